@@ -17,6 +17,7 @@ var services = mapOf(
         "blueprints" to "Blueprints",
         "bot" to "Bot",
         "cdn" to "CDN",
+        "chaosstudio" to "Chaos Studio",
         "cognitive" to "Cognitive Services",
         "communication" to "Communication",
         "compute" to "Compute",
@@ -0,0 +1,62 @@
+package fakearm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+// Client returns a Client whose Resource Manager Endpoint is the Fake ARM Server, which doesn't
+// authenticate nor register any Resource Providers
+func (s *Server) Client(t *testing.T) *clients.Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client != nil {
+		return s.client
+	}
+
+	env := azure.PublicCloud
+	env.Name = "FakeArm"
+	env.ResourceManagerEndpoint = s.server.URL
+	env.TokenAudience = s.server.URL
+
+	client := clients.Client{
+		Account: &clients.ResourceManagerAccount{
+			Environment:                      env,
+			SkipResourceProviderRegistration: true,
+			SubscriptionId:                   SubscriptionId,
+			TenantId:                         TenantId,
+		},
+	}
+	o := &common.ClientOptions{
+		SubscriptionId:              SubscriptionId,
+		TenantID:                    TenantId,
+		TerraformVersion:            "0.0.0",
+		KeyVaultAuthorizer:          autorest.NullAuthorizer{},
+		ResourceManagerAuthorizer:   autorest.NullAuthorizer{},
+		ResourceManagerEndpoint:     env.ResourceManagerEndpoint,
+		StorageAuthorizer:           autorest.NullAuthorizer{},
+		SynapseAuthorizer:           autorest.NullAuthorizer{},
+		BatchManagementAuthorizer:   autorest.NullAuthorizer{},
+		SkipProviderReg:             true,
+		DisableCorrelationRequestID: true,
+		DisableTerraformPartnerID:   true,
+		Environment:                 env,
+		Features:                    features.Default(),
+		TokenFunc: func(_ string) (autorest.Authorizer, error) {
+			return autorest.NullAuthorizer{}, nil
+		},
+	}
+	if err := client.Build(context.Background(), o); err != nil {
+		t.Fatalf("building the Client for the Fake ARM Server: %+v", err)
+	}
+
+	s.client = &client
+	return s.client
+}
@@ -0,0 +1,527 @@
+package fakearm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+const (
+	// SubscriptionId is the ID of the Subscription which the Fake ARM Server exposes
+	SubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+	// TenantId is the ID of the Tenant which the Fake ARM Server exposes
+	TenantId = "00000000-0000-0000-0000-000000000000"
+
+	// operationsPath is the path used for the status of Long Running Operations
+	operationsPath = "/providers/Microsoft.FakeArm/operations/"
+)
+
+// Server is an in-process fake of the Azure Resource Manager API, which stores the Resources
+// in-memory - allowing a Resource's Create/Read/Update/Delete to be run without Azure.
+//
+// Resources are stored as-is from the request body of a PUT (with the `id`, `name`, `type` and
+// `properties.provisioningState` populated), can be updated using a PATCH (as a JSON Merge Patch)
+// and are removed along with any nested Resources by a DELETE. A GET for a collection (for example
+// `.../providers/Microsoft.OperationalInsights/queryPacks`) returns the Resources within it.
+//
+// Resource Types registered using `LongRunning` are Created and Deleted using Long Running Operations
+// (returning an `Azure-AsyncOperation` header), otherwise these complete immediately.
+//
+// Operations which can't be modelled this way (for example POST actions) can be registered using
+// `Handle`.
+type Server struct {
+	// PollsUntilComplete is the number of times the status of a Long Running Operation is returned
+	// as `InProgress` before it's returned as `Succeeded`
+	PollsUntilComplete int
+
+	server *httptest.Server
+	client *clients.Client
+
+	mu          sync.Mutex
+	resources   map[string]map[string]interface{}
+	longRunning map[string]struct{}
+	operations  map[string]*operation
+	handlers    []handler
+	requests    []string
+	nextId      int
+}
+
+type operation struct {
+	// polls is the number of times the status of this operation has been requested
+	polls int
+}
+
+type handler struct {
+	method  string
+	path    *regexp.Regexp
+	handler http.HandlerFunc
+}
+
+// NewServer starts a Fake ARM Server, which is stopped when the test completes
+func NewServer(t *testing.T) *Server {
+	s := &Server{
+		PollsUntilComplete: 1,
+		resources:          make(map[string]map[string]interface{}),
+		longRunning:        make(map[string]struct{}),
+		operations:         make(map[string]*operation),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// URL returns the URL of the Fake ARM Server, which is used as the Resource Manager Endpoint
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// LongRunning specifies that the Resource Types (e.g. `Microsoft.Resources/resourceGroups`) should be
+// Created and Deleted using Long Running Operations
+func (s *Server) LongRunning(resourceTypes ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, v := range resourceTypes {
+		s.longRunning[strings.ToLower(v)] = struct{}{}
+	}
+}
+
+// Handle registers a custom handler for requests using the specified method whose path matches the
+// regular expression, which takes precedence over the default behaviour
+func (s *Server) Handle(method string, path *regexp.Regexp, h http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers = append(s.handlers, handler{
+		method:  method,
+		path:    path,
+		handler: h,
+	})
+}
+
+// Put stores the Resource with the specified ID, which allows pre-existing Resources to be seeded
+func (s *Server) Put(id string, body map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resources[strings.ToLower(id)] = withResourceFields(id, body)
+}
+
+// Resource returns the Resource with the specified ID, or nil if it doesn't exist
+func (s *Server) Resource(id string) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.resources[strings.ToLower(id)]
+}
+
+// ResourceIds returns the IDs of all of the Resources which exist
+func (s *Server) ResourceIds() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0, len(s.resources))
+	for _, v := range s.resources {
+		ids = append(ids, v["id"].(string))
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// Requests returns the method and path of each request made to the Fake ARM Server (e.g. `PUT /subscriptions/...`)
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, fmt.Sprintf("%s %s", r.Method, r.URL.Path))
+	handlers := append([]handler{}, s.handlers...)
+	s.mu.Unlock()
+
+	for _, h := range handlers {
+		if h.method == r.Method && h.path.MatchString(r.URL.Path) {
+			h.handler(w, r)
+			return
+		}
+	}
+
+	path := canonicalPath(strings.TrimSuffix(r.URL.Path, "/"))
+	if strings.HasPrefix(path, operationsPath) {
+		s.operationStatus(w, strings.TrimPrefix(path, operationsPath))
+		return
+	}
+
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		writeError(w, http.StatusNotFound, "InvalidResourceType", fmt.Sprintf("the path %q is not supported by the Fake ARM Server", path))
+		return
+	}
+
+	// an odd number of segments is a collection (e.g. `/subscriptions/{id}/resourceGroups`)
+	isCollection := len(segments)%2 == 1
+
+	switch {
+	case r.Method == http.MethodGet && isCollection:
+		s.list(w, path)
+	case r.Method == http.MethodGet:
+		s.get(w, path)
+	case r.Method == http.MethodPut && !isCollection:
+		s.put(w, r, path)
+	case r.Method == http.MethodPatch && !isCollection:
+		s.patch(w, r, path)
+	case r.Method == http.MethodDelete && !isCollection:
+		s.delete(w, path)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("%s is not supported for %q by the Fake ARM Server", r.Method, path))
+	}
+}
+
+func (s *Server) get(w http.ResponseWriter, id string) {
+	s.mu.Lock()
+	existing, ok := s.resources[strings.ToLower(id)]
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("the Resource %q was not found", id))
+		return
+	}
+
+	writeJson(w, http.StatusOK, existing)
+}
+
+func (s *Server) list(w http.ResponseWriter, path string) {
+	prefix := strings.ToLower(path) + "/"
+	resourceGroupResources := false
+
+	// `/subscriptions/{id}/resourceGroups/{name}/resources` lists all of the Resources within the Resource Group
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(segments) == 5 && strings.EqualFold(segments[2], "resourceGroups") && strings.EqualFold(segments[4], "resources") {
+		prefix = strings.ToLower(strings.Join(segments[:4], "/"))
+		prefix = "/" + prefix + "/providers/"
+		resourceGroupResources = true
+	}
+
+	s.mu.Lock()
+	values := make([]map[string]interface{}, 0)
+	for k, v := range s.resources {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+
+		// only the direct children are returned for a collection
+		if !resourceGroupResources && strings.Contains(strings.TrimPrefix(k, prefix), "/") {
+			continue
+		}
+		values = append(values, v)
+	}
+	s.mu.Unlock()
+
+	sort.Slice(values, func(i, j int) bool {
+		return values[i]["id"].(string) < values[j]["id"].(string)
+	})
+
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"value": values,
+	})
+}
+
+func (s *Server) put(w http.ResponseWriter, r *http.Request, id string) {
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	if resourceGroupId := resourceGroupIdFor(id); resourceGroupId != "" && s.Resource(resourceGroupId) == nil {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("the Resource Group %q was not found", resourceGroupId))
+		return
+	}
+	if parentId := parentIdFor(id); parentId != "" && s.Resource(parentId) == nil {
+		writeError(w, http.StatusNotFound, "ParentResourceNotFound", fmt.Sprintf("the Parent Resource %q was not found", parentId))
+		return
+	}
+
+	resource := withResourceFields(id, body)
+
+	s.mu.Lock()
+	s.resources[strings.ToLower(id)] = resource
+	s.mu.Unlock()
+
+	if s.isLongRunning(id) {
+		s.writeLongRunningOperation(w, http.StatusCreated, resource)
+		return
+	}
+
+	writeJson(w, http.StatusOK, resource)
+}
+
+func (s *Server) patch(w http.ResponseWriter, r *http.Request, id string) {
+	body, err := readBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	existing, ok := s.resources[strings.ToLower(id)]
+	if ok {
+		existing = withResourceFields(id, mergePatch(existing, body).(map[string]interface{}))
+		s.resources[strings.ToLower(id)] = existing
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("the Resource %q was not found", id))
+		return
+	}
+
+	writeJson(w, http.StatusOK, existing)
+}
+
+func (s *Server) delete(w http.ResponseWriter, id string) {
+	key := strings.ToLower(id)
+
+	s.mu.Lock()
+	_, exists := s.resources[key]
+	for k := range s.resources {
+		// any nested Resources are deleted too
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(s.resources, k)
+		}
+	}
+	s.mu.Unlock()
+
+	if !exists {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if s.isLongRunning(id) {
+		s.writeLongRunningOperation(w, http.StatusAccepted, nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) isLongRunning(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.longRunning[strings.ToLower(resourceTypeFor(id))]
+	return ok
+}
+
+// writeLongRunningOperation writes a response for an operation which completes once the status of the
+// operation has been polled `PollsUntilComplete` times
+func (s *Server) writeLongRunningOperation(w http.ResponseWriter, statusCode int, body interface{}) {
+	s.mu.Lock()
+	s.nextId++
+	operationId := fmt.Sprintf("%d", s.nextId)
+	s.operations[operationId] = &operation{}
+	s.mu.Unlock()
+
+	w.Header().Set("Azure-AsyncOperation", s.server.URL+operationsPath+operationId)
+	w.Header().Set("Retry-After", "0")
+	if body == nil {
+		w.WriteHeader(statusCode)
+		return
+	}
+
+	writeJson(w, statusCode, body)
+}
+
+func (s *Server) operationStatus(w http.ResponseWriter, operationId string) {
+	s.mu.Lock()
+	op, ok := s.operations[operationId]
+	status := "Succeeded"
+	if ok {
+		op.polls++
+		if op.polls <= s.PollsUntilComplete {
+			status = "InProgress"
+		}
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("the Operation %q was not found", operationId))
+		return
+	}
+
+	w.Header().Set("Retry-After", "0")
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"status": status,
+	})
+}
+
+// canonicalPath returns the path using the casing returned by ARM for the well-known segments, since some
+// SDKs use (for example) `resourcegroups` rather than `resourceGroups`
+func canonicalPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, v := range segments {
+		switch {
+		case strings.EqualFold(v, "subscriptions") && i == 1:
+			segments[i] = "subscriptions"
+		case strings.EqualFold(v, "resourceGroups") && i == 3:
+			segments[i] = "resourceGroups"
+		case strings.EqualFold(v, "providers"):
+			segments[i] = "providers"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// withResourceFields returns the Resource with the fields populated by ARM (`id`, `name`, `type`
+// and `properties.provisioningState`)
+func withResourceFields(id string, body map[string]interface{}) map[string]interface{} {
+	resource := make(map[string]interface{}, len(body)+3)
+	for k, v := range body {
+		resource[k] = v
+	}
+
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	resource["id"] = id
+	resource["name"] = segments[len(segments)-1]
+	resource["type"] = resourceTypeFor(id)
+
+	properties, ok := resource["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	properties["provisioningState"] = "Succeeded"
+	resource["properties"] = properties
+
+	return resource
+}
+
+// resourceTypeFor returns the Resource Type for the specified Resource ID, for example
+// `Microsoft.Sql/servers/databases` or `Microsoft.Resources/resourceGroups`
+func resourceTypeFor(id string) string {
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+
+	providerIndex := -1
+	for i, v := range segments {
+		if strings.EqualFold(v, "providers") {
+			providerIndex = i
+		}
+	}
+	if providerIndex == -1 || providerIndex+1 >= len(segments) {
+		if len(segments) >= 4 && strings.EqualFold(segments[2], "resourceGroups") {
+			return "Microsoft.Resources/resourceGroups"
+		}
+		return "Microsoft.Resources/subscriptions"
+	}
+
+	types := []string{segments[providerIndex+1]}
+	for i := providerIndex + 2; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+	return strings.Join(types, "/")
+}
+
+// resourceGroupIdFor returns the ID of the Resource Group which the Resource is within, or an empty
+// string if this isn't within a Resource Group (or is the Resource Group itself)
+func resourceGroupIdFor(id string) string {
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	if len(segments) <= 4 || !strings.EqualFold(segments[2], "resourceGroups") {
+		return ""
+	}
+
+	return "/" + strings.Join(segments[:4], "/")
+}
+
+// parentIdFor returns the ID of the Parent Resource for a nested Resource (for example the Server for
+// a Database), or an empty string if this isn't a nested Resource
+func parentIdFor(id string) string {
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+
+	providerIndex := -1
+	for i, v := range segments {
+		if strings.EqualFold(v, "providers") {
+			providerIndex = i
+		}
+	}
+
+	// `providers/{namespace}/{type}/{name}` is a top-level resource
+	if providerIndex == -1 || len(segments)-providerIndex <= 4 {
+		return ""
+	}
+
+	return "/" + strings.Join(segments[:len(segments)-2], "/")
+}
+
+// mergePatch applies the JSON Merge Patch (RFC 7386) to the existing value
+func mergePatch(existing interface{}, patch interface{}) interface{} {
+	patchMap, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	existingMap, ok := existing.(map[string]interface{})
+	if !ok {
+		existingMap = make(map[string]interface{})
+	}
+
+	output := make(map[string]interface{}, len(existingMap))
+	for k, v := range existingMap {
+		output[k] = v
+	}
+	for k, v := range patchMap {
+		if v == nil {
+			delete(output, k)
+			continue
+		}
+		output[k] = mergePatch(output[k], v)
+	}
+
+	return output
+}
+
+func readBody(r *http.Request) (map[string]interface{}, error) {
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the request body: %+v", err)
+	}
+
+	body := make(map[string]interface{})
+	if len(raw) == 0 {
+		return body, nil
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, fmt.Errorf("parsing the request body: %+v", err)
+	}
+
+	return body, nil
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJson(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func writeJson(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
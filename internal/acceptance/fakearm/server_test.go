@@ -0,0 +1,160 @@
+package fakearm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2019-09-01/querypacks"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestServerResourceGroupLongRunningOperations(t *testing.T) {
+	ctx := context.TODO()
+	s := NewServer(t)
+	s.PollsUntilComplete = 2
+	s.LongRunning("Microsoft.Resources/resourceGroups")
+	client := s.Client(t).Resource.GroupsClient
+
+	if _, err := client.CreateOrUpdate(ctx, "example", resources.Group{
+		Location: utils.String("westeurope"),
+		Tags: map[string]*string{
+			"env": utils.String("test"),
+		},
+	}); err != nil {
+		t.Fatalf("creating: %+v", err)
+	}
+
+	group, err := client.Get(ctx, "example")
+	if err != nil {
+		t.Fatalf("retrieving: %+v", err)
+	}
+	expectedId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example", SubscriptionId)
+	if group.ID == nil || *group.ID != expectedId {
+		t.Fatalf("expected the ID to be %q but got %v", expectedId, group.ID)
+	}
+	if group.Tags["env"] == nil || *group.Tags["env"] != "test" {
+		t.Fatalf("expected the tag `env` to be `test` but got %+v", group.Tags)
+	}
+	if group.Properties == nil || group.Properties.ProvisioningState == nil || *group.Properties.ProvisioningState != "Succeeded" {
+		t.Fatalf("expected the Provisioning State to be `Succeeded` but got %+v", group.Properties)
+	}
+
+	future, err := client.Delete(ctx, "example", "")
+	if err != nil {
+		t.Fatalf("deleting: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		t.Fatalf("waiting for deletion: %+v", err)
+	}
+
+	statusRequests := 0
+	for _, v := range s.Requests() {
+		if regexp.MustCompile("^GET " + operationsPath).MatchString(v) {
+			statusRequests++
+		}
+	}
+	if statusRequests != 3 {
+		t.Fatalf("expected the status of the Long Running Operation to be polled 3 times but got %d: %+v", statusRequests, s.Requests())
+	}
+
+	resp, err := client.Get(ctx, "example")
+	if !utils.ResponseWasNotFound(resp.Response) {
+		t.Fatalf("expected the Resource Group to be Not Found but got %d: %+v", resp.StatusCode, err)
+	}
+}
+
+func TestServerQueryPacks(t *testing.T) {
+	ctx := context.TODO()
+	s := NewServer(t)
+	client := s.Client(t).LogAnalytics.QueryPacksClient
+
+	id := querypacks.NewQueryPackID(SubscriptionId, "example", "pack")
+	if _, err := client.QueryPacksCreateOrUpdate(ctx, id, querypacks.LogAnalyticsQueryPack{Location: "westeurope"}); err == nil {
+		t.Fatalf("expected an error when the Resource Group doesn't exist")
+	}
+
+	s.Put(fmt.Sprintf("/subscriptions/%s/resourceGroups/example", SubscriptionId), map[string]interface{}{
+		"location": "westeurope",
+	})
+	if _, err := client.QueryPacksCreateOrUpdate(ctx, id, querypacks.LogAnalyticsQueryPack{Location: "westeurope"}); err != nil {
+		t.Fatalf("creating: %+v", err)
+	}
+
+	tags := map[string]string{"env": "test"}
+	if _, err := client.QueryPacksUpdateTags(ctx, id, querypacks.TagsResource{Tags: &tags}); err != nil {
+		t.Fatalf("updating the tags: %+v", err)
+	}
+
+	resp, err := client.QueryPacksGet(ctx, id)
+	if err != nil {
+		t.Fatalf("retrieving: %+v", err)
+	}
+	if resp.Model == nil || resp.Model.Location != "westeurope" || resp.Model.Tags == nil || (*resp.Model.Tags)["env"] != "test" {
+		t.Fatalf("expected the Location and Tags to be retained but got %+v", resp.Model)
+	}
+
+	if _, err := client.QueryPacksDelete(ctx, id); err != nil {
+		t.Fatalf("deleting: %+v", err)
+	}
+	resp, err = client.QueryPacksGet(ctx, id)
+	if !response.WasNotFound(resp.HttpResponse) {
+		t.Fatalf("expected the Query Pack to be Not Found but got %+v", err)
+	}
+}
+
+func TestServerDeleteRemovesNestedResources(t *testing.T) {
+	s := NewServer(t)
+	resourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example", SubscriptionId)
+	serverId := resourceGroupId + "/providers/Microsoft.Sql/servers/server"
+	s.Put(resourceGroupId, map[string]interface{}{})
+	s.Put(serverId, map[string]interface{}{})
+	s.Put(serverId+"/databases/database", map[string]interface{}{})
+
+	if v := s.Resource(serverId + "/databases/database")["type"]; v != "Microsoft.Sql/servers/databases" {
+		t.Fatalf("expected the type to be `Microsoft.Sql/servers/databases` but got %v", v)
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, s.URL()+serverId, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("deleting: %+v", err)
+	}
+	resp.Body.Close()
+
+	if ids := s.ResourceIds(); len(ids) != 1 || ids[0] != resourceGroupId {
+		t.Fatalf("expected only the Resource Group to remain but got %+v", ids)
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	existing := map[string]interface{}{
+		"location": "westeurope",
+		"tags": map[string]interface{}{
+			"first":  "1",
+			"second": "2",
+		},
+	}
+	patch := map[string]interface{}{
+		"tags": map[string]interface{}{
+			"second": nil,
+			"third":  "3",
+		},
+	}
+
+	actual := mergePatch(existing, patch).(map[string]interface{})
+	tags := actual["tags"].(map[string]interface{})
+	if actual["location"] != "westeurope" || tags["first"] != "1" || tags["third"] != "3" {
+		t.Fatalf("expected the existing and patched values to be present but got %+v", actual)
+	}
+	if _, ok := tags["second"]; ok {
+		t.Fatalf("expected `second` to be removed but got %+v", tags)
+	}
+}
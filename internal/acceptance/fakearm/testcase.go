@@ -0,0 +1,100 @@
+package fakearm
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	// envTerraformPath and envTerraformVersion are the environment variables used by the Plugin SDK to
+	// locate (or install) the Terraform CLI used for the tests
+	envTerraformPath    = "TF_ACC_TERRAFORM_PATH"
+	envTerraformVersion = "TF_ACC_TERRAFORM_VERSION"
+)
+
+// Location is the Location used for the Resources within the Test Configurations
+const Location = "westeurope"
+
+// BuildTestData returns the Test Data for a Resource tested against the Fake ARM Server, which (unlike
+// `acceptance.BuildTestData`) doesn't depend on the `ARM_*` environment variables
+func BuildTestData(t *testing.T, resourceType string, resourceLabel string) acceptance.TestData {
+	data := acceptance.BuildTestData(t, resourceType, resourceLabel)
+	data.Locations = acceptance.Regions{
+		Primary:   Location,
+		Secondary: Location,
+		Ternary:   Location,
+	}
+	data.Subscriptions = acceptance.Subscriptions{
+		Primary:   SubscriptionId,
+		Secondary: SubscriptionId,
+	}
+
+	return data
+}
+
+// ResourceTest runs the Test Steps for a Resource against the Fake ARM Server, rather than Azure -
+// confirming that the Resource has been removed from the Fake ARM Server once the test completes.
+//
+// Unlike the Acceptance Tests these run as a part of the Unit Tests (and so don't require `TF_ACC`),
+// however the Terraform CLI must be available - these are skipped when it isn't, rather than being
+// downloaded, so that these can be run offline.
+func (s *Server) ResourceTest(t *testing.T, data acceptance.TestData, testResource types.TestResource, steps []acceptance.TestStep) {
+	if !terraformCliAvailable() {
+		t.Skipf("skipping since the Terraform CLI is unavailable - either add it to the PATH or set %q", envTerraformPath)
+	}
+
+	client := s.Client(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		CheckDestroy: func(state *terraform.State) error {
+			return helpers.CheckDestroyedFunc(client, testResource, data.ResourceType, data.ResourceName)(state)
+		},
+		ProtoV5ProviderFactories: s.ProviderFactories(t),
+		Steps:                    steps,
+	})
+}
+
+// ExistsInAzure returns a Check confirming that the Resource exists within the Fake ARM Server, for use
+// in place of `check.That(..).ExistsInAzure(..)` which uses a Client for Azure
+func (s *Server) ExistsInAzure(t *testing.T, testResource types.TestResource, resourceName string) pluginsdk.TestCheckFunc {
+	return helpers.ExistsInAzure(s.Client(t), testResource, resourceName)
+}
+
+// ProviderFactories returns the Provider Factories for the Azure Provider, configured to use the Fake ARM Server
+func (s *Server) ProviderFactories(t *testing.T) map[string]func() (tfprotov5.ProviderServer, error) {
+	client := s.Client(t)
+
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"azurerm": func() (tfprotov5.ProviderServer, error) { //nolint:unparam
+			azurerm := provider.TestAzureProvider()
+			azurerm.ConfigureContextFunc = func(_ context.Context, _ *schema.ResourceData) (interface{}, diag.Diagnostics) {
+				return client, nil
+			}
+			return sdk.NewProviderServer(schema.NewGRPCProviderServer(azurerm)), nil
+		},
+	}
+}
+
+// terraformCliAvailable returns whether the Terraform CLI is available without being downloaded
+func terraformCliAvailable() bool {
+	if os.Getenv(envTerraformPath) != "" || os.Getenv(envTerraformVersion) != "" {
+		return true
+	}
+
+	_, err := exec.LookPath("terraform")
+	return err == nil
+}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/validation"
-	dns_v2018_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	aadb2c "github.com/hashicorp/terraform-provider-azurerm/internal/services/aadb2c/client"
@@ -23,6 +22,7 @@ import (
 	blueprints "github.com/hashicorp/terraform-provider-azurerm/internal/services/blueprints/client"
 	bot "github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/client"
 	cdn "github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/client"
+	chaosstudio "github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/client"
 	cognitiveServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/client"
 	communication "github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/client"
 	compute "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
@@ -122,6 +122,14 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// CorrelationRequestID is the value of the `x-ms-correlation-request-id` header sent with each request
+	// to Azure, which is empty when this has been disabled
+	CorrelationRequestID string
+
+	// LongRunningOperationsClient is used to resume polling Long Running Operations which were started
+	// during a previous run, which is configured using the Resource Manager Authorizer
+	LongRunningOperationsClient *autorest.Client
+
 	AadB2c                *aadb2c.Client
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisServices.Client
@@ -138,6 +146,7 @@ type Client struct {
 	Blueprints            *blueprints.Client
 	Bot                   *bot.Client
 	Cdn                   *cdn.Client
+	ChaosStudio           *chaosstudio.Client
 	Cognitive             *cognitiveServices.Client
 	Communication         *communication.Client
 	Compute               *compute.Client
@@ -160,7 +169,7 @@ type Client struct {
 	DevTestLabs           *devtestlabs.Client
 	DigitalTwins          *digitaltwins.Client
 	Disks                 *disks.Client
-	Dns                   *dns_v2018_05_01.Client
+	Dns                   *dns.Client
 	DomainServices        *domainservices.Client
 	Elastic               *elastic.Client
 	EventGrid             *eventgrid.Client
@@ -238,6 +247,11 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 
 	client.Features = o.Features
 	client.StopContext = ctx
+	client.CorrelationRequestID = o.CorrelationRequestID()
+
+	longRunningOperationsClient := autorest.NewClientWithUserAgent("")
+	o.ConfigureClient(&longRunningOperationsClient, o.ResourceManagerAuthorizer)
+	client.LongRunningOperationsClient = &longRunningOperationsClient
 
 	client.AadB2c = aadb2c.NewClient(o)
 	client.Advisor = advisor.NewClient(o)
@@ -255,6 +269,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.Blueprints = blueprints.NewClient(o)
 	client.Bot = bot.NewClient(o)
 	client.Cdn = cdn.NewClient(o)
+	client.ChaosStudio = chaosstudio.NewClient(o)
 	client.Cognitive = cognitiveServices.NewClient(o)
 	client.Communication = communication.NewClient(o)
 	client.Compute = compute.NewClient(o)
@@ -347,3 +362,13 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 
 	return nil
 }
+
+// ConfiguredSubscriptionId returns the ID of the Subscription configured in the Provider, which is used
+// when importing a Resource using the names of each segment of the Resource ID
+func (client *Client) ConfiguredSubscriptionId() string {
+	if client.Account == nil {
+		return ""
+	}
+
+	return client.Account.SubscriptionId
+}
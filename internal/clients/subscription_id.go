@@ -0,0 +1,14 @@
+package clients
+
+import "strings"
+
+// SubscriptionIdFromResourceId returns the Subscription ID from the Resource ID, or an empty string
+// if the Resource ID isn't scoped to a Subscription
+func SubscriptionIdFromResourceId(id string) string {
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	if !strings.HasPrefix(id, "/") || len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		return ""
+	}
+
+	return segments[1]
+}
@@ -0,0 +1,41 @@
+package clients
+
+import (
+	"testing"
+)
+
+func TestSubscriptionIdFromResourceId(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Expected: "00000000-0000-0000-0000-000000000000",
+		},
+		{
+			Input:    "/Subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Expected: "00000000-0000-0000-0000-000000000000",
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/example",
+			Expected: "",
+		},
+		{
+			Input:    "https://example.vault.azure.net/keys/example",
+			Expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := SubscriptionIdFromResourceId(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
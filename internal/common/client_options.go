@@ -7,7 +7,6 @@ import (
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/meta"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/version"
@@ -48,17 +47,35 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	setUserAgent(c, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = BuildSender()
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
-	if !o.DisableCorrelationRequestID {
-		id := o.CustomCorrelationRequestID
-		if id == "" {
-			id = correlationRequestID()
-		}
+	if id := o.CorrelationRequestID(); id != "" {
 		c.RequestInspector = withCorrelationRequestID(id)
 	}
 }
 
+// ForSubscription returns a copy of these ClientOptions for the specified Subscription - which is used to build
+// the SDK Client(s) needed to manage a Resource within a Subscription other than the one configured in the
+// Provider, using the same credentials and configuration
+func (o ClientOptions) ForSubscription(subscriptionId string) *ClientOptions {
+	o.SubscriptionId = subscriptionId
+	return &o
+}
+
+// CorrelationRequestID returns the Correlation Request ID which is sent in the `x-ms-correlation-request-id`
+// header of each request to Azure, or an empty string when this has been disabled
+func (o ClientOptions) CorrelationRequestID() string {
+	if o.DisableCorrelationRequestID {
+		return ""
+	}
+
+	if o.CustomCorrelationRequestID != "" {
+		return o.CustomCorrelationRequestID
+	}
+
+	return correlationRequestID()
+}
+
 func setUserAgent(client *autorest.Client, tfVersion, partnerID string, disableTerraformPartnerID bool) {
 	tfUserAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io) Terraform Plugin SDK/%s", tfVersion, meta.SDKVersionString())
 
@@ -0,0 +1,26 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestClientOptionsForSubscription(t *testing.T) {
+	o := ClientOptions{
+		SubscriptionId:            "00000000-0000-0000-0000-000000000000",
+		ResourceManagerAuthorizer: autorest.NullAuthorizer{},
+		ResourceManagerEndpoint:   "https://management.azure.com/",
+	}
+
+	other := o.ForSubscription("11111111-1111-1111-1111-111111111111")
+	if other.SubscriptionId != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected the Subscription ID to be %q but got %q", "11111111-1111-1111-1111-111111111111", other.SubscriptionId)
+	}
+	if other.ResourceManagerEndpoint != o.ResourceManagerEndpoint || other.ResourceManagerAuthorizer != o.ResourceManagerAuthorizer {
+		t.Fatalf("expected the credentials and configuration to be the same")
+	}
+	if o.SubscriptionId != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("expected the original ClientOptions to be unchanged but the Subscription ID was %q", o.SubscriptionId)
+	}
+}
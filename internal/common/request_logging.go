@@ -0,0 +1,242 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+)
+
+const redactedValue = "REDACTED"
+
+var (
+	// sensitiveFieldRegex matches the names of the JSON fields, headers and query string parameters known to contain secrets
+	sensitiveFieldRegex = regexp.MustCompile(`(?i)(password|secret|token|authorization|connectionstring|credential|privatekey|accesskey|accountkey|sharedkey|primarykey|secondarykey|masterkey|apikey|subscription-key)`)
+
+	// sensitiveQueryParameterRegex matches the names of the query string parameters known to contain secrets, in
+	// addition to those matched by sensitiveFieldRegex - such as the signature of a Shared Access Signature
+	sensitiveQueryParameterRegex = regexp.MustCompile(`^sig$`)
+)
+
+type logFieldsKey struct{}
+
+// WithLogFields returns a copy of the Context containing the specified key/value fields, which are included
+// in the log messages for each HTTP Request made to Azure using this Context (e.g. the Resource Type and ID)
+func WithLogFields(ctx context.Context, fields map[string]interface{}) context.Context {
+	merged := make(map[string]interface{})
+	for k, v := range LogFieldsFromContext(ctx) {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	return context.WithValue(ctx, logFieldsKey{}, merged)
+}
+
+// LogFieldsFromContext returns the key/value fields added to the Context using WithLogFields
+func LogFieldsFromContext(ctx context.Context) map[string]interface{} {
+	if ctx == nil {
+		return nil
+	}
+
+	if v, ok := ctx.Value(logFieldsKey{}).(map[string]interface{}); ok {
+		return v
+	}
+
+	return nil
+}
+
+// FormatLogFields formats the specified key/value fields for inclusion within a log message, sorted by key
+func FormatLogFields(fields map[string]interface{}) string {
+	keys := make([]string, 0)
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make([]string, 0)
+	for _, k := range keys {
+		out = append(out, fmt.Sprintf("%s=%v", k, fields[k]))
+	}
+	return strings.Join(out, " ")
+}
+
+// BuildSender returns the Sender used for HTTP Requests to Azure, which logs each Request/Response
+func BuildSender() autorest.Sender {
+	return autorest.DecorateSender(&http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		},
+	}, withRequestLogging())
+}
+
+// withRequestLogging logs each HTTP Request/Response, tagged with the fields from the Request's Context and the
+// Correlation/Request IDs - including the (redacted) bodies unless HTTP Body Logging has been disabled
+func withRequestLogging() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			logBodies := features.HttpBodyLoggingEnabled()
+
+			fields := map[string]interface{}{
+				"http_method": r.Method,
+				"http_url":    redactedUrl(r),
+			}
+			for k, v := range LogFieldsFromContext(r.Context()) {
+				fields[k] = v
+			}
+			if v := r.Header.Get(HeaderCorrelationRequestID); v != "" {
+				fields["correlation_request_id"] = v
+			}
+
+			requestFields := map[string]interface{}{
+				"http_request_headers": redactedHeaders(r.Header),
+			}
+			if logBodies && r.Body != nil {
+				body, err := io.ReadAll(r.Body)
+				if err == nil {
+					r.Body = io.NopCloser(bytes.NewReader(body))
+					requestFields["http_request_body"] = redactedBody(body)
+				}
+			}
+			log.Printf("[DEBUG] AzureRM Request: %s", FormatLogFields(mergeLogFields(fields, requestFields)))
+
+			start := time.Now()
+			resp, err := s.Do(r)
+			fields["duration_ms"] = time.Since(start).Milliseconds()
+
+			if resp == nil {
+				if err != nil {
+					fields["error"] = err.Error()
+				}
+				log.Printf("[DEBUG] AzureRM Request completed with no Response: %s", FormatLogFields(fields))
+				return resp, err
+			}
+
+			responseFields := map[string]interface{}{
+				"http_status_code":      resp.StatusCode,
+				"http_response_headers": redactedHeaders(resp.Header),
+			}
+			if v := resp.Header.Get("x-ms-request-id"); v != "" {
+				responseFields["request_id"] = v
+			}
+			if logBodies && resp.Body != nil {
+				body, readErr := io.ReadAll(resp.Body)
+				if readErr == nil {
+					resp.Body = io.NopCloser(bytes.NewReader(body))
+					responseFields["http_response_body"] = redactedBody(body)
+				}
+			}
+			log.Printf("[DEBUG] AzureRM Response: %s", FormatLogFields(mergeLogFields(fields, responseFields)))
+
+			return resp, err
+		})
+	}
+}
+
+func mergeLogFields(first, second map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{})
+	for k, v := range first {
+		out[k] = v
+	}
+	for k, v := range second {
+		out[k] = v
+	}
+	return out
+}
+
+func redactedUrl(r *http.Request) string {
+	if r.URL == nil {
+		return ""
+	}
+
+	u := *r.URL
+	query := u.Query()
+	for k := range query {
+		if sensitiveFieldRegex.MatchString(k) || sensitiveQueryParameterRegex.MatchString(k) {
+			query.Set(k, redactedValue)
+		}
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func redactedHeaders(input http.Header) string {
+	keys := make([]string, 0)
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	out := make([]string, 0)
+	for _, k := range keys {
+		value := strings.Join(input.Values(k), ",")
+		if sensitiveFieldRegex.MatchString(k) {
+			value = redactedValue
+		}
+		out = append(out, fmt.Sprintf("%s: %s", k, value))
+	}
+
+	return fmt.Sprintf("%q", strings.Join(out, "; "))
+}
+
+// redactedBody returns the body with the value of any sensitive JSON fields redacted - bodies which aren't
+// JSON can't be reliably redacted, so only their length is logged
+func redactedBody(body []byte) string {
+	if len(body) == 0 {
+		return `""`
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("%q", fmt.Sprintf("[%d bytes of non-JSON content omitted]", len(body)))
+	}
+
+	out, err := json.Marshal(redactJson(v))
+	if err != nil {
+		return fmt.Sprintf("%q", fmt.Sprintf("[%d bytes omitted]", len(body)))
+	}
+
+	return string(out)
+}
+
+func redactJson(input interface{}) interface{} {
+	return redactJsonField("", input)
+}
+
+// redactJsonField redacts the JSON value for the field named `name` - scalar values are redacted when the field
+// name is known to contain a secret
+func redactJsonField(name string, input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = redactJsonField(key, value)
+		}
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactJsonField(name, value)
+		}
+		return v
+
+	case nil:
+		return input
+	}
+
+	if name != "" && sensitiveFieldRegex.MatchString(name) {
+		return redactedValue
+	}
+
+	return input
+}
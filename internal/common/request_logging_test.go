@@ -0,0 +1,99 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestWithLogFields(t *testing.T) {
+	ctx := WithLogFields(context.TODO(), map[string]interface{}{
+		"resource_type": "azurerm_resource_group",
+	})
+	ctx = WithLogFields(ctx, map[string]interface{}{
+		"resource_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+	})
+
+	expected := "resource_id=/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example resource_type=azurerm_resource_group"
+	if actual := FormatLogFields(LogFieldsFromContext(ctx)); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
+
+func TestRedactedBody(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    ``,
+			expected: `""`,
+		},
+		{
+			input:    `not json`,
+			expected: `"[8 bytes of non-JSON content omitted]"`,
+		},
+		{
+			input:    `{"name":"example","properties":{"adminPassword":"Passw0rd1234!","tags":["a"]}}`,
+			expected: `{"name":"example","properties":{"adminPassword":"REDACTED","tags":["a"]}}`,
+		},
+		{
+			input:    `{"value":[{"name":"example","id":"/example"}],"access_token":"eyJ0eXAi"}`,
+			expected: `{"access_token":"REDACTED","value":[{"id":"/example","name":"example"}]}`,
+		},
+		{
+			input:    `{"keys":[{"keyName":"key1","permissions":"FULL"}],"primaryKey":"abc123","connectionString":"Endpoint=example;SharedAccessKey=abc123"}`,
+			expected: `{"connectionString":"REDACTED","keys":[{"keyName":"key1","permissions":"FULL"}],"primaryKey":"REDACTED"}`,
+		},
+		{
+			// only fields known to contain secrets are redacted, rather than every value within `properties`
+			input:    `{"name":"example","properties":{"displayName":"example","code":"ABC","status":"Enabled","provisioningState":"Succeeded"}}`,
+			expected: `{"name":"example","properties":{"code":"ABC","displayName":"example","provisioningState":"Succeeded","status":"Enabled"}}`,
+		},
+		{
+			input:    `{"secrets":["abc123"],"tags":["a"]}`,
+			expected: `{"secrets":["REDACTED"],"tags":["a"]}`,
+		},
+		{
+			input:    `{"properties":{"secrets":{"name":"example"}}}`,
+			expected: `{"properties":{"secrets":{"name":"example"}}}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		if actual := redactedBody([]byte(v.input)); actual != v.expected {
+			t.Fatalf("expected %s but got %s", v.expected, actual)
+		}
+	}
+}
+
+func TestRedactedHeadersAndUrl(t *testing.T) {
+	u, _ := url.Parse("https://example.blob.core.windows.net/container?sv=2020-08-04&sig=abc123")
+	req := &http.Request{
+		URL: u,
+		Header: http.Header{
+			"Authorization": []string{"Bearer eyJ0eXAi"},
+			"Content-Type":  []string{"application/json"},
+		},
+	}
+
+	actual := redactedUrl(req)
+	if strings.Contains(actual, "abc123") {
+		t.Fatalf("expected the `sig` to be redacted from the URL but got %q", actual)
+	}
+	if !strings.Contains(actual, "sv=2020-08-04") {
+		t.Fatalf("expected the `sv` to be logged but got %q", actual)
+	}
+
+	headers := redactedHeaders(req.Header)
+	if strings.Contains(headers, "eyJ0eXAi") {
+		t.Fatalf("expected the `Authorization` header to be redacted but got %s", headers)
+	}
+	if !strings.Contains(headers, "Content-Type: application/json") {
+		t.Fatalf("expected the `Content-Type` header to be logged but got %s", headers)
+	}
+}
@@ -11,6 +11,10 @@ import (
 // This functionality calls out to the Azure MetaData Service to cache the list of supported
 // Azure Locations for the specified Endpoint - and then uses that to provide enhanced validation
 //
+// In addition Services can register plan-time checks for Resources (see `sdk.EnhancedValidation`)
+// which validate the configuration against capability data retrieved from Azure, for example
+// that a Virtual Machine Size is available within the specified Location/Zone.
+//
 // This is enabled by default as of version 2.20 of the Azure Provider, and can be disabled by
 // setting the Environment Variable `ARM_PROVIDER_ENHANCED_VALIDATION` to `false`.
 func EnhancedValidationEnabled() bool {
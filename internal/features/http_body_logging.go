@@ -0,0 +1,21 @@
+package features
+
+import (
+	"os"
+	"strings"
+)
+
+// HttpBodyLoggingEnabled returns whether or not the bodies of the HTTP Requests and Responses
+// sent to/received from Azure should be included in the debug logs.
+//
+// Known secrets (for example passwords, keys and tokens) within the bodies are redacted prior to
+// being logged. As the bodies can still contain sensitive information this can be disabled by
+// setting the Environment Variable `ARM_PROVIDER_HTTP_BODY_LOGGING` to `false`.
+func HttpBodyLoggingEnabled() bool {
+	value := os.Getenv("ARM_PROVIDER_HTTP_BODY_LOGGING")
+	if value == "" {
+		return true
+	}
+
+	return strings.EqualFold(value, "true")
+}
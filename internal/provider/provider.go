@@ -8,10 +8,12 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -25,6 +27,12 @@ func TestAzureProvider() *schema.Provider {
 	return azureProvider(true)
 }
 
+// AzureProviderServer returns the gRPC Provider Server for the Azure Provider, which allows Typed Resources
+// to persist data (such as in-progress Long Running Operations) into the Private State of a Resource
+func AzureProviderServer() tfprotov5.ProviderServer {
+	return sdk.NewProviderServer(schema.NewGRPCProviderServer(AzureProvider()))
+}
+
 func ValidatePartnerID(i interface{}, k string) ([]string, []error) {
 	// ValidatePartnerID checks if partner_id is any of the following:
 	//  * a valid UUID - will add "pid-" prefix to the ID if it is not already present
@@ -158,6 +166,19 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	// finally register any Enhanced Validation (plan-time checks against capability data from Azure)
+	if features.EnhancedValidationEnabled() {
+		for resourceType, validations := range enhancedValidations() {
+			resource, ok := resources[resourceType]
+			if !ok {
+				panic(fmt.Sprintf("Enhanced Validation is registered for %q but the Resource doesn't exist", resourceType))
+			}
+
+			debugLog("[DEBUG] Registering %d Enhanced Validation(s) for %q..", len(validations), resourceType)
+			sdk.ApplyEnhancedValidations(resource, validations)
+		}
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -448,3 +469,64 @@ More information on the "skip_provider_registration" flag can be found here:
 https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#skip_provider_registration
 
 Original Error: %s`
+
+// enhancedValidations returns the Enhanced Validation registered by each Service, keyed by Resource Type
+func enhancedValidations() map[string][]sdk.EnhancedValidation {
+	output := make(map[string][]sdk.EnhancedValidation)
+
+	// Service Registrations are reused across Typed and Untyped Services, so these need to be de-duplicated
+	registered := make(map[string]struct{})
+	add := func(serviceName string, validations []sdk.EnhancedValidation) {
+		if _, exists := registered[serviceName]; exists {
+			return
+		}
+		registered[serviceName] = struct{}{}
+
+		for _, v := range validations {
+			output[v.ResourceType] = append(output[v.ResourceType], v)
+		}
+	}
+
+	for _, service := range SupportedTypedServices() {
+		if v, ok := service.(sdk.TypedServiceRegistrationWithEnhancedValidation); ok {
+			add(service.Name(), v.EnhancedValidations())
+		}
+	}
+	for _, service := range SupportedUntypedServices() {
+		if v, ok := service.(sdk.UntypedServiceRegistrationWithEnhancedValidation); ok {
+			add(service.Name(), v.EnhancedValidations())
+		}
+	}
+
+	return output
+}
+
+// sweepers returns the Sweepers registered by each Service, which are used to remove the resources
+// leaked by the Acceptance Tests
+func sweepers() []sdk.Sweeper {
+	output := make([]sdk.Sweeper, 0)
+
+	// Service Registrations are reused across Typed and Untyped Services, so these need to be de-duplicated
+	registered := make(map[string]struct{})
+	add := func(serviceName string, sweepers []sdk.Sweeper) {
+		if _, exists := registered[serviceName]; exists {
+			return
+		}
+		registered[serviceName] = struct{}{}
+
+		output = append(output, sweepers...)
+	}
+
+	for _, service := range SupportedTypedServices() {
+		if v, ok := service.(sdk.TypedServiceRegistrationWithSweepers); ok {
+			add(service.Name(), v.Sweepers())
+		}
+	}
+	for _, service := range SupportedUntypedServices() {
+		if v, ok := service.(sdk.UntypedServiceRegistrationWithSweepers); ok {
+			add(service.Name(), v.Sweepers())
+		}
+	}
+
+	return output
+}
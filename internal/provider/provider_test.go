@@ -95,6 +95,24 @@ func TestResourcesSupportCustomTimeouts(t *testing.T) {
 	}
 }
 
+func TestEnhancedValidationsAreValid(t *testing.T) {
+	provider := TestAzureProvider()
+	for resourceType, validations := range enhancedValidations() {
+		if _, ok := provider.ResourcesMap[resourceType]; !ok {
+			t.Fatalf("Enhanced Validation is registered for %q but the Resource doesn't exist", resourceType)
+		}
+
+		for _, v := range validations {
+			if v.Description == "" {
+				t.Fatalf("an Enhanced Validation for %q has no Description", resourceType)
+			}
+			if v.Func == nil {
+				t.Fatalf("the Enhanced Validation %q for %q has no Func", v.Description, resourceType)
+			}
+		}
+	}
+}
+
 func TestProvider_impl(t *testing.T) {
 	_ = AzureProvider()
 }
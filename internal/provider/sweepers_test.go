@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// sweeperTimeout is the maximum time each Sweeper can run for within a single Location
+const sweeperTimeout = 3 * time.Hour
+
+// TestMain runs the Sweepers registered by each Service when the `-sweep` flag is specified
+// (e.g. `go test ./internal/provider -v -sweep=westeurope,eastus2`) - otherwise the tests are run as normal.
+func TestMain(m *testing.M) {
+	for _, v := range sweepers() {
+		sweeper := v
+		resource.AddTestSweepers(sweeper.Name, &resource.Sweeper{
+			Name:         sweeper.Name,
+			Dependencies: sweeper.Dependencies,
+			F: func(region string) error {
+				client, err := testclient.Build()
+				if err != nil {
+					return fmt.Errorf("building client: %+v", err)
+				}
+
+				ctx, cancel := context.WithTimeout(context.Background(), sweeperTimeout)
+				defer cancel()
+
+				log.Printf("[DEBUG] Running Sweeper %q in %q..", sweeper.Name, region)
+				return sweeper.Func(ctx, client, location.Normalize(region))
+			},
+		})
+	}
+
+	resource.TestMain(m)
+}
+
+func TestSweepersAreValid(t *testing.T) {
+	names := make(map[string]sdk.Sweeper)
+	for _, v := range sweepers() {
+		if v.Name == "" {
+			t.Fatalf("a Sweeper has no Name")
+		}
+		if v.Func == nil {
+			t.Fatalf("the Sweeper %q has no Func", v.Name)
+		}
+		if _, exists := names[v.Name]; exists {
+			t.Fatalf("multiple Sweepers are registered with the name %q", v.Name)
+		}
+		names[v.Name] = v
+	}
+
+	for _, v := range names {
+		for _, dependency := range v.Dependencies {
+			if _, ok := names[dependency]; !ok {
+				t.Fatalf("the Sweeper %q depends on the Sweeper %q which doesn't exist", v.Name, dependency)
+			}
+		}
+	}
+}
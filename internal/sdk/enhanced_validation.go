@@ -0,0 +1,138 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// EnhancedValidation is a plan-time check for a Resource which validates the configuration against
+// capability data retrieved from Azure - for example that a SKU is available within a Region/Zone.
+//
+// These checks are only registered when Enhanced Validation is enabled (see `features.EnhancedValidationEnabled`)
+// and are best-effort: when the capability data is unavailable (for example as the API returns an error) the
+// check should log this and return nil, rather than failing the plan.
+type EnhancedValidation struct {
+	// ResourceType is the name of the Resource this check applies to, e.g. `azurerm_storage_account`
+	ResourceType string
+
+	// Description is a short description of what this check validates, which is output in the logs
+	Description string
+
+	// Func runs this check against the planned changes, which are available in `metadata.ResourceDiff`.
+	// Any error returned is surfaced as a diagnostic during `terraform plan`.
+	Func func(ctx context.Context, metadata ResourceMetaData) error
+}
+
+// TypedServiceRegistrationWithEnhancedValidation is a superset of TypedServiceRegistration allowing
+// plan-time checks to be registered for the Resources within (or outside of) this Service Package.
+type TypedServiceRegistrationWithEnhancedValidation interface {
+	TypedServiceRegistration
+
+	EnhancedValidations() []EnhancedValidation
+}
+
+// UntypedServiceRegistrationWithEnhancedValidation is a superset of UntypedServiceRegistration allowing
+// plan-time checks to be registered for the Resources within (or outside of) this Service Package.
+type UntypedServiceRegistrationWithEnhancedValidation interface {
+	UntypedServiceRegistration
+
+	EnhancedValidations() []EnhancedValidation
+}
+
+// ApplyEnhancedValidations registers the specified checks within the CustomizeDiff function for this Resource,
+// which are run (in order) once any existing CustomizeDiff function has completed successfully.
+func ApplyEnhancedValidations(resource *schema.Resource, validations []EnhancedValidation) {
+	if len(validations) == 0 {
+		return
+	}
+
+	existing := resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if existing != nil {
+			if err := existing(ctx, d, meta); err != nil {
+				return err
+			}
+		}
+
+		// a CustomizeDiff function can only return an error, so any warnings raised by these checks are logged
+		logger := &DiagnosticsLogger{}
+		defer logEnhancedValidationWarnings(logger)
+
+		metaData := ResourceMetaData{
+			Client:                   meta.(*clients.Client),
+			Logger:                   logger,
+			ResourceDiff:             d,
+			serializationDebugLogger: NullLogger{},
+		}
+		for _, v := range validations {
+			logger.Debugf("Running Enhanced Validation %q..", v.Description)
+			if err := v.Func(ctx, metaData); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func logEnhancedValidationWarnings(logger *DiagnosticsLogger) {
+	for _, v := range logger.diagnostics {
+		if v.Severity == diag.Warning {
+			log.Printf("[WARN] Enhanced Validation: %s", v.Summary)
+		}
+	}
+}
+
+// CapabilityCache caches the capability data used by Enhanced Validation (for example the SKUs available
+// within a Region), keyed by an arbitrary key (for example the Region) - so that this data is retrieved
+// from Azure at most once per key, regardless of how many Resources use it.
+type CapabilityCache struct {
+	lock  sync.Mutex
+	items map[string]*capabilityCacheItem
+}
+
+type capabilityCacheItem struct {
+	// lock is held whilst the value is being retrieved, so that concurrent callers for the same key wait
+	// for (and then use) this value, without blocking callers for other keys
+	lock sync.Mutex
+
+	populated bool
+	value     interface{}
+}
+
+// Get returns the cached value for the specified key, calling `retrieve` to populate this when it's not
+// been cached. Errors aren't cached, so that a transient failure is retried by the next caller.
+func (c *CapabilityCache) Get(key string, retrieve func() (interface{}, error)) (interface{}, error) {
+	c.lock.Lock()
+	if c.items == nil {
+		c.items = make(map[string]*capabilityCacheItem)
+	}
+	item, ok := c.items[key]
+	if !ok {
+		item = &capabilityCacheItem{}
+		c.items[key] = item
+	}
+	c.lock.Unlock()
+
+	item.lock.Lock()
+	defer item.lock.Unlock()
+
+	if item.populated {
+		return item.value, nil
+	}
+
+	value, err := retrieve()
+	if err != nil {
+		return nil, fmt.Errorf("retrieving capabilities for %q: %+v", key, err)
+	}
+
+	item.populated = true
+	item.value = value
+	return value, nil
+}
@@ -0,0 +1,251 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func TestApplyEnhancedValidations(t *testing.T) {
+	testData := []struct {
+		name          string
+		sku           string
+		existingFails bool
+		expectedError string
+		expectedCalls []string
+	}{
+		{
+			name:          "valid",
+			sku:           "Standard",
+			expectedCalls: []string{"existing", "first", "second"},
+		},
+		{
+			name:          "existing customize diff fails",
+			sku:           "Standard",
+			existingFails: true,
+			expectedError: "existing failed",
+			expectedCalls: []string{"existing"},
+		},
+		{
+			name:          "enhanced validation fails",
+			sku:           "Premium",
+			expectedError: "the SKU \"Premium\" is not available",
+			expectedCalls: []string{"existing", "first"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		calls := make([]string, 0)
+		existingFails := v.existingFails
+		resource := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"sku": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+			CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+				calls = append(calls, "existing")
+				if existingFails {
+					return fmt.Errorf("existing failed")
+				}
+				return nil
+			},
+		}
+
+		ApplyEnhancedValidations(resource, []EnhancedValidation{
+			{
+				ResourceType: "azurerm_example",
+				Description:  "first",
+				Func: func(ctx context.Context, metadata ResourceMetaData) error {
+					calls = append(calls, "first")
+					if sku := metadata.ResourceDiff.Get("sku").(string); sku != "Standard" {
+						return fmt.Errorf("the SKU %q is not available", sku)
+					}
+					return nil
+				},
+			},
+			{
+				ResourceType: "azurerm_example",
+				Description:  "second",
+				Func: func(ctx context.Context, metadata ResourceMetaData) error {
+					calls = append(calls, "second")
+					return nil
+				},
+			},
+		})
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"sku": v.sku,
+		})
+		_, err := resource.SimpleDiff(context.TODO(), nil, config, &clients.Client{})
+		if v.expectedError == "" && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.expectedError != "" && (err == nil || !strings.Contains(err.Error(), v.expectedError)) {
+			t.Fatalf("expected the error %q but got: %+v", v.expectedError, err)
+		}
+
+		if strings.Join(calls, ",") != strings.Join(v.expectedCalls, ",") {
+			t.Fatalf("expected the calls %q but got %q", strings.Join(v.expectedCalls, ","), strings.Join(calls, ","))
+		}
+	}
+}
+
+func TestApplyEnhancedValidationsNone(t *testing.T) {
+	resource := &schema.Resource{}
+	ApplyEnhancedValidations(resource, nil)
+	if resource.CustomizeDiff != nil {
+		t.Fatalf("expected no CustomizeDiff function to be registered")
+	}
+}
+
+func TestApplyEnhancedValidationsLogsWarnings(t *testing.T) {
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"sku": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	ApplyEnhancedValidations(resource, []EnhancedValidation{
+		{
+			ResourceType: "azurerm_example",
+			Description:  "warning",
+			Func: func(ctx context.Context, metadata ResourceMetaData) error {
+				metadata.Logger.Warnf("skipping the SKU %q", metadata.ResourceDiff.Get("sku").(string))
+				return nil
+			},
+		},
+	})
+
+	for _, sku := range []string{"Standard", "Premium"} {
+		output.Reset()
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"sku": sku,
+		})
+		if _, err := resource.SimpleDiff(context.TODO(), nil, config, &clients.Client{}); err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		// the warnings are per plan, so only the warning for this SKU should be logged
+		logged := output.String()
+		if expected := fmt.Sprintf("[WARN] Enhanced Validation: skipping the SKU %q", sku); strings.Count(logged, expected) != 1 {
+			t.Fatalf("expected the warning %q to be logged once but got: %s", expected, logged)
+		}
+		if strings.Count(logged, "[WARN]") != 1 {
+			t.Fatalf("expected a single warning to be logged but got: %s", logged)
+		}
+	}
+}
+
+func TestCapabilityCache(t *testing.T) {
+	cache := CapabilityCache{}
+
+	calls := 0
+	retrieve := func() (interface{}, error) {
+		calls++
+		return []string{"Standard_D2s_v3"}, nil
+	}
+	for i := 0; i < 3; i++ {
+		v, err := cache.Get("westeurope", retrieve)
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if len(v.([]string)) != 1 {
+			t.Fatalf("expected 1 item but got %d", len(v.([]string)))
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected the capabilities to be retrieved once but got %d", calls)
+	}
+
+	failures := 0
+	for i := 0; i < 2; i++ {
+		_, err := cache.Get("eastus", func() (interface{}, error) {
+			failures++
+			return nil, fmt.Errorf("bad request")
+		})
+		if err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+	if failures != 2 {
+		t.Fatalf("expected the failure not to be cached but the capabilities were retrieved %d times", failures)
+	}
+
+	// once retrieved successfully, the value is cached
+	for i := 0; i < 2; i++ {
+		if _, err := cache.Get("eastus", retrieve); err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("expected the capabilities to be retrieved twice but got %d", calls)
+	}
+}
+
+func TestCapabilityCacheLocksPerKey(t *testing.T) {
+	cache := CapabilityCache{}
+
+	// retrieving the capabilities for one key mustn't block retrieving the capabilities for another
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = cache.Get("westeurope", func() (interface{}, error) {
+			close(started)
+			<-release
+			return "westeurope", nil
+		})
+	}()
+	<-started
+
+	result := make(chan interface{})
+	go func() {
+		v, _ := cache.Get("eastus", func() (interface{}, error) {
+			return "eastus", nil
+		})
+		result <- v
+	}()
+
+	select {
+	case v := <-result:
+		if v != "eastus" {
+			t.Fatalf("expected %q but got %+v", "eastus", v)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the capabilities for another key to be retrieved whilst the first key was being retrieved")
+	}
+
+	// whereas callers for the same key wait for (and use) the value being retrieved
+	go func() {
+		v, _ := cache.Get("westeurope", func() (interface{}, error) {
+			return "retrieved again", nil
+		})
+		result <- v
+	}()
+	close(release)
+	<-done
+
+	if v := <-result; v != "westeurope" {
+		t.Fatalf("expected the cached value %q but got %+v", "westeurope", v)
+	}
+}
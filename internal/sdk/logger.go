@@ -2,6 +2,13 @@ package sdk
 
 // Logger is an interface for switching out the Logger implementation
 type Logger interface {
+	// Debug prints out a message prefixed with `[DEBUG]` verbatim
+	Debug(message string)
+
+	// Debugf prints out a message prefixed with `[DEBUG]` formatted
+	// with the specified arguments
+	Debugf(format string, args ...interface{})
+
 	// Info prints out a message prefixed with `[INFO]` verbatim
 	Info(message string)
 
@@ -15,4 +22,25 @@ type Logger interface {
 	// Warnf prints out a message prefixed with `[WARN]` formatted
 	// with the specified arguments
 	Warnf(format string, args ...interface{})
+
+	// Error prints out a message prefixed with `[ERROR]` verbatim
+	Error(message string)
+
+	// Errorf prints out a message prefixed with `[ERROR]` formatted
+	// with the specified arguments
+	Errorf(format string, args ...interface{})
+
+	// With returns a copy of this Logger which includes the specified
+	// key/value field within each message
+	With(key string, value interface{}) Logger
+}
+
+// withLogField returns a copy of the fields including the specified key/value
+func withLogField(fields map[string]interface{}, key string, value interface{}) map[string]interface{} {
+	out := make(map[string]interface{})
+	for k, v := range fields {
+		out[k] = v
+	}
+	out[key] = value
+	return out
 }
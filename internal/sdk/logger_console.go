@@ -3,17 +3,40 @@ package sdk
 import (
 	"fmt"
 	"log"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 var _ Logger = ConsoleLogger{}
 
 // ConsoleLogger provides a Logger implementation which writes the log messages
 // to StdOut - in Terraform's perspective that's proxied via the Plugin SDK
-type ConsoleLogger struct{}
+type ConsoleLogger struct {
+	fields map[string]interface{}
+}
+
+func (l ConsoleLogger) print(level, message string) {
+	if len(l.fields) > 0 {
+		message = fmt.Sprintf("%s: %s", message, common.FormatLogFields(l.fields))
+	}
+
+	log.Print(fmt.Sprintf("[%s] %s", level, message))
+}
+
+// Debug prints out a message prefixed with `[DEBUG]` verbatim
+func (l ConsoleLogger) Debug(message string) {
+	l.print("DEBUG", message)
+}
+
+// Debugf prints out a message prefixed with `[DEBUG]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
+}
 
 // Info prints out a message prefixed with `[INFO]` verbatim
-func (ConsoleLogger) Info(message string) {
-	log.Print(fmt.Sprintf("[INFO] %s", message))
+func (l ConsoleLogger) Info(message string) {
+	l.print("INFO", message)
 }
 
 // Infof prints out a message prefixed with `[INFO]` formatted
@@ -24,7 +47,7 @@ func (l ConsoleLogger) Infof(format string, args ...interface{}) {
 
 // Warn prints out a message prefixed with `[WARN]` formatted verbatim
 func (l ConsoleLogger) Warn(message string) {
-	log.Print(fmt.Sprintf("[WARN] %s", message))
+	l.print("WARN", message)
 }
 
 // Warnf prints out a message prefixed with `[WARN]` formatted
@@ -32,3 +55,22 @@ func (l ConsoleLogger) Warn(message string) {
 func (l ConsoleLogger) Warnf(format string, args ...interface{}) {
 	l.Warn(fmt.Sprintf(format, args...))
 }
+
+// Error prints out a message prefixed with `[ERROR]` verbatim
+func (l ConsoleLogger) Error(message string) {
+	l.print("ERROR", message)
+}
+
+// Errorf prints out a message prefixed with `[ERROR]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Errorf(format string, args ...interface{}) {
+	l.Error(fmt.Sprintf(format, args...))
+}
+
+// With returns a copy of this Logger which includes the specified
+// key/value field within each message
+func (l ConsoleLogger) With(key string, value interface{}) Logger {
+	return ConsoleLogger{
+		fields: withLogField(l.fields, key, value),
+	}
+}
@@ -5,24 +5,56 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 var _ Logger = &DiagnosticsLogger{}
 
+// DiagnosticsLogger provides a Logger implementation which surfaces any warnings to
+// the user as Diagnostics, with all other messages written to StdOut
 type DiagnosticsLogger struct {
 	diagnostics diag.Diagnostics
+	fields      map[string]interface{}
+
+	// parent is the Logger this was created from (using `With`), which holds the Diagnostics
+	parent *DiagnosticsLogger
+}
+
+func (d *DiagnosticsLogger) root() *DiagnosticsLogger {
+	if d.parent != nil {
+		return d.parent.root()
+	}
+
+	return d
+}
+
+func (d *DiagnosticsLogger) print(level, message string) {
+	if len(d.fields) > 0 {
+		message = fmt.Sprintf("%s: %s", message, common.FormatLogFields(d.fields))
+	}
+
+	log.Printf("[%s] %s", level, message)
+}
+
+func (d *DiagnosticsLogger) Debug(message string) {
+	d.print("DEBUG", message)
+}
+
+func (d *DiagnosticsLogger) Debugf(format string, args ...interface{}) {
+	d.Debug(fmt.Sprintf(format, args...))
 }
 
 func (d *DiagnosticsLogger) Info(message string) {
-	log.Printf("[INFO] %s", message)
+	d.print("INFO", message)
 }
 
 func (d *DiagnosticsLogger) Infof(format string, args ...interface{}) {
-	log.Printf("[INFO] "+format, args...)
+	d.Info(fmt.Sprintf(format, args...))
 }
 
 func (d *DiagnosticsLogger) Warn(message string) {
-	d.diagnostics = append(d.diagnostics, diag.Diagnostic{
+	root := d.root()
+	root.diagnostics = append(root.diagnostics, diag.Diagnostic{
 		Severity:      diag.Warning,
 		Summary:       message,
 		Detail:        message,
@@ -31,10 +63,20 @@ func (d *DiagnosticsLogger) Warn(message string) {
 }
 
 func (d *DiagnosticsLogger) Warnf(format string, args ...interface{}) {
-	d.diagnostics = append(d.diagnostics, diag.Diagnostic{
-		Severity:      diag.Warning,
-		Summary:       fmt.Sprintf(format, args...),
-		Detail:        fmt.Sprintf(format, args...),
-		AttributePath: nil,
-	})
+	d.Warn(fmt.Sprintf(format, args...))
+}
+
+func (d *DiagnosticsLogger) Error(message string) {
+	d.print("ERROR", message)
+}
+
+func (d *DiagnosticsLogger) Errorf(format string, args ...interface{}) {
+	d.Error(fmt.Sprintf(format, args...))
+}
+
+func (d *DiagnosticsLogger) With(key string, value interface{}) Logger {
+	return &DiagnosticsLogger{
+		fields: withLogField(d.fields, key, value),
+		parent: d.root(),
+	}
 }
@@ -0,0 +1,111 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+var _ Logger = &TerraformLogger{}
+
+// TerraformLogger provides a Logger implementation which writes structured log messages (including
+// any key/value fields) using terraform-plugin-log - and which surfaces any warnings to the user as
+// Diagnostics.
+type TerraformLogger struct {
+	ctx         context.Context
+	diagnostics *diag.Diagnostics
+}
+
+// NewTerraformLogger returns a TerraformLogger which writes to the logger within the specified Context
+func NewTerraformLogger(ctx context.Context) *TerraformLogger {
+	return &TerraformLogger{
+		ctx:         ctx,
+		diagnostics: &diag.Diagnostics{},
+	}
+}
+
+// newOperationLogger returns a TerraformLogger for a single operation (e.g. Create) against a Data Source
+// or Resource, tagged with the Resource Type, Resource ID (when known) and the Correlation Request ID sent
+// to Azure. The returned Context is tagged with the same fields, which are included in the log messages for
+// each HTTP Request made using this Context.
+func newOperationLogger(ctx context.Context, resourceType, resourceId string, meta interface{}) (context.Context, *TerraformLogger) {
+	fields := map[string]interface{}{
+		"resource_type": resourceType,
+	}
+	if resourceId != "" {
+		fields["resource_id"] = resourceId
+	}
+	if client, ok := meta.(*clients.Client); ok && client.CorrelationRequestID != "" {
+		fields["correlation_request_id"] = client.CorrelationRequestID
+	}
+
+	ctx = common.WithLogFields(ctx, fields)
+	for k, v := range fields {
+		ctx = tflog.With(ctx, k, v)
+	}
+
+	return ctx, NewTerraformLogger(ctx)
+}
+
+// Diagnostics returns the warnings logged using this Logger (and any Loggers created from it using `With`)
+func (l *TerraformLogger) Diagnostics() diag.Diagnostics {
+	return *l.diagnostics
+}
+
+// Debug writes a message at the Debug level verbatim
+func (l *TerraformLogger) Debug(message string) {
+	tflog.Debug(l.ctx, message)
+}
+
+// Debugf writes a message at the Debug level formatted with the specified arguments
+func (l *TerraformLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
+}
+
+// Info writes a message at the Info level verbatim
+func (l *TerraformLogger) Info(message string) {
+	tflog.Info(l.ctx, message)
+}
+
+// Infof writes a message at the Info level formatted with the specified arguments
+func (l *TerraformLogger) Infof(format string, args ...interface{}) {
+	l.Info(fmt.Sprintf(format, args...))
+}
+
+// Warn writes a message at the Warn level verbatim, which is also surfaced as a Diagnostic
+func (l *TerraformLogger) Warn(message string) {
+	tflog.Warn(l.ctx, message)
+	*l.diagnostics = append(*l.diagnostics, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  message,
+		Detail:   message,
+	})
+}
+
+// Warnf writes a message at the Warn level formatted with the specified arguments, which is also
+// surfaced as a Diagnostic
+func (l *TerraformLogger) Warnf(format string, args ...interface{}) {
+	l.Warn(fmt.Sprintf(format, args...))
+}
+
+// Error writes a message at the Error level verbatim
+func (l *TerraformLogger) Error(message string) {
+	tflog.Error(l.ctx, message)
+}
+
+// Errorf writes a message at the Error level formatted with the specified arguments
+func (l *TerraformLogger) Errorf(format string, args ...interface{}) {
+	l.Error(fmt.Sprintf(format, args...))
+}
+
+// With returns a copy of this Logger which includes the specified key/value field within each message
+func (l *TerraformLogger) With(key string, value interface{}) Logger {
+	return &TerraformLogger{
+		ctx:         tflog.With(l.ctx, key, value),
+		diagnostics: l.diagnostics,
+	}
+}
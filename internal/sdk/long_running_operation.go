@@ -0,0 +1,198 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+// WaitForCreation waits for the Long Running Operation used to Create this Resource to complete.
+//
+// Should the Create time out whilst this operation is still in progress, the Resource ID is set and
+// the operation (including the Polling URL) is persisted into the Private State of the Resource. The
+// Create then completes with a Warning rather than an Error, and polling is resumed during the next
+// Terraform run - rather than requiring that the Resource is imported.
+//
+// NOTE: this is only possible when the Provider is served using NewProviderServer, otherwise this
+// returns the error from the Long Running Operation as-is.
+func (rmd ResourceMetaData) WaitForCreation(ctx context.Context, id resourceid.Formatter, future azure.FutureAPI, client autorest.Client) error {
+	err := future.WaitForCompletionRef(ctx, client)
+	if err == nil {
+		return nil
+	}
+
+	state := privateStateFromContext(ctx)
+	if state == nil || ctx.Err() != context.DeadlineExceeded {
+		return err
+	}
+
+	raw, marshalErr := future.MarshalJSON()
+	if marshalErr != nil {
+		rmd.Logger.Warnf("unable to persist the Long Running Operation for %s: %+v", id, marshalErr)
+		return err
+	}
+
+	rmd.Logger.Infof("the Create of %s timed out with the Long Running Operation still in progress - persisting the Polling URL %q", id, future.PollingURL())
+	state.setLongRunningOperation(&longRunningOperation{
+		ResourceId: id.ID(),
+		Future:     raw,
+	})
+	rmd.SetID(id)
+
+	return err
+}
+
+// createStillInProgress returns whether the Create of this Resource timed out with the Long Running
+// Operation still in progress, which has been persisted to resume during the next Terraform run
+func createStillInProgress(ctx context.Context, d *schema.ResourceData) bool {
+	state := privateStateFromContext(ctx)
+	if state == nil || d.Id() == "" {
+		return false
+	}
+
+	operation := state.longRunningOperation()
+	return operation != nil && operation.ResourceId == d.Id()
+}
+
+// persistedLongRunningOperation returns the Long Running Operation for the Create of this Resource which was
+// persisted during a previous Terraform run, discarding it if it's no longer applicable
+func persistedLongRunningOperation(ctx context.Context, metadata ResourceMetaData) (*privateState, *longRunningOperation, *azure.Future) {
+	state := privateStateFromContext(ctx)
+	if state == nil {
+		return nil, nil, nil
+	}
+	operation := state.longRunningOperation()
+	if operation == nil {
+		return nil, nil, nil
+	}
+
+	id := metadata.ResourceData.Id()
+	if operation.ResourceId != id {
+		metadata.Logger.Infof("discarding the Long Running Operation for %q since the Resource ID is now %q", operation.ResourceId, id)
+		state.setLongRunningOperation(nil)
+		return nil, nil, nil
+	}
+
+	var future azure.Future
+	if err := future.UnmarshalJSON(operation.Future); err != nil {
+		metadata.Logger.Warnf("discarding the Long Running Operation for the Create of %s since it couldn't be resumed: %+v", id, err)
+		state.setLongRunningOperation(nil)
+		return nil, nil, nil
+	}
+
+	return state, operation, &future
+}
+
+// checkLongRunningOperation checks the status of the Long Running Operation for the Create of this Resource,
+// when one was persisted during a previous Terraform run - returning whether the operation is still in progress.
+//
+// This is used during a Read (e.g. when refreshing during a Plan) and so only checks the status once, rather
+// than waiting for the operation to complete - which is instead done during an Apply.
+func checkLongRunningOperation(ctx context.Context, metadata ResourceMetaData) bool {
+	state, operation, future := persistedLongRunningOperation(ctx, metadata)
+	if operation == nil {
+		return false
+	}
+
+	id := metadata.ResourceData.Id()
+	done, err := future.DoneWithContext(ctx, metadata.Client.LongRunningOperationsClient)
+	if (err == nil && !done) || ctx.Err() != nil {
+		updateLongRunningOperation(state, operation, future)
+		metadata.Logger.Warnf("the Create of %s is still in progress - polling will be resumed during the next Terraform run", id)
+		return true
+	}
+
+	completeLongRunningOperation(state, metadata, err)
+	return false
+}
+
+// resumeLongRunningOperation resumes polling the Long Running Operation for the Create of this Resource,
+// when one was persisted during a previous Terraform run - returning whether the operation is still in
+// progress.
+//
+// Polling is resumed for up to the Create timeout for the Resource, rather than the timeout for the
+// current operation (e.g. Update), since this is finishing off the Create - however this can still be
+// cancelled (e.g. by an interrupt).
+func resumeLongRunningOperation(ctx context.Context, metadata ResourceMetaData) bool {
+	state, operation, future := persistedLongRunningOperation(ctx, metadata)
+	if operation == nil {
+		return false
+	}
+
+	cancellation := state.cancellation
+	if cancellation == nil {
+		cancellation = ctx
+	}
+
+	id := metadata.ResourceData.Id()
+	timeout := metadata.ResourceData.Timeout(schema.TimeoutCreate)
+	pollingCtx, cancel := context.WithTimeout(detachedContext{parent: ctx, cancellation: cancellation}, timeout)
+	defer cancel()
+
+	metadata.Logger.Infof("resuming polling the Long Running Operation for the Create of %s (Polling URL %q) for up to %s", id, future.PollingURL(), timeout)
+	err := future.WaitForCompletionRef(pollingCtx, *metadata.Client.LongRunningOperationsClient)
+	if err != nil && pollingCtx.Err() != nil {
+		updateLongRunningOperation(state, operation, future)
+		metadata.Logger.Warnf("the Create of %s is still in progress - polling will be resumed during the next Terraform run", id)
+		return true
+	}
+
+	completeLongRunningOperation(state, metadata, err)
+	return false
+}
+
+// updateLongRunningOperation persists the latest polling state of the Long Running Operation
+func updateLongRunningOperation(state *privateState, operation *longRunningOperation, future *azure.Future) {
+	if raw, err := future.MarshalJSON(); err == nil {
+		operation.Future = raw
+	}
+	state.setLongRunningOperation(operation)
+}
+
+// completeLongRunningOperation removes the Long Running Operation once it's completed (or failed)
+func completeLongRunningOperation(state *privateState, metadata ResourceMetaData, err error) {
+	id := metadata.ResourceData.Id()
+	state.setLongRunningOperation(nil)
+	if err != nil {
+		metadata.Logger.Warnf("the Create of %s which was resumed from a previous Terraform run failed: %+v\n\nThis Resource may need to be replaced (for example using `terraform apply -replace`)", id, err)
+		return
+	}
+
+	metadata.Logger.Infof("the Create of %s which was resumed from a previous Terraform run has completed", id)
+}
+
+// stillInProgressError returns the error returned when attempting to Update a Resource whose Create is
+// still in progress
+func stillInProgressError(id string) error {
+	return fmt.Errorf("the Create of %q is still in progress - this will be resumed during the next Terraform run", id)
+}
+
+// detachedContext is a Context which retains the values of the parent Context but not its deadline - allowing
+// an operation to run for longer than the parent Context allows, whilst still being cancellable
+type detachedContext struct {
+	parent context.Context
+
+	// cancellation is used to determine whether the operation has been cancelled, and shouldn't have a deadline
+	cancellation context.Context
+}
+
+func (c detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (c detachedContext) Done() <-chan struct{} {
+	return c.cancellation.Done()
+}
+
+func (c detachedContext) Err() error {
+	return c.cancellation.Err()
+}
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
@@ -0,0 +1,239 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// longRunningOperationPrivateStateKey is the key within a Resource's Private State which is used to
+// persist a Long Running Operation which was still in progress when the operation timed out
+const longRunningOperationPrivateStateKey = "azurerm_long_running_operation"
+
+// longRunningOperation is a Long Running Operation persisted into the Private State of a Resource
+type longRunningOperation struct {
+	// ResourceId is the ID of the Resource which is being Created
+	ResourceId string `json:"resource_id"`
+
+	// Future is the serialized `azure.Future` (including the Polling URL) used to resume polling
+	Future json.RawMessage `json:"future"`
+}
+
+// privateState is the Private State for a Resource which is made available to the Resource
+// via the Context, since the Plugin SDK doesn't expose this to Resources directly
+type privateState struct {
+	sync.Mutex
+
+	operation *longRunningOperation
+
+	// cancellation is cancelled when the request is cancelled or the Provider is stopped, but has no
+	// deadline - allowing polling to be resumed for longer than the timeout of the current operation
+	cancellation context.Context
+}
+
+type privateStateKey struct{}
+
+func withPrivateState(ctx context.Context, state *privateState) context.Context {
+	return context.WithValue(ctx, privateStateKey{}, state)
+}
+
+// privateStateFromContext returns the Private State for the Resource, or nil if this isn't available
+// (for example when the Provider isn't being served via NewProviderServer)
+func privateStateFromContext(ctx context.Context) *privateState {
+	if v, ok := ctx.Value(privateStateKey{}).(*privateState); ok {
+		return v
+	}
+
+	return nil
+}
+
+func (s *privateState) longRunningOperation() *longRunningOperation {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.operation
+}
+
+func (s *privateState) setLongRunningOperation(input *longRunningOperation) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.operation = input
+}
+
+// decodePrivateState returns the privateState for the specified Private State sent by Terraform Core
+func decodePrivateState(input []byte) (*privateState, error) {
+	state := &privateState{}
+	if len(input) == 0 {
+		return state, nil
+	}
+
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(input, &raw); err != nil {
+		return nil, fmt.Errorf("unmarshaling Private State: %+v", err)
+	}
+
+	if v, ok := raw[longRunningOperationPrivateStateKey]; ok {
+		var operation longRunningOperation
+		if err := json.Unmarshal(v, &operation); err != nil {
+			return nil, fmt.Errorf("unmarshaling %q from the Private State: %+v", longRunningOperationPrivateStateKey, err)
+		}
+		state.operation = &operation
+	}
+
+	return state, nil
+}
+
+// encodePrivateState merges the privateState into the Private State returned by the Plugin SDK
+func encodePrivateState(input []byte, state *privateState) ([]byte, error) {
+	raw := make(map[string]json.RawMessage)
+	if len(input) > 0 {
+		if err := json.Unmarshal(input, &raw); err != nil {
+			return nil, fmt.Errorf("unmarshaling Private State: %+v", err)
+		}
+	}
+
+	delete(raw, longRunningOperationPrivateStateKey)
+	if operation := state.longRunningOperation(); operation != nil {
+		v, err := json.Marshal(operation)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling %q into the Private State: %+v", longRunningOperationPrivateStateKey, err)
+		}
+		raw[longRunningOperationPrivateStateKey] = v
+	}
+
+	return json.Marshal(raw)
+}
+
+var _ tfprotov5.ProviderServer = privateStateProviderServer{}
+
+// privateStateProviderServer wraps the ProviderServer from the Plugin SDK, making the Private State
+// for each Resource available to (and updatable by) the Resource via the Context
+type privateStateProviderServer struct {
+	tfprotov5.ProviderServer
+
+	stop *stopSignal
+}
+
+// NewProviderServer wraps the ProviderServer from the Plugin SDK so that Typed Resources can persist
+// data into the Private State of a Resource - which is used to resume Long Running Operations which
+// were still in progress when the Create timed out.
+func NewProviderServer(server tfprotov5.ProviderServer) tfprotov5.ProviderServer {
+	return privateStateProviderServer{
+		ProviderServer: server,
+		stop: &stopSignal{
+			ch: make(chan struct{}),
+		},
+	}
+}
+
+func (s privateStateProviderServer) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	s.stop.stop()
+	return s.ProviderServer.StopProvider(ctx, req)
+}
+
+func (s privateStateProviderServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	state, err := decodePrivateState(req.Private)
+	if err != nil {
+		return nil, err
+	}
+
+	hadOperation := state.longRunningOperation() != nil
+
+	resp, err := s.ProviderServer.ReadResource(withPrivateState(ctx, state), req)
+	if err != nil || resp == nil || !hadOperation {
+		return resp, err
+	}
+
+	// the Plugin SDK echoes back the Private State it was sent, so this needs to be re-encoded
+	// to either persist the updated operation, or remove it once it's completed (or failed)
+	if resp.Private, err = encodePrivateState(resp.Private, state); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s privateStateProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil || len(resp.PlannedPrivate) == 0 || len(resp.RequiresReplace) > 0 {
+		return resp, err
+	}
+
+	// carry over the Long Running Operation from the Prior State, since the Plugin SDK
+	// builds the Planned Private State from scratch
+	state, err := decodePrivateState(req.PriorPrivate)
+	if err != nil {
+		return nil, err
+	}
+	if state.longRunningOperation() == nil {
+		return resp, nil
+	}
+
+	if resp.PlannedPrivate, err = encodePrivateState(resp.PlannedPrivate, state); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s privateStateProviderServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	state, err := decodePrivateState(req.PlannedPrivate)
+	if err != nil {
+		return nil, err
+	}
+
+	cancellation, cancel := s.stop.stoppableContext(ctx)
+	defer cancel()
+	state.cancellation = cancellation
+
+	resp, err := s.ProviderServer.ApplyResourceChange(withPrivateState(ctx, state), req)
+	// the Plugin SDK only returns a Private State when the Resource exists
+	if err != nil || resp == nil || len(resp.Private) == 0 {
+		return resp, err
+	}
+
+	if resp.Private, err = encodePrivateState(resp.Private, state); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// stopSignal tracks requests from Terraform Core to stop the Provider (for example on an interrupt), since
+// the Plugin SDK only exposes this through the Context passed to the Resource, which also has a deadline
+type stopSignal struct {
+	sync.Mutex
+
+	ch chan struct{}
+}
+
+func (s *stopSignal) stop() {
+	s.Lock()
+	defer s.Unlock()
+
+	close(s.ch)
+	s.ch = make(chan struct{})
+}
+
+// stoppableContext returns a Context which is cancelled when either the parent Context is cancelled
+// or the Provider is stopped
+func (s *stopSignal) stoppableContext(parent context.Context) (context.Context, context.CancelFunc) {
+	s.Lock()
+	stop := s.ch
+	s.Unlock()
+
+	ctx, cancel := context.WithCancel(parent)
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
@@ -0,0 +1,213 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type fakeProviderServer struct {
+	tfprotov5.ProviderServer
+
+	// operation is the Long Running Operation set by the Resource during an Apply
+	operation *longRunningOperation
+
+	// clearOperation specifies whether the Resource completes the Long Running Operation during a Read
+	clearOperation bool
+
+	requiresReplace bool
+
+	// apply is called with the Context passed to the Resource during an Apply
+	apply func(ctx context.Context)
+}
+
+func (f fakeProviderServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	if f.clearOperation {
+		privateStateFromContext(ctx).setLongRunningOperation(nil)
+	}
+
+	// the Plugin SDK echoes back the Private State from the request
+	return &tfprotov5.ReadResourceResponse{
+		Private: req.Private,
+	}, nil
+}
+
+func (f fakeProviderServer) PlanResourceChange(_ context.Context, _ *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp := &tfprotov5.PlanResourceChangeResponse{
+		PlannedPrivate: []byte(`{"schema_version":"0"}`),
+	}
+	if f.requiresReplace {
+		resp.RequiresReplace = []*tftypes.AttributePath{
+			tftypes.NewAttributePath().WithAttributeName("name"),
+		}
+	}
+
+	return resp, nil
+}
+
+func (f fakeProviderServer) ApplyResourceChange(ctx context.Context, _ *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	if f.operation != nil {
+		privateStateFromContext(ctx).setLongRunningOperation(f.operation)
+	}
+	if f.apply != nil {
+		f.apply(ctx)
+	}
+
+	return &tfprotov5.ApplyResourceChangeResponse{
+		Private: []byte(`{"schema_version":"0"}`),
+	}, nil
+}
+
+func (f fakeProviderServer) StopProvider(_ context.Context, _ *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	return &tfprotov5.StopProviderResponse{}, nil
+}
+
+func TestPrivateStateProviderServerPersistsLongRunningOperation(t *testing.T) {
+	ctx := context.TODO()
+	operation := &longRunningOperation{
+		ResourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+		Future:     json.RawMessage(`{"method":"PUT","pollingURI":"https://management.azure.com/operations/123"}`),
+	}
+
+	applyResp, err := NewProviderServer(fakeProviderServer{operation: operation}).ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{})
+	if err != nil {
+		t.Fatalf("applying: %+v", err)
+	}
+	assertPrivateStateOperation(t, applyResp.Private, operation)
+
+	// the existing Private State from the Plugin SDK must be retained
+	raw := make(map[string]interface{})
+	if err := json.Unmarshal(applyResp.Private, &raw); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	if _, ok := raw["schema_version"]; !ok {
+		t.Fatalf("expected the existing Private State to be retained but got %s", string(applyResp.Private))
+	}
+
+	// the operation is carried over into the Plan, unless the Resource is being replaced
+	planResp, err := NewProviderServer(fakeProviderServer{}).PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		PriorPrivate: applyResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	assertPrivateStateOperation(t, planResp.PlannedPrivate, operation)
+
+	planResp, err = NewProviderServer(fakeProviderServer{requiresReplace: true}).PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		PriorPrivate: applyResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	assertPrivateStateOperation(t, planResp.PlannedPrivate, nil)
+
+	// the operation is retained across a Read until it's completed
+	readResp, err := NewProviderServer(fakeProviderServer{}).ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		Private: applyResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+	assertPrivateStateOperation(t, readResp.Private, operation)
+
+	readResp, err = NewProviderServer(fakeProviderServer{clearOperation: true}).ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		Private: applyResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+	assertPrivateStateOperation(t, readResp.Private, nil)
+
+	// the remainder of the Private State is retained once the operation is removed
+	raw = make(map[string]interface{})
+	if err := json.Unmarshal(readResp.Private, &raw); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	if _, ok := raw["schema_version"]; !ok {
+		t.Fatalf("expected the existing Private State to be retained but got %s", string(readResp.Private))
+	}
+}
+
+func TestDetachedContext(t *testing.T) {
+	type key struct{}
+	parent, cancelParent := context.WithTimeout(context.WithValue(context.TODO(), key{}, "value"), time.Nanosecond)
+	defer cancelParent()
+	<-parent.Done()
+
+	cancellation, cancel := context.WithCancel(context.TODO())
+	ctx := detachedContext{parent: parent, cancellation: cancellation}
+	if ctx.Err() != nil {
+		t.Fatalf("expected the detached context not to be cancelled when the parent's deadline is exceeded but got %+v", ctx.Err())
+	}
+	if _, ok := ctx.Deadline(); ok {
+		t.Fatalf("expected the detached context not to have a deadline")
+	}
+	if v := ctx.Value(key{}); v != "value" {
+		t.Fatalf("expected the detached context to retain the value from the parent but got %+v", v)
+	}
+
+	cancel()
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatalf("expected the detached context to be cancelled")
+	}
+	if ctx.Err() != context.Canceled {
+		t.Fatalf("expected the detached context to be cancelled but got %+v", ctx.Err())
+	}
+}
+
+func TestPrivateStateProviderServerStopCancelsApply(t *testing.T) {
+	var server tfprotov5.ProviderServer
+	var cancellation context.Context
+	server = NewProviderServer(fakeProviderServer{
+		apply: func(ctx context.Context) {
+			cancellation = privateStateFromContext(ctx).cancellation
+			if cancellation.Err() != nil {
+				t.Fatalf("expected the cancellation context not to be cancelled but got %+v", cancellation.Err())
+			}
+
+			if _, err := server.StopProvider(ctx, &tfprotov5.StopProviderRequest{}); err != nil {
+				t.Fatalf("stopping: %+v", err)
+			}
+			select {
+			case <-cancellation.Done():
+			case <-time.After(time.Second):
+				t.Fatalf("expected the cancellation context to be cancelled when the Provider is stopped")
+			}
+		},
+	})
+
+	if _, err := server.ApplyResourceChange(context.TODO(), &tfprotov5.ApplyResourceChangeRequest{}); err != nil {
+		t.Fatalf("applying: %+v", err)
+	}
+	if cancellation == nil {
+		t.Fatalf("expected a cancellation context to be made available to the Resource")
+	}
+}
+
+func assertPrivateStateOperation(t *testing.T, private []byte, expected *longRunningOperation) {
+	state, err := decodePrivateState(private)
+	if err != nil {
+		t.Fatalf("decoding the Private State: %+v", err)
+	}
+
+	actual := state.longRunningOperation()
+	if expected == nil {
+		if actual != nil {
+			t.Fatalf("expected no Long Running Operation but got %+v", actual)
+		}
+		return
+	}
+
+	if actual == nil {
+		t.Fatalf("expected a Long Running Operation but didn't get one")
+	}
+	if actual.ResourceId != expected.ResourceId || string(actual.Future) != string(expected.Future) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
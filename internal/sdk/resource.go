@@ -92,6 +92,18 @@ type ResourceWithCustomImporter interface {
 	CustomImporter() ResourceRunFunc
 }
 
+// ResourceWithImportByName is an optional interface
+//
+// Resources implementing this interface can be imported using the names of each segment
+// of the Resource ID (e.g. `{resourceGroup}/{name}`) in addition to the Resource ID.
+type ResourceWithImportByName interface {
+	Resource
+
+	// IDFromNames returns a function which builds the Resource ID from the names of each segment,
+	// typically using the `{Name}IDFromNames` function generated for the Resource ID
+	IDFromNames() pluginsdk.IDFromNamesFunc
+}
+
 // ResourceWithUpdate is an optional interface
 //
 // Notably the Arguments for Resources implementing this interface
@@ -0,0 +1,144 @@
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+// FieldCodec converts between the value of a model field using a custom type and the
+// value stored in the Terraform Schema - for example a `time.Time` stored as an RFC3339 string
+type FieldCodec interface {
+	// Decode converts the value from the Terraform Schema into a value of the custom type
+	Decode(input interface{}) (interface{}, error)
+
+	// Encode converts a value of the custom type into a value which can be stored in the Terraform Schema
+	Encode(input interface{}) (interface{}, error)
+}
+
+var (
+	fieldCodecs     = map[reflect.Type]FieldCodec{}
+	fieldCodecsLock = sync.RWMutex{}
+)
+
+func init() {
+	RegisterFieldCodec(reflect.TypeOf(time.Time{}), TimeCodec{})
+}
+
+// RegisterFieldCodec registers the FieldCodec used to Encode/Decode model fields of the specified type.
+// Model fields can then use either this type or a pointer to it, where a nil pointer represents null.
+//
+// Example Usage:
+//
+//	func init() {
+//		sdk.RegisterFieldCodec(reflect.TypeOf(parse.VaultId{}), sdk.ResourceIdCodec(parse.VaultID))
+//	}
+func RegisterFieldCodec(fieldType reflect.Type, codec FieldCodec) {
+	fieldCodecsLock.Lock()
+	defer fieldCodecsLock.Unlock()
+
+	fieldCodecs[fieldType] = codec
+}
+
+func fieldCodecFor(fieldType reflect.Type) (FieldCodec, bool) {
+	fieldCodecsLock.RLock()
+	defer fieldCodecsLock.RUnlock()
+
+	codec, ok := fieldCodecs[fieldType]
+	return codec, ok
+}
+
+var _ FieldCodec = TimeCodec{}
+
+// TimeCodec round-trips a `time.Time` as an RFC3339 formatted string, which is registered by default
+type TimeCodec struct{}
+
+func (TimeCodec) Decode(input interface{}) (interface{}, error) {
+	v, ok := input.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected a string but got %T", input)
+	}
+
+	if v == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, v)
+}
+
+func (TimeCodec) Encode(input interface{}) (interface{}, error) {
+	v, ok := input.(time.Time)
+	if !ok {
+		return nil, fmt.Errorf("expected a time.Time but got %T", input)
+	}
+
+	if v.IsZero() {
+		return "", nil
+	}
+
+	return v.Format(time.RFC3339), nil
+}
+
+var _ FieldCodec = resourceIdCodec{}
+
+type resourceIdCodec struct {
+	parseFunc reflect.Value
+	idType    reflect.Type
+}
+
+// ResourceIdCodec returns a FieldCodec for a Resource ID type, which is stored in the Terraform Schema as
+// a string. The specified parse function must have the signature `func(input string) (*T, error)`, where
+// `T` exposes an `ID()` method - for example `parse.VaultID`
+func ResourceIdCodec(parseFunc interface{}) FieldCodec {
+	fn := reflect.ValueOf(parseFunc)
+	fnType := fn.Type()
+	if fnType.Kind() != reflect.Func || fnType.NumIn() != 1 || fnType.In(0).Kind() != reflect.String || fnType.NumOut() != 2 || fnType.Out(0).Kind() != reflect.Ptr {
+		panic(fmt.Sprintf("expected a parse function with the signature `func(string) (*T, error)` but got %s", fnType))
+	}
+
+	idType := fnType.Out(0).Elem()
+	if !reflect.PtrTo(idType).Implements(reflect.TypeOf((*resourceid.Formatter)(nil)).Elem()) {
+		panic(fmt.Sprintf("the Resource ID type %s must implement resourceid.Formatter", idType))
+	}
+
+	return resourceIdCodec{
+		parseFunc: fn,
+		idType:    idType,
+	}
+}
+
+func (c resourceIdCodec) Decode(input interface{}) (interface{}, error) {
+	v, ok := input.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected a string but got %T", input)
+	}
+
+	if v == "" {
+		return reflect.Zero(c.idType).Interface(), nil
+	}
+
+	out := c.parseFunc.Call([]reflect.Value{reflect.ValueOf(v)})
+	if err, ok := out[1].Interface().(error); ok && err != nil {
+		return nil, err
+	}
+
+	return out[0].Elem().Interface(), nil
+}
+
+func (c resourceIdCodec) Encode(input interface{}) (interface{}, error) {
+	if reflect.TypeOf(input) != c.idType {
+		return nil, fmt.Errorf("expected a %s but got %T", c.idType, input)
+	}
+
+	if reflect.ValueOf(input).IsZero() {
+		return "", nil
+	}
+
+	// the ID() method may use a pointer receiver, so call it via an addressable copy
+	id := reflect.New(c.idType)
+	id.Elem().Set(reflect.ValueOf(input))
+	return id.Interface().(resourceid.Formatter).ID(), nil
+}
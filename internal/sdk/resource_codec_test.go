@@ -0,0 +1,96 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testResourceId is a Resource ID type with an ID of the format `/things/{name}`
+type testResourceId struct {
+	Name string
+}
+
+func (id testResourceId) ID() string {
+	return fmt.Sprintf("/things/%s", id.Name)
+}
+
+func parseTestResourceId(input string) (*testResourceId, error) {
+	if !strings.HasPrefix(input, "/things/") {
+		return nil, fmt.Errorf("expected an ID in the format `/things/{name}` but got %q", input)
+	}
+
+	return &testResourceId{
+		Name: strings.TrimPrefix(input, "/things/"),
+	}, nil
+}
+
+func TestTimeCodec(t *testing.T) {
+	codec := TimeCodec{}
+	expected := time.Date(2022, 10, 1, 12, 30, 0, 0, time.UTC)
+
+	encoded, err := codec.Encode(expected)
+	if err != nil {
+		t.Fatalf("encoding: %+v", err)
+	}
+	if encoded != "2022-10-01T12:30:00Z" {
+		t.Fatalf("expected the encoded value to be %q but got %q", "2022-10-01T12:30:00Z", encoded)
+	}
+
+	decoded, err := codec.Decode(encoded)
+	if err != nil {
+		t.Fatalf("decoding: %+v", err)
+	}
+	if !decoded.(time.Time).Equal(expected) {
+		t.Fatalf("expected the decoded value to be %s but got %s", expected, decoded)
+	}
+
+	if _, err := codec.Decode("2022-10-01"); err == nil {
+		t.Fatalf("expected an error decoding a value which isn't RFC3339 but didn't get one")
+	}
+
+	if _, err := codec.Encode("2022-10-01T12:30:00Z"); err == nil {
+		t.Fatalf("expected an error encoding a value which isn't a time.Time but didn't get one")
+	}
+}
+
+func TestResourceIdCodec(t *testing.T) {
+	codec := ResourceIdCodec(parseTestResourceId)
+
+	decoded, err := codec.Decode("/things/example")
+	if err != nil {
+		t.Fatalf("decoding: %+v", err)
+	}
+	if decoded != (testResourceId{Name: "example"}) {
+		t.Fatalf("expected the decoded value to be %+v but got %+v", testResourceId{Name: "example"}, decoded)
+	}
+
+	encoded, err := codec.Encode(decoded)
+	if err != nil {
+		t.Fatalf("encoding: %+v", err)
+	}
+	if encoded != "/things/example" {
+		t.Fatalf("expected the encoded value to be %q but got %q", "/things/example", encoded)
+	}
+
+	if _, err := codec.Decode("/other/example"); err == nil {
+		t.Fatalf("expected an error decoding an invalid Resource ID but didn't get one")
+	}
+
+	if _, err := codec.Encode("/things/example"); err == nil {
+		t.Fatalf("expected an error encoding a value which isn't a testResourceId but didn't get one")
+	}
+}
+
+func TestResourceIdCodecInvalidParseFunc(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("expected a panic for an invalid parse function but didn't get one")
+		}
+	}()
+
+	ResourceIdCodec(func(input string) (string, error) {
+		return input, nil
+	})
+}
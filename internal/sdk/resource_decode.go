@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -11,6 +12,11 @@ import (
 // NOTE: this object must be passed by value - and must contain `tfschema`
 // struct tags for all fields
 //
+// Fields which are pointers (e.g. `*bool` or `*int64`) are left as nil when
+// the field is null in the configuration, allowing "not set" to be told apart
+// from the zero value. Fields can also use any type which has a FieldCodec
+// registered, such as `time.Time` or a Resource ID.
+//
 // Example Usage:
 //
 // type Person struct {
@@ -42,6 +48,12 @@ type stateRetriever interface {
 	GetOkExists(key string) (interface{}, bool)
 }
 
+// rawConfigRetriever is implemented by both the ResourceData and ResourceDiff, exposing the raw configuration
+// so that a field which is null can be told apart from one which is set to the zero value
+type rawConfigRetriever interface {
+	GetRawConfig() cty.Value
+}
+
 func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debugLogger Logger) error {
 	if reflect.TypeOf(input).Kind() != reflect.Ptr {
 		return fmt.Errorf("need a pointer")
@@ -53,7 +65,13 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 		debugLogger.Infof("Field", field)
 
 		if val, exists := field.Tag.Lookup("tfschema"); exists {
-			tfschemaValue, valExists := stateRetriever.GetOkExists(val)
+			var tfschemaValue interface{}
+			var valExists bool
+			if field.Type.Kind() == reflect.Ptr {
+				tfschemaValue, valExists = getNullableValue(stateRetriever, val)
+			} else {
+				tfschemaValue, valExists = stateRetriever.GetOkExists(val)
+			}
 			if !valExists {
 				continue
 			}
@@ -70,6 +88,23 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 	return nil
 }
 
+// getNullableValue returns the value of the specified key when it's set in the configuration (including to the
+// zero value), falling back to GetOkExists when the configuration isn't available (for example during a Read)
+func getNullableValue(stateRetriever stateRetriever, key string) (interface{}, bool) {
+	if retriever, ok := stateRetriever.(rawConfigRetriever); ok {
+		config := retriever.GetRawConfig()
+		if config.IsKnown() && !config.IsNull() && config.Type().IsObjectType() && config.Type().HasAttribute(key) {
+			if config.GetAttr(key).IsNull() {
+				return nil, false
+			}
+
+			return stateRetriever.Get(key), true
+		}
+	}
+
+	return stateRetriever.GetOkExists(key)
+}
+
 func setValue(input, tfschemaValue interface{}, index int, fieldName string, debugLogger Logger) (errOut error) {
 	debugLogger.Infof("setting list value for %q..", fieldName)
 	defer func() {
@@ -84,6 +119,26 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 		}
 	}()
 
+	field := reflect.ValueOf(input).Elem().Field(index)
+	if codec, ok := fieldCodecFor(field.Type()); ok {
+		if tfschemaValue == nil {
+			return nil
+		}
+
+		debugLogger.Infof("[CODEC] Decode %+v", tfschemaValue)
+		decoded, err := codec.Decode(tfschemaValue)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(decoded))
+		return nil
+	}
+
+	if field.Kind() == reflect.Ptr {
+		debugLogger.Infof("[POINTER] Decode %+v", tfschemaValue)
+		return setPointerValue(field, tfschemaValue)
+	}
+
 	if v, ok := tfschemaValue.(string); ok {
 		debugLogger.Infof("[String] Decode %+v", v)
 		debugLogger.Infof("Input %+v", reflect.ValueOf(input))
@@ -176,6 +231,18 @@ func setListValue(input interface{}, index int, fieldName string, v []interface{
 		reflect.ValueOf(input).Elem().Field(index).Set(bSlice)
 
 	default:
+		if isPrimitiveKind(fieldType.Elem().Kind()) {
+			// e.g. a slice of an enum type such as `[]TablePlan`
+			slice := reflect.MakeSlice(fieldType, len(v), len(v))
+			for i, val := range v {
+				if err := setPrimitiveValue(slice.Index(i), val); err != nil {
+					return err
+				}
+			}
+			reflect.ValueOf(input).Elem().Field(index).Set(slice)
+			return nil
+		}
+
 		valueToSet := reflect.MakeSlice(reflect.ValueOf(input).Elem().Field(index).Type(), 0, 0)
 		debugLogger.Infof("List Type", valueToSet.Type())
 
@@ -216,3 +283,86 @@ func setListValue(input interface{}, index int, fieldName string, v []interface{
 
 	return nil
 }
+
+// setPointerValue sets the pointer field to the value from the Terraform Schema - leaving it as nil when
+// there's no value, or when the value is empty and the type of the field uses a FieldCodec
+func setPointerValue(field reflect.Value, tfschemaValue interface{}) error {
+	if tfschemaValue == nil {
+		return nil
+	}
+
+	elemType := field.Type().Elem()
+	ptr := reflect.New(elemType)
+	if codec, ok := fieldCodecFor(elemType); ok {
+		if reflect.ValueOf(tfschemaValue).IsZero() {
+			return nil
+		}
+
+		decoded, err := codec.Decode(tfschemaValue)
+		if err != nil {
+			return err
+		}
+		ptr.Elem().Set(reflect.ValueOf(decoded))
+	} else if err := setPrimitiveValue(ptr.Elem(), tfschemaValue); err != nil {
+		return err
+	}
+
+	field.Set(ptr)
+	return nil
+}
+
+func isPrimitiveKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.String, reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+
+	return false
+}
+
+// setPrimitiveValue sets the value from the Terraform Schema into the target, which can be a named
+// type (such as an enum) whose underlying type is a string, bool, float or int
+func setPrimitiveValue(target reflect.Value, tfschemaValue interface{}) error {
+	switch target.Kind() {
+	case reflect.String:
+		if v, ok := tfschemaValue.(string); ok {
+			target.SetString(v)
+			return nil
+		}
+
+	case reflect.Bool:
+		if v, ok := tfschemaValue.(bool); ok {
+			target.SetBool(v)
+			return nil
+		}
+
+	case reflect.Float32, reflect.Float64:
+		switch v := tfschemaValue.(type) {
+		case float64:
+			target.SetFloat(v)
+			return nil
+		case float32:
+			target.SetFloat(float64(v))
+			return nil
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch v := tfschemaValue.(type) {
+		case int:
+			target.SetInt(int64(v))
+			return nil
+		case int32:
+			target.SetInt(int64(v))
+			return nil
+		case int64:
+			target.SetInt(v)
+			return nil
+		}
+
+	default:
+		return fmt.Errorf("unsupported type %s", target.Type())
+	}
+
+	return fmt.Errorf("cannot set a value of type %T into a %s", tfschemaValue, target.Type())
+}
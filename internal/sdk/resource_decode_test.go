@@ -3,6 +3,10 @@ package sdk
 import (
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type decodeTestData struct {
@@ -698,6 +702,155 @@ func TestResourceDecode_NestedThreeLevelsDeepMultipleItems(t *testing.T) {
 	}.test(t)
 }
 
+func TestDecode_TopLevelPointers(t *testing.T) {
+	type SimpleType struct {
+		String  *string  `tfschema:"string"`
+		Number  *int64   `tfschema:"number"`
+		Price   *float64 `tfschema:"price"`
+		Enabled *bool    `tfschema:"enabled"`
+		Omitted *string  `tfschema:"omitted"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"string":  "",
+			"number":  0,
+			"price":   float64(0),
+			"enabled": false,
+		},
+		Input: &SimpleType{},
+		Expected: &SimpleType{
+			String:  utils.String(""),
+			Number:  utils.Int64(0),
+			Price:   utils.Float(0),
+			Enabled: utils.Bool(false),
+		},
+		ExpectError: false,
+	}.test(t)
+}
+
+func TestDecode_TopLevelPointersRawConfig(t *testing.T) {
+	type SimpleType struct {
+		Enabled    *bool   `tfschema:"enabled"`
+		Retention  *int64  `tfschema:"retention"`
+		ObjectName *string `tfschema:"object_name"`
+	}
+
+	// the state contains the zero values for all fields, but only `enabled` is set in the configuration
+	state := testDataGetterWithRawConfig{
+		testDataGetter: testDataGetter{
+			values: map[string]interface{}{
+				"enabled":     false,
+				"retention":   0,
+				"object_name": "",
+			},
+		},
+		rawConfig: cty.ObjectVal(map[string]cty.Value{
+			"enabled":     cty.False,
+			"retention":   cty.NullVal(cty.Number),
+			"object_name": cty.NullVal(cty.String),
+		}),
+	}
+
+	var actual SimpleType
+	if err := decodeReflectedType(&actual, state, ConsoleLogger{}); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	expected := SimpleType{
+		Enabled: utils.Bool(false),
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("\nExpected: %+v\n\n Received %+v\n\n", expected, actual)
+	}
+}
+
+func TestDecode_TopLevelCodecsAndEnums(t *testing.T) {
+	type Colour string
+	type SimpleType struct {
+		Created     time.Time      `tfschema:"created"`
+		Expires     *time.Time     `tfschema:"expires"`
+		NotExpiring *time.Time     `tfschema:"not_expiring"`
+		Parent      testResourceId `tfschema:"parent"`
+		Colour      Colour         `tfschema:"colour"`
+		Accent      *Colour        `tfschema:"accent"`
+		Colours     []Colour       `tfschema:"colours"`
+	}
+	RegisterFieldCodec(reflect.TypeOf(testResourceId{}), ResourceIdCodec(parseTestResourceId))
+
+	accent := Colour("blue")
+	decodeTestData{
+		State: map[string]interface{}{
+			"created":      "2022-01-02T03:04:05Z",
+			"expires":      "2023-01-02T03:04:05Z",
+			"not_expiring": "",
+			"parent":       "/things/example",
+			"colour":       "red",
+			"accent":       "blue",
+			"colours":      []interface{}{"green", "yellow"},
+		},
+		Input: &SimpleType{},
+		Expected: &SimpleType{
+			Created: time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+			Expires: func() *time.Time {
+				v := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+				return &v
+			}(),
+			Parent:  testResourceId{Name: "example"},
+			Colour:  "red",
+			Accent:  &accent,
+			Colours: []Colour{"green", "yellow"},
+		},
+		ExpectError: false,
+	}.test(t)
+}
+
+func TestDecode_TopLevelCodecInvalidValue(t *testing.T) {
+	type SimpleType struct {
+		Created time.Time `tfschema:"created"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"created": "not-a-time",
+		},
+		Input:       &SimpleType{},
+		Expected:    &SimpleType{},
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestResourceDecode_NestedPointersAndCodecs(t *testing.T) {
+	type Inner struct {
+		Value   *int64     `tfschema:"value"`
+		Omitted *string    `tfschema:"omitted"`
+		Updated *time.Time `tfschema:"updated"`
+	}
+	type Type struct {
+		Inner []Inner `tfschema:"inner"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value":   0,
+					"updated": "2022-01-02T03:04:05Z",
+				},
+			},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Inner: []Inner{
+				{
+					Value: utils.Int64(0),
+					Updated: func() *time.Time {
+						v := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+						return &v
+					}(),
+				},
+			},
+		},
+	}.test(t)
+}
+
 func (testData decodeTestData) test(t *testing.T) {
 	debugLogger := ConsoleLogger{}
 	state := testData.stateWrapper()
@@ -742,3 +895,13 @@ func (td testDataGetter) GetOkExists(key string) (interface{}, bool) {
 	val, ok := td.values[key]
 	return val, ok
 }
+
+type testDataGetterWithRawConfig struct {
+	testDataGetter
+
+	rawConfig cty.Value
+}
+
+func (td testDataGetterWithRawConfig) GetRawConfig() cty.Value {
+	return td.rawConfig
+}
@@ -8,6 +8,9 @@ import (
 // Encode will encode the specified object into the Terraform State
 // NOTE: this requires that the object passed in is a pointer and
 // all fields contain `tfschema` struct tags
+//
+// Fields which are nil pointers are set to null, and fields using a type
+// with a registered FieldCodec are encoded using that FieldCodec.
 func (rmd ResourceMetaData) Encode(input interface{}) error {
 	if reflect.TypeOf(input).Kind() != reflect.Ptr {
 		return fmt.Errorf("need a pointer")
@@ -49,6 +52,16 @@ func recurse(objType reflect.Type, objVal reflect.Value, fieldName string, debug
 		field := objType.Field(i)
 		fieldVal := objVal.Field(i)
 		if tfschemaTag, exists := field.Tag.Lookup("tfschema"); exists {
+			if codec, ok := fieldCodecFor(field.Type); ok {
+				cv, err := codec.Encode(fieldVal.Interface())
+				if err != nil {
+					return nil, fmt.Errorf("encoding %q: %+v", tfschemaTag, err)
+				}
+				debugLogger.Infof("Setting %q to %+v", tfschemaTag, cv)
+				output[tfschemaTag] = cv
+				continue
+			}
+
 			switch field.Type.Kind() {
 			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 				iv := fieldVal.Int()
@@ -70,6 +83,20 @@ func recurse(objType reflect.Type, objVal reflect.Value, fieldName string, debug
 				debugLogger.Infof("Setting %q to %t", tfschemaTag, bv)
 				output[tfschemaTag] = bv
 
+			case reflect.Ptr:
+				if fieldVal.IsNil() {
+					debugLogger.Infof("Setting %q to null", tfschemaTag)
+					output[tfschemaTag] = nil
+					continue
+				}
+
+				pv, err := encodePointerValue(fieldVal.Elem())
+				if err != nil {
+					return nil, fmt.Errorf("encoding %q: %+v", tfschemaTag, err)
+				}
+				debugLogger.Infof("Setting %q to %+v", tfschemaTag, pv)
+				output[tfschemaTag] = pv
+
 			case reflect.Map:
 				iter := fieldVal.MapRange()
 				attr := make(map[string]interface{})
@@ -115,6 +142,16 @@ func recurse(objType reflect.Type, objVal reflect.Value, fieldName string, debug
 					}
 
 				default:
+					if isPrimitiveKind(sv.Type().Elem().Kind()) {
+						// e.g. a slice of an enum type such as `[]TablePlan`
+						debugLogger.Infof("Setting %q to %+v", tfschemaTag, sv.Type())
+						for i := 0; i < sv.Len(); i++ {
+							attr[i] = primitiveValue(sv.Index(i))
+						}
+						output[tfschemaTag] = attr
+						continue
+					}
+
 					for i := 0; i < sv.Len(); i++ {
 						debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
 						debugLogger.Infof("[SLICE] Type %+v", sv.Type())
@@ -139,3 +176,32 @@ func recurse(objType reflect.Type, objVal reflect.Value, fieldName string, debug
 
 	return output, nil
 }
+
+// encodePointerValue returns the value which a non-nil pointer field references, in a form which can
+// be stored in the Terraform Schema
+func encodePointerValue(input reflect.Value) (interface{}, error) {
+	if codec, ok := fieldCodecFor(input.Type()); ok {
+		return codec.Encode(input.Interface())
+	}
+
+	if !isPrimitiveKind(input.Kind()) {
+		return nil, fmt.Errorf("unsupported pointer type %s", input.Type())
+	}
+
+	return primitiveValue(input), nil
+}
+
+// primitiveValue returns the value of a (potentially named) string, bool, float or int type
+// as the underlying type, for example an enum type as a string
+func primitiveValue(input reflect.Value) interface{} {
+	switch input.Kind() {
+	case reflect.Bool:
+		return input.Bool()
+	case reflect.Float32, reflect.Float64:
+		return input.Float()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return input.Int()
+	}
+
+	return input.String()
+}
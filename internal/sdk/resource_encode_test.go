@@ -3,8 +3,10 @@ package sdk
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type encodeTestData struct {
@@ -647,6 +649,100 @@ func TestResourceEncode_NestedThreeLevelsDeepMultipleItems(t *testing.T) {
 	}.test(t)
 }
 
+func TestResourceEncode_TopLevelPointers(t *testing.T) {
+	type SimpleType struct {
+		String  *string  `tfschema:"string"`
+		Number  *int64   `tfschema:"number"`
+		Price   *float64 `tfschema:"price"`
+		Enabled *bool    `tfschema:"enabled"`
+		Omitted *string  `tfschema:"omitted"`
+	}
+
+	encodeTestData{
+		Input: &SimpleType{
+			String:  utils.String("hello"),
+			Number:  utils.Int64(0),
+			Price:   utils.Float(1.5),
+			Enabled: utils.Bool(false),
+		},
+		Expected: map[string]interface{}{
+			"string":  "hello",
+			"number":  int64(0),
+			"price":   float64(1.5),
+			"enabled": false,
+			"omitted": nil,
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_TopLevelCodecsAndEnums(t *testing.T) {
+	type Colour string
+	type SimpleType struct {
+		Created     time.Time      `tfschema:"created"`
+		Expires     *time.Time     `tfschema:"expires"`
+		NotExpiring *time.Time     `tfschema:"not_expiring"`
+		Parent      testResourceId `tfschema:"parent"`
+		Colour      Colour         `tfschema:"colour"`
+		Accent      *Colour        `tfschema:"accent"`
+		Colours     []Colour       `tfschema:"colours"`
+	}
+	RegisterFieldCodec(reflect.TypeOf(testResourceId{}), ResourceIdCodec(parseTestResourceId))
+
+	expires := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	accent := Colour("blue")
+	encodeTestData{
+		Input: &SimpleType{
+			Created: time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+			Expires: &expires,
+			Parent:  testResourceId{Name: "example"},
+			Colour:  "red",
+			Accent:  &accent,
+			Colours: []Colour{"green", "yellow"},
+		},
+		Expected: map[string]interface{}{
+			"created":      "2022-01-02T03:04:05Z",
+			"expires":      "2023-01-02T03:04:05Z",
+			"not_expiring": nil,
+			"parent":       "/things/example",
+			"colour":       "red",
+			"accent":       "blue",
+			"colours":      []interface{}{"green", "yellow"},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_NestedPointersAndCodecs(t *testing.T) {
+	type Inner struct {
+		Value   *int64     `tfschema:"value"`
+		Omitted *string    `tfschema:"omitted"`
+		Updated *time.Time `tfschema:"updated"`
+	}
+	type Type struct {
+		Inner []Inner `tfschema:"inner"`
+	}
+
+	updated := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	encodeTestData{
+		Input: &Type{
+			Inner: []Inner{
+				{
+					Value:   utils.Int64(0),
+					Updated: &updated,
+				},
+			},
+		},
+		Expected: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value":   int64(0),
+					"omitted": nil,
+					"updated": "2022-01-02T03:04:05Z",
+				},
+			},
+		},
+	}.test(t)
+}
+
 func (testData encodeTestData) test(t *testing.T) {
 	objType := reflect.TypeOf(testData.Input).Elem()
 	objVal := reflect.ValueOf(testData.Input).Elem()
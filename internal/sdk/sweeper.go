@@ -0,0 +1,54 @@
+package sdk
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// sweepablePrefix is the prefix used for the names of resources created during the Acceptance Tests
+// (e.g. `acctestRG-`, `acctestkv-`) - which is used to identify resources which have been leaked
+const sweepablePrefix = "acctest"
+
+// Sweeper removes the resources leaked by Acceptance Test runs which failed to clean up after themselves,
+// for example where the test run was cancelled or timed out. These are run via `go test -sweep` (see the
+// `sweep` target in the GNUmakefile) rather than as a part of the Provider.
+//
+// Sweepers must only remove resources which were created by the Acceptance Tests, which can be determined
+// from the name of the resource using `IsSweepable`.
+type Sweeper struct {
+	// Name is the unique name of this Sweeper, which is typically the Resource Type being swept,
+	// e.g. `azurerm_resource_group`
+	Name string
+
+	// Dependencies is a list of the names of the Sweepers which must be run before this Sweeper -
+	// for example soft-deleted resources can only be purged once their Resource Group is deleted
+	Dependencies []string
+
+	// Func removes the leaked resources within the specified (normalized) Location - resources which
+	// aren't scoped to a Location should be removed regardless of the Location
+	Func func(ctx context.Context, client *clients.Client, location string) error
+}
+
+// TypedServiceRegistrationWithSweepers is a superset of TypedServiceRegistration allowing Sweepers
+// to be registered for the leaked resources within this Service Package.
+type TypedServiceRegistrationWithSweepers interface {
+	TypedServiceRegistration
+
+	Sweepers() []Sweeper
+}
+
+// UntypedServiceRegistrationWithSweepers is a superset of UntypedServiceRegistration allowing Sweepers
+// to be registered for the leaked resources within this Service Package.
+type UntypedServiceRegistrationWithSweepers interface {
+	UntypedServiceRegistration
+
+	Sweepers() []Sweeper
+}
+
+// IsSweepable returns whether the resource with the specified name was created by the Acceptance Tests
+// and can be removed by a Sweeper
+func IsSweepable(name string) bool {
+	return strings.HasPrefix(strings.ToLower(name), sweepablePrefix)
+}
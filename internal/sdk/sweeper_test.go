@@ -0,0 +1,22 @@
+package sdk
+
+import "testing"
+
+func TestIsSweepable(t *testing.T) {
+	testData := map[string]bool{
+		"acctestRG-220101010101010101": true,
+		"acctestkv-abc12":              true,
+		"AccTestMG-1":                  true,
+		"example-resources":            false,
+		"production-acctest":           false,
+		"":                             false,
+	}
+
+	for input, expected := range testData {
+		t.Logf("[DEBUG] Testing %q", input)
+
+		if actual := IsSweepable(input); actual != expected {
+			t.Fatalf("expected %t for %q but got %t", expected, input, actual)
+		}
+	}
+}
@@ -12,14 +12,12 @@ import (
 // into the object used by the Terraform Plugin SDK
 type DataSourceWrapper struct {
 	dataSource DataSource
-	logger     Logger
 }
 
 // NewDataSourceWrapper returns a DataSourceWrapper for this Data Source implementation
 func NewDataSourceWrapper(dataSource DataSource) DataSourceWrapper {
 	return DataSourceWrapper{
 		dataSource: dataSource,
-		logger:     &DiagnosticsLogger{},
 	}
 }
 
@@ -43,8 +41,8 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 
 	resource := schema.Resource{
 		Schema: *resourceSchema,
-		ReadContext: dw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, dw.logger)
+		ReadContext: dw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}, logger Logger) error {
+			metaData := runArgs(d, meta, logger)
 			return dw.dataSource.Read().Func(ctx, metaData)
 		}),
 		Timeouts: &schema.ResourceTimeout{
@@ -55,6 +53,6 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 	return &resource, nil
 }
 
-func (dw *DataSourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) schema.ReadContextFunc {
-	return diagnosticsWrapper(in, dw.logger)
+func (dw *DataSourceWrapper) diagnosticsWrapper(in operationFunc) schema.ReadContextFunc {
+	return diagnosticsWrapper(dw.dataSource.ResourceType(), in)
 }
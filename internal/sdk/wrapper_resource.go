@@ -47,10 +47,16 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 
-		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}, logger Logger) error {
+			metaData := runArgs(d, meta, logger)
 			err := rw.resource.Create().Func(ctx, metaData)
 			if err != nil {
+				// the Resource will be Read once the Create completes during the next run
+				if createStillInProgress(ctx, d) {
+					logger.Warnf("%s\n\nThe Create of %q is still in progress and will be resumed during the next Terraform run.", err.Error(), d.Id())
+					return nil
+				}
+
 				return err
 			}
 			// NOTE: whilst this may look like we should use the Read
@@ -60,12 +66,16 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		}),
 
 		// looks like these could be reused, easiest if they're not
-		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}, logger Logger) error {
+			metaData := runArgs(d, meta, logger)
+			if inProgress := checkLongRunningOperation(ctx, metaData); inProgress {
+				return nil
+			}
+
 			return rw.resource.Read().Func(ctx, metaData)
 		}),
-		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}, logger Logger) error {
+			metaData := runArgs(d, meta, logger)
 			return rw.resource.Delete().Func(ctx, metaData)
 		}),
 
@@ -74,44 +84,17 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			Read:   d(rw.resource.Read().Timeout),
 			Delete: d(rw.resource.Delete().Timeout),
 		},
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			fn := rw.resource.IDValidationFunc()
-			warnings, errors := fn(id, "id")
-			if len(warnings) > 0 {
-				for _, warning := range warnings {
-					rw.logger.Warn(warning)
-				}
-			}
-			if len(errors) > 0 {
-				out := ""
-				for _, error := range errors {
-					out += error.Error()
-				}
-				return fmt.Errorf(out)
-			}
-
-			return nil
-		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
-			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				metaData := runArgs(d, meta, rw.logger)
-
-				err := v.CustomImporter()(ctx, metaData)
-				if err != nil {
-					return nil, err
-				}
-
-				return []*pluginsdk.ResourceData{metaData.ResourceData}, nil
-			}
-
-			return schema.ImportStatePassthroughContext(ctx, d, meta)
-		}),
+		Importer: rw.importer(),
 	}
 
 	// Not all resources support update - so this is an separate interface
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
-		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}, logger Logger) error {
+			metaData := runArgs(d, meta, logger)
+			if inProgress := resumeLongRunningOperation(ctx, metaData); inProgress {
+				return stillInProgressError(d.Id())
+			}
 
 			err := v.Update().Func(ctx, metaData)
 			if err != nil {
@@ -175,14 +158,21 @@ and we recommend using the %[2]q resource instead.
 	return &resource, nil
 }
 
-func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return diagnosticsWrapper(in, rw.logger)
+func (rw *ResourceWrapper) diagnosticsWrapper(in operationFunc) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diagnosticsWrapper(rw.resource.ResourceType(), in)
 }
 
-func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, logger Logger) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+// operationFunc is an operation (e.g. Create) against a Data Source/Resource, which is passed a Logger
+// for this operation and a Context which is tagged with the same fields
+type operationFunc func(ctx context.Context, d *schema.ResourceData, meta interface{}, logger Logger) error
+
+func diagnosticsWrapper(resourceType string, in operationFunc) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx, logger := newOperationLogger(ctx, resourceType, d.Id(), meta)
+
 		out := make([]diag.Diagnostic, 0)
-		if err := in(ctx, d, meta); err != nil {
+		if err := in(ctx, d, meta, logger); err != nil {
+			logger.Error(err.Error())
 			out = append(out, diag.Diagnostic{
 				Severity:      diag.Error,
 				Summary:       err.Error(),
@@ -191,10 +181,53 @@ func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, met
 			})
 		}
 
-		if diagsLogger, ok := logger.(*DiagnosticsLogger); ok {
-			out = append(out, diagsLogger.diagnostics...)
-		}
+		out = append(out, logger.Diagnostics()...)
 
 		return out
 	}
 }
+
+// importer returns the Importer for this Resource, which validates the Resource ID prior to importing - or
+// builds this from the names of each segment of the Resource ID for Resources implementing ResourceWithImportByName
+func (rw *ResourceWrapper) importer() *schema.ResourceImporter {
+	validateFunc := func(id string) error {
+		fn := rw.resource.IDValidationFunc()
+		warnings, errors := fn(id, "id")
+		if len(warnings) > 0 {
+			for _, warning := range warnings {
+				rw.logger.Warn(warning)
+			}
+		}
+		if len(errors) > 0 {
+			out := ""
+			for _, error := range errors {
+				out += error.Error()
+			}
+			return fmt.Errorf(out)
+		}
+
+		return nil
+	}
+
+	thenFunc := func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+		if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
+			ctx, logger := newOperationLogger(ctx, rw.resource.ResourceType(), d.Id(), meta)
+			metaData := runArgs(d, meta, logger)
+
+			err := v.CustomImporter()(ctx, metaData)
+			if err != nil {
+				return nil, err
+			}
+
+			return []*pluginsdk.ResourceData{metaData.ResourceData}, nil
+		}
+
+		return schema.ImportStatePassthroughContext(ctx, d, meta)
+	}
+
+	if v, ok := rw.resource.(ResourceWithImportByName); ok {
+		return pluginsdk.ImporterValidatingResourceIdOrNamesThen(validateFunc, v.IDFromNames(), thenFunc)
+	}
+
+	return pluginsdk.ImporterValidatingResourceIdThen(validateFunc, thenFunc)
+}
@@ -41,6 +41,11 @@ func dataSourceApiManagementApi() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"api_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"description": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -145,6 +150,7 @@ func dataSourceApiManagementApiRead(d *pluginsdk.ResourceData, meta interface{})
 	d.Set("resource_group_name", id.ResourceGroup)
 
 	if props := resp.APIContractProperties; props != nil {
+		d.Set("api_type", string(props.APIType))
 		d.Set("description", props.Description)
 		d.Set("display_name", props.DisplayName)
 		d.Set("is_current", props.IsCurrent)
@@ -152,7 +158,7 @@ func dataSourceApiManagementApiRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("path", props.Path)
 		d.Set("revision", props.APIRevision)
 		d.Set("service_url", props.ServiceURL)
-		d.Set("soap_pass_through", string(props.APIType) == string(apimanagement.SoapAPITypeSoapPassThrough))
+		d.Set("soap_pass_through", string(props.APIType) == string(apimanagement.APITypeSoap))
 		d.Set("subscription_required", props.SubscriptionRequired)
 		d.Set("version", props.APIVersion)
 		d.Set("version_set_id", props.APIVersionSetID)
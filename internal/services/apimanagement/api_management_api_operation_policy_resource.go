@@ -23,9 +23,16 @@ func resourceApiManagementApiOperationPolicy() *pluginsdk.Resource {
 		Read:   resourceApiManagementAPIOperationPolicyRead,
 		Update: resourceApiManagementAPIOperationPolicyCreateUpdate,
 		Delete: resourceApiManagementAPIOperationPolicyDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdOrNames(func(id string) error {
 			_, err := parse.ApiOperationPolicyID(id)
 			return err
+		}, func(subscriptionId, input string) (string, error) {
+			// the name of the Policy is always `xml` so can be omitted
+			id, err := parse.ApiOperationPolicyIDFromNames(subscriptionId, fmt.Sprintf("%s/%s", input, apimanagement.PolicyExportFormatXML))
+			if err != nil {
+				return "", err
+			}
+			return id.ID(), nil
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
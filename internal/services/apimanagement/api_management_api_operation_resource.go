@@ -22,9 +22,15 @@ func resourceApiManagementApiOperation() *pluginsdk.Resource {
 		Read:   resourceApiManagementApiOperationRead,
 		Update: resourceApiManagementApiOperationCreateUpdate,
 		Delete: resourceApiManagementApiOperationDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdOrNames(func(id string) error {
 			_, err := parse.ApiOperationID(id)
 			return err
+		}, func(subscriptionId, input string) (string, error) {
+			id, err := parse.ApiOperationIDFromNames(subscriptionId, input)
+			if err != nil {
+				return "", err
+			}
+			return id.ID(), nil
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
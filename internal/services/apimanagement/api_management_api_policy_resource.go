@@ -23,9 +23,16 @@ func resourceApiManagementApiPolicy() *pluginsdk.Resource {
 		Read:   resourceApiManagementAPIPolicyRead,
 		Update: resourceApiManagementAPIPolicyCreateUpdate,
 		Delete: resourceApiManagementAPIPolicyDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdOrNames(func(id string) error {
 			_, err := parse.ApiPolicyID(id)
 			return err
+		}, func(subscriptionId, input string) (string, error) {
+			// the name of the Policy is always `xml` so can be omitted
+			id, err := parse.ApiPolicyIDFromNames(subscriptionId, fmt.Sprintf("%s/%s", input, apimanagement.PolicyExportFormatXML))
+			if err != nil {
+				return "", err
+			}
+			return id.ID(), nil
 		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
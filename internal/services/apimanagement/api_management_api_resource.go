@@ -1,17 +1,21 @@
 package apimanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspaceapi"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -25,8 +29,17 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 		Read:   resourceApiManagementApiRead,
 		Update: resourceApiManagementApiCreateUpdate,
 		Delete: resourceApiManagementApiDelete,
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			// when `api_type` isn't configured it's derived from `soap_pass_through`, so needs recomputing when that changes
+			if diff.Id() != "" && diff.HasChange("soap_pass_through") && !apiManagementApiTypeIsConfigured(diff.GetRawConfig()) {
+				return diff.SetNewComputed("api_type")
+			}
+			return nil
+		}),
+
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ApiID(id)
+			_, _, err := parseApiManagementApiID(id)
 			return err
 		}),
 
@@ -67,6 +80,8 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 					ValidateFunc: validation.StringInSlice([]string{
 						string(apimanagement.ProtocolHTTP),
 						string(apimanagement.ProtocolHTTPS),
+						string(apimanagement.ProtocolWs),
+						string(apimanagement.ProtocolWss),
 					}, false),
 				},
 			},
@@ -85,6 +100,19 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 			},
 
 			// Optional
+			"api_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apimanagement.APITypeGraphql),
+					string(apimanagement.APITypeHTTP),
+					string(apimanagement.APITypeSoap),
+					string(apimanagement.APITypeWebsocket),
+				}, false),
+				ConflictsWith: []string{"soap_pass_through"},
+			},
+
 			"description": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -106,6 +134,7 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
+								string(apimanagement.ContentFormatGraphqlLink),
 								string(apimanagement.ContentFormatOpenapi),
 								string(apimanagement.ContentFormatOpenapijson),
 								string(apimanagement.ContentFormatOpenapijsonLink),
@@ -177,9 +206,10 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 			},
 
 			"soap_pass_through": {
-				Type:     pluginsdk.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"api_type"},
 			},
 
 			"source_api_id": {
@@ -262,18 +292,30 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 				Computed: true,
 				Optional: true,
 			},
+
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
 		},
 	}
 }
 
 func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	id := parse.NewApiID(subscriptionId, d.Get("resource_group_name").(string), d.Get("api_management_name").(string), d.Get("name").(string))
 
+	workspaceId, err := expandApiManagementWorkspaceID(d.Get("workspace_id").(string), id.SubscriptionId, id.ResourceGroup, id.ServiceName)
+	if err != nil {
+		return err
+	}
+	client := newApiManagementApiClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
+
 	revision := d.Get("revision").(string)
 	path := d.Get("path").(string)
 	apiId := fmt.Sprintf("%s;rev=%s", id.Name, revision)
@@ -293,7 +335,7 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 	}
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, apiId)
+		existing, err := client.Get(ctx, apiId)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
 				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
@@ -305,17 +347,14 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		}
 	}
 
-	var apiType apimanagement.APIType
-	var soapApiType apimanagement.SoapAPIType
-
-	soapPassThrough := d.Get("soap_pass_through").(bool)
-	if soapPassThrough {
+	// `api_type` is Computed, so the value from the raw config is used to avoid picking up a previously computed value
+	apiType := apimanagement.APITypeHTTP
+	if apiManagementApiTypeIsConfigured(d.GetRawConfig()) {
+		apiType = apimanagement.APIType(d.Get("api_type").(string))
+	} else if d.Get("soap_pass_through").(bool) {
 		apiType = apimanagement.APITypeSoap
-		soapApiType = apimanagement.SoapAPITypeSoapPassThrough
-	} else {
-		apiType = apimanagement.APITypeHTTP
-		soapApiType = apimanagement.SoapAPITypeSoapToRest
 	}
+	soapApiType := soapApiTypeFromApiType(apiType)
 
 	// If import is used, we need to send properties to Azure API in two operations.
 	// First we execute import and then updated the other props.
@@ -358,14 +397,9 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 			apiParams.APICreateOrUpdateProperties.APIVersionSetID = utils.String(versionSetId)
 		}
 
-		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, apiId, apiParams, "")
-		if err != nil {
+		if err := client.CreateOrUpdate(ctx, apiId, apiParams); err != nil {
 			return fmt.Errorf("creating/updating %s: %+v", id, err)
 		}
-
-		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("waiting on creating/updating %s: %+v", id, err)
-		}
 	}
 
 	description := d.Get("description").(string)
@@ -414,28 +448,27 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		params.APICreateOrUpdateProperties.APIVersionSetID = utils.String(versionSetId)
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, apiId, params, "")
-	if err != nil {
+	if err := client.CreateOrUpdate(ctx, apiId, params); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting on creating/updating %s: %+v", id, err)
+	if workspaceId != nil {
+		d.SetId(parse.NewWorkspaceApiID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, workspaceId.Name, id.Name).ID())
+	} else {
+		d.SetId(id.ID())
 	}
-
-	d.SetId(id.ID())
 	return resourceApiManagementApiRead(d, meta)
 }
 
 func resourceApiManagementApiRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.ApiID(d.Id())
+	id, workspaceId, err := parseApiManagementApiID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := newApiManagementApiClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
 
 	name := id.Name
 	revision := ""
@@ -444,7 +477,7 @@ func resourceApiManagementApiRead(d *pluginsdk.ResourceData, meta interface{}) e
 		revision = strings.Split(id.Name, "=")[1]
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	resp, err := client.Get(ctx, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[DEBUG] API %q Revision %q (API Management Service %q / Resource Group %q) does not exist - removing from state!", name, revision, id.ServiceName, id.ResourceGroup)
@@ -458,6 +491,7 @@ func resourceApiManagementApiRead(d *pluginsdk.ResourceData, meta interface{}) e
 	d.Set("api_management_name", id.ServiceName)
 	d.Set("name", name)
 	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("workspace_id", flattenApiManagementWorkspaceID(workspaceId))
 
 	if props := resp.APIContractProperties; props != nil {
 		d.Set("description", props.Description)
@@ -467,7 +501,15 @@ func resourceApiManagementApiRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("path", props.Path)
 		d.Set("service_url", props.ServiceURL)
 		d.Set("revision", props.APIRevision)
-		d.Set("soap_pass_through", string(props.APIType) == string(apimanagement.SoapAPITypeSoapPassThrough))
+		apiType := string(props.APIType)
+		if apiType == "" {
+			apiType = string(apimanagement.APITypeHTTP)
+		}
+		d.Set("api_type", apiType)
+		// `soap_pass_through` conflicts with `api_type`, so is only set when `api_type` isn't configured to avoid a diff
+		if !apiManagementApiTypeIsConfigured(d.GetRawConfig()) {
+			d.Set("soap_pass_through", apiType == string(apimanagement.APITypeSoap))
+		}
 		d.Set("subscription_required", props.SubscriptionRequired)
 		d.Set("version", props.APIVersion)
 		d.Set("version_set_id", props.APIVersionSetID)
@@ -495,14 +537,14 @@ func resourceApiManagementApiRead(d *pluginsdk.ResourceData, meta interface{}) e
 }
 
 func resourceApiManagementApiDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ApiClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.ApiID(d.Id())
+	id, workspaceId, err := parseApiManagementApiID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := newApiManagementApiClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
 
 	name := id.Name
 	revision := ""
@@ -511,8 +553,7 @@ func resourceApiManagementApiDelete(d *pluginsdk.ResourceData, meta interface{})
 		revision = strings.Split(id.Name, "=")[1]
 	}
 
-	deleteRevisions := utils.Bool(true)
-	if resp, err := client.Delete(ctx, id.ResourceGroup, id.ServiceName, name, "", deleteRevisions); err != nil {
+	if resp, err := client.Delete(ctx, name); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
 			return fmt.Errorf("deleting API %q / Revision %q (API Management Service %q / Resource Group %q): %s", name, revision, id.ServiceName, id.ResourceGroup, err)
 		}
@@ -521,6 +562,47 @@ func resourceApiManagementApiDelete(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
+// parseApiManagementApiID parses the ID of an API within either an API Management Service or a Workspace,
+// returning the ID of the Workspace when the API is within one
+func parseApiManagementApiID(input string) (*parse.ApiId, *parse.WorkspaceId, error) {
+	if workspaceApiId, err := parse.WorkspaceApiID(input); err == nil {
+		id := parse.NewApiID(workspaceApiId.SubscriptionId, workspaceApiId.ResourceGroup, workspaceApiId.ServiceName, workspaceApiId.ApiName)
+		workspaceId := parse.NewWorkspaceID(workspaceApiId.SubscriptionId, workspaceApiId.ResourceGroup, workspaceApiId.ServiceName, workspaceApiId.WorkspaceName)
+		return &id, &workspaceId, nil
+	}
+
+	id, err := parse.ApiID(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	return id, nil, nil
+}
+
+// apiManagementApiTypeIsConfigured returns whether `api_type` is set in the given raw config, which
+// is null when the resource is being imported
+func apiManagementApiTypeIsConfigured(config cty.Value) bool {
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() {
+		return false
+	}
+	v, ok := config.AsValueMap()["api_type"]
+	return ok && !v.IsNull()
+}
+
+// soapApiTypeFromApiType maps the type of an API onto the (differently named) type
+// which is sent when creating or importing the API
+func soapApiTypeFromApiType(apiType apimanagement.APIType) apimanagement.SoapAPIType {
+	switch apiType {
+	case apimanagement.APITypeGraphql:
+		return apimanagement.SoapAPITypeGraphQL
+	case apimanagement.APITypeSoap:
+		return apimanagement.SoapAPITypeSoapPassThrough
+	case apimanagement.APITypeWebsocket:
+		return apimanagement.SoapAPITypeWebSocket
+	default:
+		return apimanagement.SoapAPITypeSoapToRest
+	}
+}
+
 func expandApiManagementApiProtocols(input []interface{}) *[]apimanagement.Protocol {
 	if len(input) == 0 {
 		return nil
@@ -659,3 +741,234 @@ func flattenApiManagementOpenIDAuthentication(input *apimanagement.OpenIDAuthent
 
 	return []interface{}{result}
 }
+
+// newApiManagementApiClient returns a client managing APIs within either the API Management Service `serviceName`, or
+// the Workspace `workspaceId` within it when set
+func newApiManagementApiClient(meta interface{}, resourceGroup, serviceName string, workspaceId *parse.WorkspaceId) workspaceScopedClient[apimanagement.APICreateOrUpdateParameter, apimanagement.APIContract] {
+	if workspaceId == nil {
+		client := meta.(*clients.Client).ApiManagement.ApiClient
+		return workspaceScopedClient[apimanagement.APICreateOrUpdateParameter, apimanagement.APIContract]{
+			CreateOrUpdate: func(ctx context.Context, name string, input apimanagement.APICreateOrUpdateParameter) error {
+				future, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, name, input, "")
+				if err != nil {
+					return err
+				}
+				return future.WaitForCompletionRef(ctx, client.Client)
+			},
+			Get: func(ctx context.Context, name string) (apimanagement.APIContract, error) {
+				return client.Get(ctx, resourceGroup, serviceName, name)
+			},
+			Delete: func(ctx context.Context, name string) (autorest.Response, error) {
+				return client.Delete(ctx, resourceGroup, serviceName, name, "", utils.Bool(true))
+			},
+		}
+	}
+
+	client := meta.(*clients.Client).ApiManagement.WorkspaceApiClient
+	apiId := func(name string) workspaceapi.WorkspaceApiId {
+		return workspaceapi.NewWorkspaceApiID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.ServiceName, workspaceId.Name, name)
+	}
+	return workspaceScopedClient[apimanagement.APICreateOrUpdateParameter, apimanagement.APIContract]{
+		CreateOrUpdate: func(ctx context.Context, name string, input apimanagement.APICreateOrUpdateParameter) error {
+			return client.CreateOrUpdateThenPoll(ctx, apiId(name), convertApiCreateOrUpdateParameterToWorkspace(input), workspaceapi.DefaultCreateOrUpdateOptions())
+		},
+		Get: func(ctx context.Context, name string) (apimanagement.APIContract, error) {
+			resp, err := client.Get(ctx, apiId(name))
+			result := convertApiContractFromWorkspace(resp.Model)
+			result.Response = autorest.Response{Response: resp.HttpResponse}
+			return result, err
+		},
+		Delete: func(ctx context.Context, name string) (autorest.Response, error) {
+			resp, err := client.Delete(ctx, apiId(name), workspaceapi.DeleteOptions{
+				IfMatch:         utils.String("*"),
+				DeleteRevisions: utils.Bool(true),
+			})
+			return autorest.Response{Response: resp.HttpResponse}, err
+		},
+	}
+}
+
+func convertApiCreateOrUpdateParameterToWorkspace(input apimanagement.APICreateOrUpdateParameter) workspaceapi.ApiCreateOrUpdateParameter {
+	output := workspaceapi.ApiCreateOrUpdateParameter{}
+	props := input.APICreateOrUpdateProperties
+	if props == nil {
+		return output
+	}
+
+	output.Properties = &workspaceapi.ApiCreateOrUpdateProperties{
+		ApiRevision:            props.APIRevision,
+		ApiRevisionDescription: props.APIRevisionDescription,
+		ApiVersion:             props.APIVersion,
+		ApiVersionDescription:  props.APIVersionDescription,
+		ApiVersionSetId:        props.APIVersionSetID,
+		AuthenticationSettings: convertApiAuthenticationSettingsToWorkspace(props.AuthenticationSettings),
+		Description:            props.Description,
+		DisplayName:            props.DisplayName,
+		IsCurrent:              props.IsCurrent,
+		IsOnline:               props.IsOnline,
+		Path:                   utils.NormalizeNilableString(props.Path),
+		ServiceUrl:             props.ServiceURL,
+		SourceApiId:            props.SourceAPIID,
+		SubscriptionRequired:   props.SubscriptionRequired,
+		TermsOfServiceUrl:      props.TermsOfServiceURL,
+		Value:                  props.Value,
+	}
+	if props.SoapAPIType != "" {
+		soapApiType := workspaceapi.SoapApiType(props.SoapAPIType)
+		output.Properties.ApiType = &soapApiType
+	}
+	if props.APIType != "" {
+		apiType := workspaceapi.ApiType(props.APIType)
+		output.Properties.Type = &apiType
+	}
+	if props.Format != "" {
+		format := workspaceapi.ContentFormat(props.Format)
+		output.Properties.Format = &format
+	}
+	if props.Protocols != nil {
+		protocols := make([]workspaceapi.Protocol, 0)
+		for _, v := range *props.Protocols {
+			protocols = append(protocols, workspaceapi.Protocol(v))
+		}
+		output.Properties.Protocols = &protocols
+	}
+	if v := props.SubscriptionKeyParameterNames; v != nil {
+		output.Properties.SubscriptionKeyParameterNames = &workspaceapi.SubscriptionKeyParameterNamesContract{
+			Header: v.Header,
+			Query:  v.Query,
+		}
+	}
+	if v := props.Contact; v != nil {
+		output.Properties.Contact = &workspaceapi.ApiContactInformation{
+			Email: v.Email,
+			Name:  v.Name,
+			Url:   v.URL,
+		}
+	}
+	if v := props.License; v != nil {
+		output.Properties.License = &workspaceapi.ApiLicenseInformation{
+			Name: v.Name,
+			Url:  v.URL,
+		}
+	}
+	if v := props.WsdlSelector; v != nil {
+		output.Properties.WsdlSelector = &workspaceapi.ApiCreateOrUpdatePropertiesWsdlSelector{
+			WsdlEndpointName: v.WsdlEndpointName,
+			WsdlServiceName:  v.WsdlServiceName,
+		}
+	}
+
+	return output
+}
+
+func convertApiAuthenticationSettingsToWorkspace(input *apimanagement.AuthenticationSettingsContract) *workspaceapi.AuthenticationSettingsContract {
+	if input == nil {
+		return nil
+	}
+
+	output := workspaceapi.AuthenticationSettingsContract{}
+	if v := input.OAuth2; v != nil {
+		output.OAuth2 = &workspaceapi.OAuth2AuthenticationSettingsContract{
+			AuthorizationServerId: v.AuthorizationServerID,
+			Scope:                 v.Scope,
+		}
+	}
+	if v := input.Openid; v != nil {
+		output.Openid = &workspaceapi.OpenIdAuthenticationSettingsContract{
+			OpenidProviderId: v.OpenidProviderID,
+		}
+		if v.BearerTokenSendingMethods != nil {
+			methods := make([]workspaceapi.BearerTokenSendingMethods, 0)
+			for _, method := range *v.BearerTokenSendingMethods {
+				methods = append(methods, workspaceapi.BearerTokenSendingMethods(method))
+			}
+			output.Openid.BearerTokenSendingMethods = &methods
+		}
+	}
+	return &output
+}
+
+func convertApiContractFromWorkspace(input *workspaceapi.ApiContract) apimanagement.APIContract {
+	output := apimanagement.APIContract{}
+	if input == nil {
+		return output
+	}
+
+	output.ID = input.Id
+	output.Name = input.Name
+	output.Type = input.Type
+
+	props := input.Properties
+	if props == nil {
+		return output
+	}
+
+	output.APIContractProperties = &apimanagement.APIContractProperties{
+		APIRevision:            props.ApiRevision,
+		APIRevisionDescription: props.ApiRevisionDescription,
+		APIVersion:             props.ApiVersion,
+		APIVersionDescription:  props.ApiVersionDescription,
+		APIVersionSetID:        props.ApiVersionSetId,
+		AuthenticationSettings: &apimanagement.AuthenticationSettingsContract{},
+		Description:            props.Description,
+		DisplayName:            props.DisplayName,
+		IsCurrent:              props.IsCurrent,
+		IsOnline:               props.IsOnline,
+		Path:                   utils.String(props.Path),
+		ServiceURL:             props.ServiceUrl,
+		SourceAPIID:            props.SourceApiId,
+		SubscriptionRequired:   props.SubscriptionRequired,
+		TermsOfServiceURL:      props.TermsOfServiceUrl,
+	}
+	if props.Type != nil {
+		output.APIContractProperties.APIType = apimanagement.APIType(*props.Type)
+	}
+	if props.Protocols != nil {
+		protocols := make([]apimanagement.Protocol, 0)
+		for _, v := range *props.Protocols {
+			protocols = append(protocols, apimanagement.Protocol(v))
+		}
+		output.APIContractProperties.Protocols = &protocols
+	}
+	if v := props.SubscriptionKeyParameterNames; v != nil {
+		output.APIContractProperties.SubscriptionKeyParameterNames = &apimanagement.SubscriptionKeyParameterNamesContract{
+			Header: v.Header,
+			Query:  v.Query,
+		}
+	}
+	if v := props.Contact; v != nil {
+		output.APIContractProperties.Contact = &apimanagement.APIContactInformation{
+			Email: v.Email,
+			Name:  v.Name,
+			URL:   v.Url,
+		}
+	}
+	if v := props.License; v != nil {
+		output.APIContractProperties.License = &apimanagement.APILicenseInformation{
+			Name: v.Name,
+			URL:  v.Url,
+		}
+	}
+	if v := props.AuthenticationSettings; v != nil {
+		if oauth2 := v.OAuth2; oauth2 != nil {
+			output.APIContractProperties.AuthenticationSettings.OAuth2 = &apimanagement.OAuth2AuthenticationSettingsContract{
+				AuthorizationServerID: oauth2.AuthorizationServerId,
+				Scope:                 oauth2.Scope,
+			}
+		}
+		if openid := v.Openid; openid != nil {
+			output.APIContractProperties.AuthenticationSettings.Openid = &apimanagement.OpenIDAuthenticationSettingsContract{
+				OpenidProviderID: openid.OpenidProviderId,
+			}
+			if openid.BearerTokenSendingMethods != nil {
+				methods := make([]apimanagement.BearerTokenSendingMethods, 0)
+				for _, method := range *openid.BearerTokenSendingMethods {
+					methods = append(methods, apimanagement.BearerTokenSendingMethods(method))
+				}
+				output.APIContractProperties.AuthenticationSettings.Openid.BearerTokenSendingMethods = &methods
+			}
+		}
+	}
+
+	return output
+}
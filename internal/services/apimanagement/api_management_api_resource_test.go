@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspaceapi"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -145,6 +146,80 @@ func TestAccApiManagementApi_soapPassthrough(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementApi_soapPassthroughToApiType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
+	r := ApiManagementApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.soapPassthrough(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api_type").HasValue("soap"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.apiTypeSoap(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api_type").HasValue("soap"),
+				check.That(data.ResourceName).Key("soap_pass_through").HasValue("false"),
+			),
+		},
+		data.ImportStep("soap_pass_through"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api_type").HasValue("http"),
+				check.That(data.ResourceName).Key("soap_pass_through").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.soapPassthrough(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api_type").HasValue("soap"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementApi_graphQL(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
+	r := ApiManagementApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.graphQL(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api_type").HasValue("graphql"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementApi_webSocket(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
+	r := ApiManagementApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.webSocket(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api_type").HasValue("websocket"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccApiManagementApi_subscriptionRequired(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
 	r := ApiManagementApiResource{}
@@ -320,7 +395,33 @@ func TestAccApiManagementApi_createRevisionFromExistingRevision(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementApi_workspace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
+	r := ApiManagementApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.workspace(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("workspace_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (ApiManagementApiResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if workspaceApiId, err := parse.WorkspaceApiID(state.ID); err == nil {
+		id := workspaceapi.NewWorkspaceApiID(workspaceApiId.SubscriptionId, workspaceApiId.ResourceGroup, workspaceApiId.ServiceName, workspaceApiId.WorkspaceName, workspaceApiId.ApiName)
+		resp, err := clients.ApiManagement.WorkspaceApiClient.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %+v", id, err)
+		}
+
+		return utils.Bool(resp.Model != nil && resp.Model.Id != nil), nil
+	}
+
 	id, err := parse.ApiID(state.ID)
 	if err != nil {
 		return nil, err
@@ -399,6 +500,77 @@ resource "azurerm_api_management_api" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r ApiManagementApiResource) apiTypeSoap(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "api1"
+  path                = "api1"
+  protocols           = ["https"]
+  revision            = "1"
+  api_type            = "soap"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiManagementApiResource) graphQL(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "api1"
+  path                = "api1"
+  protocols           = ["https"]
+  revision            = "1"
+  api_type            = "graphql"
+  service_url         = "https://api.spacex.land/graphql"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiManagementApiResource) webSocket(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+# WebSocket APIs are not supported in the Consumption tier
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+
+  sku_name = "Developer_1"
+}
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "api1"
+  path                = "api1"
+  protocols           = ["wss"]
+  revision            = "1"
+  api_type            = "websocket"
+  service_url         = "wss://ws.ifelse.io"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
 func (r ApiManagementApiResource) subscriptionRequired(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -693,3 +865,20 @@ resource "azurerm_api_management" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (ApiManagementApiResource) workspace(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  workspace_id        = azurerm_api_management_workspace.test.id
+  display_name        = "api1"
+  path                = "api1"
+  protocols           = ["https"]
+  revision            = "1"
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
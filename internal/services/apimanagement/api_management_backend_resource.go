@@ -1,17 +1,20 @@
 package apimanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacebackend"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -26,7 +29,7 @@ func resourceApiManagementBackend() *pluginsdk.Resource {
 		Update: resourceApiManagementBackendCreateUpdate,
 		Delete: resourceApiManagementBackendDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.BackendID(id)
+			_, _, err := parseApiManagementBackendID(id)
 			return err
 		}),
 
@@ -249,20 +252,32 @@ func resourceApiManagementBackend() *pluginsdk.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
 		},
 	}
 }
 
 func resourceApiManagementBackendCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.BackendClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	id := parse.NewBackendID(subscriptionId, d.Get("resource_group_name").(string), d.Get("api_management_name").(string), d.Get("name").(string))
 
+	workspaceId, err := expandApiManagementWorkspaceID(d.Get("workspace_id").(string), id.SubscriptionId, id.ResourceGroup, id.ServiceName)
+	if err != nil {
+		return err
+	}
+	client := newApiManagementBackendClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
+
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+		existing, err := client.Get(ctx, id.Name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
 				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
@@ -312,24 +327,28 @@ func resourceApiManagementBackendCreateUpdate(d *pluginsdk.ResourceData, meta in
 		}
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, id.Name, backendContract, ""); err != nil {
+	if err := client.CreateOrUpdate(ctx, id.Name, backendContract); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	d.SetId(id.ID())
+	if workspaceId != nil {
+		d.SetId(parse.NewWorkspaceBackendID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, workspaceId.Name, id.Name).ID())
+	} else {
+		d.SetId(id.ID())
+	}
 	return resourceApiManagementBackendRead(d, meta)
 }
 
 func resourceApiManagementBackendRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.BackendClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
-	id, err := parse.BackendID(d.Id())
+	id, workspaceId, err := parseApiManagementBackendID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := newApiManagementBackendClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	resp, err := client.Get(ctx, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[DEBUG] %s does not exist - removing from state!", *id)
@@ -343,6 +362,7 @@ func resourceApiManagementBackendRead(d *pluginsdk.ResourceData, meta interface{
 	d.Set("name", id.Name)
 	d.Set("api_management_name", id.ServiceName)
 	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("workspace_id", flattenApiManagementWorkspaceID(workspaceId))
 
 	if props := resp.BackendContractProperties; props != nil {
 		d.Set("description", props.Description)
@@ -370,16 +390,16 @@ func resourceApiManagementBackendRead(d *pluginsdk.ResourceData, meta interface{
 }
 
 func resourceApiManagementBackendDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.BackendClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.BackendID(d.Id())
+	id, workspaceId, err := parseApiManagementBackendID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := newApiManagementBackendClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
 
-	if resp, err := client.Delete(ctx, id.ResourceGroup, id.ServiceName, id.Name, ""); err != nil {
+	if resp, err := client.Delete(ctx, id.Name); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
 			return fmt.Errorf("deleting %s: %s", *id, err)
 		}
@@ -642,3 +662,239 @@ func flattenApiManagementBackendTls(input *apimanagement.BackendTLSProperties) [
 	}
 	return append(results, result)
 }
+
+// parseApiManagementBackendID parses the ID of a Backend within either an API Management Service or a Workspace,
+// returning the ID of the Workspace when the Backend is within one
+func parseApiManagementBackendID(input string) (*parse.BackendId, *parse.WorkspaceId, error) {
+	if workspaceBackendId, err := parse.WorkspaceBackendID(input); err == nil {
+		id := parse.NewBackendID(workspaceBackendId.SubscriptionId, workspaceBackendId.ResourceGroup, workspaceBackendId.ServiceName, workspaceBackendId.BackendName)
+		workspaceId := parse.NewWorkspaceID(workspaceBackendId.SubscriptionId, workspaceBackendId.ResourceGroup, workspaceBackendId.ServiceName, workspaceBackendId.WorkspaceName)
+		return &id, &workspaceId, nil
+	}
+
+	id, err := parse.BackendID(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	return id, nil, nil
+}
+
+// newApiManagementBackendClient returns a client managing Backends within either the API Management Service
+// `serviceName`, or the Workspace `workspaceId` within it when set
+func newApiManagementBackendClient(meta interface{}, resourceGroup, serviceName string, workspaceId *parse.WorkspaceId) workspaceScopedClient[apimanagement.BackendContract, apimanagement.BackendContract] {
+	if workspaceId == nil {
+		client := meta.(*clients.Client).ApiManagement.BackendClient
+		return workspaceScopedClient[apimanagement.BackendContract, apimanagement.BackendContract]{
+			CreateOrUpdate: func(ctx context.Context, name string, input apimanagement.BackendContract) error {
+				_, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, name, input, "")
+				return err
+			},
+			Get: func(ctx context.Context, name string) (apimanagement.BackendContract, error) {
+				return client.Get(ctx, resourceGroup, serviceName, name)
+			},
+			Delete: func(ctx context.Context, name string) (autorest.Response, error) {
+				return client.Delete(ctx, resourceGroup, serviceName, name, "")
+			},
+		}
+	}
+
+	client := meta.(*clients.Client).ApiManagement.WorkspaceBackendClient
+	backendId := func(name string) workspacebackend.WorkspaceBackendId {
+		return workspacebackend.NewWorkspaceBackendID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.ServiceName, workspaceId.Name, name)
+	}
+	return workspaceScopedClient[apimanagement.BackendContract, apimanagement.BackendContract]{
+		CreateOrUpdate: func(ctx context.Context, name string, input apimanagement.BackendContract) error {
+			_, err := client.CreateOrUpdate(ctx, backendId(name), convertBackendContractToWorkspace(input), workspacebackend.DefaultCreateOrUpdateOptions())
+			return err
+		},
+		Get: func(ctx context.Context, name string) (apimanagement.BackendContract, error) {
+			resp, err := client.Get(ctx, backendId(name))
+			result := convertBackendContractFromWorkspace(resp.Model)
+			result.Response = autorest.Response{Response: resp.HttpResponse}
+			return result, err
+		},
+		Delete: func(ctx context.Context, name string) (autorest.Response, error) {
+			resp, err := client.Delete(ctx, backendId(name), workspacebackend.DeleteOptions{
+				IfMatch: utils.String("*"),
+			})
+			return autorest.Response{Response: resp.HttpResponse}, err
+		},
+	}
+}
+
+func convertBackendContractToWorkspace(input apimanagement.BackendContract) workspacebackend.BackendContract {
+	output := workspacebackend.BackendContract{
+		Id:   input.ID,
+		Name: input.Name,
+		Type: input.Type,
+	}
+
+	props := input.BackendContractProperties
+	if props == nil {
+		return output
+	}
+
+	output.Properties = &workspacebackend.BackendContractProperties{
+		Description: props.Description,
+		Protocol:    workspacebackend.BackendProtocol(props.Protocol),
+		ResourceId:  props.ResourceID,
+		Title:       props.Title,
+		Url:         utils.NormalizeNilableString(props.URL),
+	}
+
+	if v := props.Credentials; v != nil {
+		credentials := workspacebackend.BackendCredentialsContract{
+			Certificate:    v.Certificate,
+			CertificateIds: v.CertificateIds,
+		}
+		if v.Authorization != nil {
+			credentials.Authorization = &workspacebackend.BackendAuthorizationHeaderCredentials{
+				Parameter: utils.NormalizeNilableString(v.Authorization.Parameter),
+				Scheme:    utils.NormalizeNilableString(v.Authorization.Scheme),
+			}
+		}
+		if v.Header != nil {
+			header := v.Header
+			credentials.Header = &header
+		}
+		if v.Query != nil {
+			query := v.Query
+			credentials.Query = &query
+		}
+		output.Properties.Credentials = &credentials
+	}
+
+	if v := props.Properties; v != nil && v.ServiceFabricCluster != nil {
+		cluster := v.ServiceFabricCluster
+		serviceFabricCluster := workspacebackend.BackendServiceFabricClusterProperties{
+			ClientCertificateId:          cluster.ClientCertificateID,
+			ClientCertificatethumbprint:  cluster.ClientCertificatethumbprint,
+			ManagementEndpoints:          make([]string, 0),
+			ServerCertificateThumbprints: cluster.ServerCertificateThumbprints,
+		}
+		if cluster.ManagementEndpoints != nil {
+			serviceFabricCluster.ManagementEndpoints = *cluster.ManagementEndpoints
+		}
+		if cluster.MaxPartitionResolutionRetries != nil {
+			serviceFabricCluster.MaxPartitionResolutionRetries = utils.Int64(int64(*cluster.MaxPartitionResolutionRetries))
+		}
+		if cluster.ServerX509Names != nil {
+			names := make([]workspacebackend.X509CertificateName, 0)
+			for _, name := range *cluster.ServerX509Names {
+				names = append(names, workspacebackend.X509CertificateName{
+					IssuerCertificateThumbprint: name.IssuerCertificateThumbprint,
+					Name:                        name.Name,
+				})
+			}
+			serviceFabricCluster.ServerX509Names = &names
+		}
+		output.Properties.Properties = &workspacebackend.BackendProperties{
+			ServiceFabricCluster: &serviceFabricCluster,
+		}
+	}
+
+	if v := props.Proxy; v != nil {
+		output.Properties.Proxy = &workspacebackend.BackendProxyContract{
+			Password: v.Password,
+			Url:      utils.NormalizeNilableString(v.URL),
+			Username: v.Username,
+		}
+	}
+
+	if v := props.TLS; v != nil {
+		output.Properties.Tls = &workspacebackend.BackendTlsProperties{
+			ValidateCertificateChain: v.ValidateCertificateChain,
+			ValidateCertificateName:  v.ValidateCertificateName,
+		}
+	}
+
+	return output
+}
+
+func convertBackendContractFromWorkspace(input *workspacebackend.BackendContract) apimanagement.BackendContract {
+	output := apimanagement.BackendContract{}
+	if input == nil {
+		return output
+	}
+
+	output.ID = input.Id
+	output.Name = input.Name
+	output.Type = input.Type
+
+	props := input.Properties
+	if props == nil {
+		return output
+	}
+
+	output.BackendContractProperties = &apimanagement.BackendContractProperties{
+		Description: props.Description,
+		Protocol:    apimanagement.BackendProtocol(props.Protocol),
+		ResourceID:  props.ResourceId,
+		Title:       props.Title,
+		URL:         utils.String(props.Url),
+	}
+
+	if v := props.Credentials; v != nil {
+		credentials := apimanagement.BackendCredentialsContract{
+			Certificate:    v.Certificate,
+			CertificateIds: v.CertificateIds,
+		}
+		if v.Authorization != nil {
+			credentials.Authorization = &apimanagement.BackendAuthorizationHeaderCredentials{
+				Parameter: utils.String(v.Authorization.Parameter),
+				Scheme:    utils.String(v.Authorization.Scheme),
+			}
+		}
+		if v.Header != nil {
+			credentials.Header = *v.Header
+		}
+		if v.Query != nil {
+			credentials.Query = *v.Query
+		}
+		output.BackendContractProperties.Credentials = &credentials
+	}
+
+	if v := props.Properties; v != nil && v.ServiceFabricCluster != nil {
+		cluster := v.ServiceFabricCluster
+		managementEndpoints := cluster.ManagementEndpoints
+		serviceFabricCluster := apimanagement.BackendServiceFabricClusterProperties{
+			ClientCertificateID:          cluster.ClientCertificateId,
+			ClientCertificatethumbprint:  cluster.ClientCertificatethumbprint,
+			ManagementEndpoints:          &managementEndpoints,
+			ServerCertificateThumbprints: cluster.ServerCertificateThumbprints,
+		}
+		if cluster.MaxPartitionResolutionRetries != nil {
+			serviceFabricCluster.MaxPartitionResolutionRetries = utils.Int32(int32(*cluster.MaxPartitionResolutionRetries))
+		}
+		if cluster.ServerX509Names != nil {
+			names := make([]apimanagement.X509CertificateName, 0)
+			for _, name := range *cluster.ServerX509Names {
+				names = append(names, apimanagement.X509CertificateName{
+					IssuerCertificateThumbprint: name.IssuerCertificateThumbprint,
+					Name:                        name.Name,
+				})
+			}
+			serviceFabricCluster.ServerX509Names = &names
+		}
+		output.BackendContractProperties.Properties = &apimanagement.BackendProperties{
+			ServiceFabricCluster: &serviceFabricCluster,
+		}
+	}
+
+	if v := props.Proxy; v != nil {
+		output.BackendContractProperties.Proxy = &apimanagement.BackendProxyContract{
+			Password: v.Password,
+			URL:      utils.String(v.Url),
+			Username: v.Username,
+		}
+	}
+
+	if v := props.Tls; v != nil {
+		output.BackendContractProperties.TLS = &apimanagement.BackendTLSProperties{
+			ValidateCertificateChain: v.ValidateCertificateChain,
+			ValidateCertificateName:  v.ValidateCertificateName,
+		}
+	}
+
+	return output
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacebackend"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -202,7 +203,33 @@ func TestAccApiManagementBackend_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementBackend_workspace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_backend", "test")
+	r := ApiManagementAuthorizationBackendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.workspace(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("workspace_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (ApiManagementAuthorizationBackendResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if workspaceBackendId, err := parse.WorkspaceBackendID(state.ID); err == nil {
+		id := workspacebackend.NewWorkspaceBackendID(workspaceBackendId.SubscriptionId, workspaceBackendId.ResourceGroup, workspaceBackendId.ServiceName, workspaceBackendId.WorkspaceName, workspaceBackendId.BackendName)
+		resp, err := clients.ApiManagement.WorkspaceBackendClient.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %+v", id, err)
+		}
+
+		return utils.Bool(resp.Model != nil && resp.Model.Id != nil), nil
+	}
+
 	id, err := parse.BackendID(state.ID)
 	if err != nil {
 		return nil, err
@@ -497,3 +524,18 @@ resource "azurerm_api_management_backend" "test" {
 }
 `, r.template(data, "all"), data.RandomInteger)
 }
+
+func (ApiManagementAuthorizationBackendResource) workspace(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backend" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  workspace_id        = azurerm_api_management_workspace.test.id
+  protocol            = "http"
+  url                 = "https://acctest"
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,273 @@
+package apimanagement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2021-08-01/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementGlobalSchemaModel struct {
+	SchemaId          string `tfschema:"schema_id"`
+	ApiManagementName string `tfschema:"api_management_name"`
+	ResourceGroupName string `tfschema:"resource_group_name"`
+	Type              string `tfschema:"type"`
+	Value             string `tfschema:"value"`
+	Description       string `tfschema:"description"`
+}
+
+type ApiManagementGlobalSchemaResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementGlobalSchemaResource{}
+
+func (r ApiManagementGlobalSchemaResource) ResourceType() string {
+	return "azurerm_api_management_global_schema"
+}
+
+func (r ApiManagementGlobalSchemaResource) ModelObject() interface{} {
+	return &ApiManagementGlobalSchemaModel{}
+}
+
+func (r ApiManagementGlobalSchemaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return schema.ValidateSchemaID
+}
+
+func (r ApiManagementGlobalSchemaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"schema_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_management_name": schemaz.SchemaApiManagementName(),
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(schema.PossibleValuesForSchemaType(), false),
+		},
+
+		"value": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
+				if d.Get("type").(string) == string(schema.SchemaTypeJson) {
+					return pluginsdk.SuppressJsonDiff(k, old, new, d)
+				}
+				return old == new
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ApiManagementGlobalSchemaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementGlobalSchemaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GlobalSchemaClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ApiManagementGlobalSchemaModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := schema.NewSchemaID(subscriptionId, model.ResourceGroupName, model.ApiManagementName, model.SchemaId)
+
+			existing, err := client.GlobalSchemaGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props, err := expandApiManagementGlobalSchemaProperties(model)
+			if err != nil {
+				return err
+			}
+
+			parameters := schema.GlobalSchemaContract{
+				Properties: props,
+			}
+			if err := client.GlobalSchemaCreateOrUpdateThenPoll(ctx, id, parameters, schema.DefaultGlobalSchemaCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementGlobalSchemaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GlobalSchemaClient
+
+			id, err := schema.ParseSchemaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GlobalSchemaGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementGlobalSchemaModel{
+				SchemaId:          id.SchemaId,
+				ApiManagementName: id.ServiceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Type = string(props.SchemaType)
+					state.Description = utils.NormalizeNilableString(props.Description)
+
+					value, err := flattenApiManagementGlobalSchemaValue(props)
+					if err != nil {
+						return fmt.Errorf("flattening `value`: %+v", err)
+					}
+					state.Value = value
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementGlobalSchemaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GlobalSchemaClient
+
+			id, err := schema.ParseSchemaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementGlobalSchemaModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			props, err := expandApiManagementGlobalSchemaProperties(model)
+			if err != nil {
+				return err
+			}
+
+			parameters := schema.GlobalSchemaContract{
+				Properties: props,
+			}
+			if err := client.GlobalSchemaCreateOrUpdateThenPoll(ctx, *id, parameters, schema.DefaultGlobalSchemaCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementGlobalSchemaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GlobalSchemaClient
+
+			id, err := schema.ParseSchemaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := schema.GlobalSchemaDeleteOperationOptions{
+				IfMatch: utils.String("*"),
+			}
+			if resp, err := client.GlobalSchemaDelete(ctx, *id, options); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementGlobalSchemaProperties(model ApiManagementGlobalSchemaModel) (*schema.GlobalSchemaContractProperties, error) {
+	props := schema.GlobalSchemaContractProperties{
+		SchemaType: schema.SchemaType(model.Type),
+	}
+
+	if model.Description != "" {
+		props.Description = utils.String(model.Description)
+	}
+
+	// XML Schemas are sent as a string in `value`, whereas JSON Schemas are sent as an object in `document`
+	if props.SchemaType == schema.SchemaTypeJson {
+		var document interface{}
+		if err := json.Unmarshal([]byte(model.Value), &document); err != nil {
+			return nil, fmt.Errorf("unmarshaling `value` as JSON: %+v", err)
+		}
+		props.Document = &document
+	} else {
+		var value interface{} = model.Value
+		props.Value = &value
+	}
+
+	return &props, nil
+}
+
+func flattenApiManagementGlobalSchemaValue(input *schema.GlobalSchemaContractProperties) (string, error) {
+	if input.SchemaType == schema.SchemaTypeJson {
+		if input.Document == nil {
+			return "", nil
+		}
+		document, err := json.Marshal(*input.Document)
+		if err != nil {
+			return "", err
+		}
+		return string(document), nil
+	}
+
+	if input.Value == nil {
+		return "", nil
+	}
+	if v, ok := (*input.Value).(string); ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("expected `value` to be a string but got %T", *input.Value)
+}
@@ -0,0 +1,171 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2021-08-01/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementGlobalSchemaResource struct{}
+
+func TestAccApiManagementGlobalSchema_xml(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_global_schema", "test")
+	r := ApiManagementGlobalSchemaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.xml(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementGlobalSchema_json(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_global_schema", "test")
+	r := ApiManagementGlobalSchemaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.json(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementGlobalSchema_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_global_schema", "test")
+	r := ApiManagementGlobalSchemaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.xml(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementGlobalSchema_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_global_schema", "test")
+	r := ApiManagementGlobalSchemaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.xml(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.xmlUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementGlobalSchemaResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := schema.ParseSchemaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ApiManagement.GlobalSchemaClient.GlobalSchemaGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApiManagementGlobalSchemaResource) xml(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_global_schema" "test" {
+  schema_id           = "acctestschema%d"
+  api_management_name = azurerm_api_management.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  type                = "xml"
+  value               = <<XML
+<xsd:schema xmlns:xsd="http://www.w3.org/2001/XMLSchema">
+  <xsd:element name="Customer" type="xsd:string"/>
+</xsd:schema>
+XML
+}
+`, ApiManagementResource{}.consumption(data), data.RandomInteger)
+}
+
+func (r ApiManagementGlobalSchemaResource) xmlUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_global_schema" "test" {
+  schema_id           = "acctestschema%d"
+  api_management_name = azurerm_api_management.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  type                = "xml"
+  description         = "updated"
+  value               = <<XML
+<xsd:schema xmlns:xsd="http://www.w3.org/2001/XMLSchema">
+  <xsd:element name="Customer" type="xsd:string"/>
+  <xsd:element name="Order" type="xsd:string"/>
+</xsd:schema>
+XML
+}
+`, ApiManagementResource{}.consumption(data), data.RandomInteger)
+}
+
+func (r ApiManagementGlobalSchemaResource) json(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_global_schema" "test" {
+  schema_id           = "acctestschema%d"
+  api_management_name = azurerm_api_management.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  type                = "json"
+  description         = "Customer Schema"
+  value = jsonencode({
+    type = "object"
+    properties = {
+      name = {
+        type = "string"
+      }
+    }
+  })
+}
+`, ApiManagementResource{}.consumption(data), data.RandomInteger)
+}
+
+func (r ApiManagementGlobalSchemaResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_global_schema" "import" {
+  schema_id           = azurerm_api_management_global_schema.test.schema_id
+  api_management_name = azurerm_api_management_global_schema.test.api_management_name
+  resource_group_name = azurerm_api_management_global_schema.test.resource_group_name
+  type                = azurerm_api_management_global_schema.test.type
+  value               = azurerm_api_management_global_schema.test.value
+}
+`, r.xml(data))
+}
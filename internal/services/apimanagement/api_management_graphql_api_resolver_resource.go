@@ -0,0 +1,280 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/graphqlapiresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementGraphQLApiResolverModel struct {
+	Name        string `tfschema:"name"`
+	ApiId       string `tfschema:"api_id"`
+	DisplayName string `tfschema:"display_name"`
+	Path        string `tfschema:"path"`
+	Description string `tfschema:"description"`
+	XmlContent  string `tfschema:"xml_content"`
+}
+
+type ApiManagementGraphQLApiResolverResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementGraphQLApiResolverResource{}
+
+func (r ApiManagementGraphQLApiResolverResource) ResourceType() string {
+	return "azurerm_api_management_graphql_api_resolver"
+}
+
+func (r ApiManagementGraphQLApiResolverResource) ModelObject() interface{} {
+	return &ApiManagementGraphQLApiResolverModel{}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return graphqlapiresolver.ValidateResolverID
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		// the path is the GraphQL type and field which this resolver resolves, e.g. `Query/users`
+		"path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"xml_content": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsNotEmpty,
+			DiffSuppressFunc: XmlWithDotNetInterpolationsDiffSuppress,
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+
+			var model ApiManagementGraphQLApiResolverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			apiId, err := parse.ApiID(model.ApiId)
+			if err != nil {
+				return err
+			}
+
+			id := graphqlapiresolver.NewResolverID(apiId.SubscriptionId, apiId.ResourceGroup, apiId.ServiceName, apiId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := graphqlapiresolver.ResolverContract{
+				Properties: &graphqlapiresolver.ResolverEntityBaseContract{
+					DisplayName: utils.String(model.DisplayName),
+					Path:        utils.String(model.Path),
+				},
+			}
+			if model.Description != "" {
+				parameters.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters, graphqlapiresolver.DefaultCreateOrUpdateOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if model.XmlContent != "" {
+				if _, err := client.PolicyCreateOrUpdate(ctx, id, expandApiManagementGraphQLApiResolverPolicy(model.XmlContent), graphqlapiresolver.DefaultPolicyCreateOrUpdateOptions()); err != nil {
+					return fmt.Errorf("creating the Policy for %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+
+			id, err := graphqlapiresolver.ParseResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiManagementGraphQLApiResolverModel{
+				Name:  id.ResolverId,
+				ApiId: parse.NewApiID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+					state.Path = utils.NormalizeNilableString(props.Path)
+					state.Description = utils.NormalizeNilableString(props.Description)
+				}
+			}
+
+			policy, err := client.PolicyGet(ctx, *id)
+			if err != nil {
+				if !response.WasNotFound(policy.HttpResponse) {
+					return fmt.Errorf("retrieving the Policy for %s: %+v", *id, err)
+				}
+			}
+			if model := policy.Model; model != nil && model.Properties != nil {
+				state.XmlContent = html.UnescapeString(model.Properties.Value)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+
+			id, err := graphqlapiresolver.ParseResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementGraphQLApiResolverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("display_name", "path", "description") {
+				parameters := graphqlapiresolver.ResolverUpdateContract{
+					Properties: &graphqlapiresolver.ResolverUpdateContractProperties{
+						DisplayName: utils.String(model.DisplayName),
+						Path:        utils.String(model.Path),
+						Description: utils.String(model.Description),
+					},
+				}
+
+				options := graphqlapiresolver.UpdateOptions{
+					IfMatch: utils.String("*"),
+				}
+				if _, err := client.Update(ctx, *id, parameters, options); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("xml_content") {
+				if model.XmlContent != "" {
+					if _, err := client.PolicyCreateOrUpdate(ctx, *id, expandApiManagementGraphQLApiResolverPolicy(model.XmlContent), graphqlapiresolver.DefaultPolicyCreateOrUpdateOptions()); err != nil {
+						return fmt.Errorf("updating the Policy for %s: %+v", *id, err)
+					}
+				} else {
+					options := graphqlapiresolver.PolicyDeleteOptions{
+						IfMatch: utils.String("*"),
+					}
+					if resp, err := client.PolicyDelete(ctx, *id, options); err != nil {
+						if !response.WasNotFound(resp.HttpResponse) {
+							return fmt.Errorf("removing the Policy for %s: %+v", *id, err)
+						}
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+
+			id, err := graphqlapiresolver.ParseResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the Policy for this Resolver is removed along with the Resolver
+			options := graphqlapiresolver.DeleteOptions{
+				IfMatch: utils.String("*"),
+			}
+			if resp, err := client.Delete(ctx, *id, options); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementGraphQLApiResolverPolicy(input string) graphqlapiresolver.PolicyContract {
+	format := graphqlapiresolver.PolicyContentFormatRawxml
+	return graphqlapiresolver.PolicyContract{
+		Properties: &graphqlapiresolver.PolicyContractProperties{
+			Format: &format,
+			Value:  input,
+		},
+	}
+}
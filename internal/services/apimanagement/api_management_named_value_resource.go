@@ -1,16 +1,20 @@
 package apimanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacenamedvalue"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -25,7 +29,7 @@ func resourceApiManagementNamedValue() *pluginsdk.Resource {
 		Update: resourceApiManagementNamedValueCreateUpdate,
 		Delete: resourceApiManagementNamedValueDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.NamedValueID(id)
+			_, _, err := parseApiManagementNamedValueID(id)
 			return err
 		}),
 
@@ -91,20 +95,32 @@ func resourceApiManagementNamedValue() *pluginsdk.Resource {
 					Type: pluginsdk.TypeString,
 				},
 			},
+
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
 		},
 	}
 }
 
 func resourceApiManagementNamedValueCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.NamedValueClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	id := parse.NewNamedValueID(subscriptionId, d.Get("resource_group_name").(string), d.Get("api_management_name").(string), d.Get("name").(string))
 
+	workspaceId, err := expandApiManagementWorkspaceID(d.Get("workspace_id").(string), id.SubscriptionId, id.ResourceGroup, id.ServiceName)
+	if err != nil {
+		return err
+	}
+	client := newApiManagementNamedValueClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
+
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+		existing, err := client.Get(ctx, id.Name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
 				return fmt.Errorf(" checking for presence of existing %s: %s", id, err)
@@ -132,31 +148,30 @@ func resourceApiManagementNamedValueCreateUpdate(d *pluginsdk.ResourceData, meta
 		parameters.NamedValueCreateContractProperties.Tags = utils.ExpandStringSlice(tags.([]interface{}))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, id.Name, parameters, "")
-	if err != nil {
+	if err := client.CreateOrUpdate(ctx, id.Name, parameters); err != nil {
 		return fmt.Errorf(" creating or updating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting on creating/updating %s: %+v", id, err)
+	if workspaceId != nil {
+		d.SetId(parse.NewWorkspaceNamedValueID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, workspaceId.Name, id.Name).ID())
+	} else {
+		d.SetId(id.ID())
 	}
 
-	d.SetId(id.ID())
-
 	return resourceApiManagementNamedValueRead(d, meta)
 }
 
 func resourceApiManagementNamedValueRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.NamedValueClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.NamedValueID(d.Id())
+	id, workspaceId, err := parseApiManagementNamedValueID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := newApiManagementNamedValueClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	resp, err := client.Get(ctx, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
@@ -169,6 +184,7 @@ func resourceApiManagementNamedValueRead(d *pluginsdk.ResourceData, meta interfa
 
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("workspace_id", flattenApiManagementWorkspaceID(workspaceId))
 	d.Set("api_management_name", id.ServiceName)
 
 	if properties := resp.NamedValueContractProperties; properties != nil {
@@ -188,16 +204,16 @@ func resourceApiManagementNamedValueRead(d *pluginsdk.ResourceData, meta interfa
 }
 
 func resourceApiManagementNamedValueDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.NamedValueClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.NamedValueID(d.Id())
+	id, workspaceId, err := parseApiManagementNamedValueID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := newApiManagementNamedValueClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
 
-	if resp, err := client.Delete(ctx, id.ResourceGroup, id.ServiceName, id.Name, ""); err != nil {
+	if resp, err := client.Delete(ctx, id.Name); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
 			return fmt.Errorf(" deleting %s: %+v", *id, err)
 		}
@@ -244,3 +260,131 @@ func flattenApiManagementNamedValueKeyVault(input *apimanagement.KeyVaultContrac
 		},
 	}
 }
+
+// parseApiManagementNamedValueID parses the ID of a Named Value within either an API Management Service or a Workspace,
+// returning the ID of the Workspace when the Named Value is within one
+func parseApiManagementNamedValueID(input string) (*parse.NamedValueId, *parse.WorkspaceId, error) {
+	if workspaceNamedValueId, err := parse.WorkspaceNamedValueID(input); err == nil {
+		id := parse.NewNamedValueID(workspaceNamedValueId.SubscriptionId, workspaceNamedValueId.ResourceGroup, workspaceNamedValueId.ServiceName, workspaceNamedValueId.NamedValueName)
+		workspaceId := parse.NewWorkspaceID(workspaceNamedValueId.SubscriptionId, workspaceNamedValueId.ResourceGroup, workspaceNamedValueId.ServiceName, workspaceNamedValueId.WorkspaceName)
+		return &id, &workspaceId, nil
+	}
+
+	id, err := parse.NamedValueID(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	return id, nil, nil
+}
+
+// newApiManagementNamedValueClient returns a client managing Named Values within either the API Management Service
+// `serviceName`, or the Workspace `workspaceId` within it when set
+func newApiManagementNamedValueClient(meta interface{}, resourceGroup, serviceName string, workspaceId *parse.WorkspaceId) workspaceScopedClient[apimanagement.NamedValueCreateContract, apimanagement.NamedValueContract] {
+	if workspaceId == nil {
+		client := meta.(*clients.Client).ApiManagement.NamedValueClient
+		return workspaceScopedClient[apimanagement.NamedValueCreateContract, apimanagement.NamedValueContract]{
+			CreateOrUpdate: func(ctx context.Context, name string, input apimanagement.NamedValueCreateContract) error {
+				future, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, name, input, "")
+				if err != nil {
+					return err
+				}
+				return future.WaitForCompletionRef(ctx, client.Client)
+			},
+			Get: func(ctx context.Context, name string) (apimanagement.NamedValueContract, error) {
+				return client.Get(ctx, resourceGroup, serviceName, name)
+			},
+			Delete: func(ctx context.Context, name string) (autorest.Response, error) {
+				return client.Delete(ctx, resourceGroup, serviceName, name, "")
+			},
+		}
+	}
+
+	client := meta.(*clients.Client).ApiManagement.WorkspaceNamedValueClient
+	namedValueId := func(name string) workspacenamedvalue.WorkspaceNamedValueId {
+		return workspacenamedvalue.NewWorkspaceNamedValueID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.ServiceName, workspaceId.Name, name)
+	}
+	return workspaceScopedClient[apimanagement.NamedValueCreateContract, apimanagement.NamedValueContract]{
+		CreateOrUpdate: func(ctx context.Context, name string, input apimanagement.NamedValueCreateContract) error {
+			return client.CreateOrUpdateThenPoll(ctx, namedValueId(name), convertNamedValueCreateContractToWorkspace(input), workspacenamedvalue.DefaultCreateOrUpdateOptions())
+		},
+		Get: func(ctx context.Context, name string) (apimanagement.NamedValueContract, error) {
+			resp, err := client.Get(ctx, namedValueId(name))
+			result := convertNamedValueContractFromWorkspace(resp.Model)
+			result.Response = autorest.Response{Response: resp.HttpResponse}
+			return result, err
+		},
+		Delete: func(ctx context.Context, name string) (autorest.Response, error) {
+			resp, err := client.Delete(ctx, namedValueId(name), workspacenamedvalue.DeleteOptions{
+				IfMatch: utils.String("*"),
+			})
+			return autorest.Response{Response: resp.HttpResponse}, err
+		},
+	}
+}
+
+func convertNamedValueCreateContractToWorkspace(input apimanagement.NamedValueCreateContract) workspacenamedvalue.NamedValueCreateContract {
+	output := workspacenamedvalue.NamedValueCreateContract{
+		Id:   input.ID,
+		Name: input.Name,
+		Type: input.Type,
+	}
+
+	props := input.NamedValueCreateContractProperties
+	if props == nil {
+		return output
+	}
+
+	output.Properties = &workspacenamedvalue.NamedValueCreateContractProperties{
+		DisplayName: utils.NormalizeNilableString(props.DisplayName),
+		Secret:      props.Secret,
+		Tags:        props.Tags,
+		Value:       props.Value,
+	}
+	if v := props.KeyVault; v != nil {
+		output.Properties.KeyVault = &workspacenamedvalue.KeyVaultContractCreateProperties{
+			IdentityClientId: v.IdentityClientID,
+			SecretIdentifier: v.SecretIdentifier,
+		}
+	}
+
+	return output
+}
+
+func convertNamedValueContractFromWorkspace(input *workspacenamedvalue.NamedValueContract) apimanagement.NamedValueContract {
+	output := apimanagement.NamedValueContract{}
+	if input == nil {
+		return output
+	}
+
+	output.ID = input.Id
+	output.Name = input.Name
+	output.Type = input.Type
+
+	props := input.Properties
+	if props == nil {
+		return output
+	}
+
+	output.NamedValueContractProperties = &apimanagement.NamedValueContractProperties{
+		DisplayName: utils.String(props.DisplayName),
+		Secret:      props.Secret,
+		Tags:        props.Tags,
+		Value:       props.Value,
+	}
+	if v := props.KeyVault; v != nil {
+		keyVault := apimanagement.KeyVaultContractProperties{
+			IdentityClientID: v.IdentityClientId,
+			SecretIdentifier: v.SecretIdentifier,
+		}
+		if lastStatus := v.LastStatus; lastStatus != nil {
+			keyVault.LastStatus = &apimanagement.KeyVaultLastAccessStatusContractProperties{
+				Code:         lastStatus.Code,
+				Message:      lastStatus.Message,
+				TimeStampUtc: convertWorkspaceDateTime(lastStatus.TimeStampUtc),
+			}
+		}
+		output.NamedValueContractProperties.KeyVault = &keyVault
+	}
+
+	return output
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacenamedvalue"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -111,7 +112,33 @@ func TestAccApiManagementNamedValue_update(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementNamedValue_workspace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_named_value", "test")
+	r := ApiManagementNamedValueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.workspace(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("workspace_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (ApiManagementNamedValueResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if workspaceNamedValueId, err := parse.WorkspaceNamedValueID(state.ID); err == nil {
+		id := workspacenamedvalue.NewWorkspaceNamedValueID(workspaceNamedValueId.SubscriptionId, workspaceNamedValueId.ResourceGroup, workspaceNamedValueId.ServiceName, workspaceNamedValueId.WorkspaceName, workspaceNamedValueId.NamedValueName)
+		resp, err := clients.ApiManagement.WorkspaceNamedValueClient.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %+v", id, err)
+		}
+
+		return utils.Bool(resp.Model != nil && resp.Model.Id != nil), nil
+	}
+
 	id, err := parse.NamedValueID(state.ID)
 	if err != nil {
 		return nil, err
@@ -443,3 +470,18 @@ resource "azurerm_api_management_named_value" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
+
+func (ApiManagementNamedValueResource) workspace(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_named_value" "test" {
+  name                = "acctestAMProperty-%d"
+  resource_group_name = azurerm_api_management.test.resource_group_name
+  api_management_name = azurerm_api_management.test.name
+  workspace_id        = azurerm_api_management_workspace.test.id
+  display_name        = "TestProperty%d"
+  value               = "Test Value"
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger, data.RandomInteger)
+}
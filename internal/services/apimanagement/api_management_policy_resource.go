@@ -1,16 +1,20 @@
 package apimanagement
 
 import (
+	"context"
 	"fmt"
 	"html"
 	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacepolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -24,7 +28,7 @@ func resourceApiManagementPolicy() *pluginsdk.Resource {
 		Delete: resourceApiManagementPolicyDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.PolicyID(id)
+			_, _, err := parseApiManagementPolicyID(id)
 			return err
 		}),
 
@@ -63,12 +67,18 @@ func resourceApiManagementPolicy() *pluginsdk.Resource {
 				ConflictsWith: []string{"xml_content"},
 				ExactlyOneOf:  []string{"xml_link", "xml_content"},
 			},
+
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
 		},
 	}
 }
 
 func resourceApiManagementPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.PolicyClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -80,6 +90,12 @@ func resourceApiManagementPolicyCreateUpdate(d *pluginsdk.ResourceData, meta int
 	resourceGroup := apiMgmtId.ResourceGroup
 	serviceName := apiMgmtId.ServiceName
 
+	workspaceId, err := expandApiManagementWorkspaceID(d.Get("workspace_id").(string), apiMgmtId.SubscriptionId, resourceGroup, serviceName)
+	if err != nil {
+		return err
+	}
+	client := newApiManagementPolicyClient(meta, resourceGroup, serviceName, workspaceId)
+
 	/*
 		Other resources would have a check for d.IsNewResource() at this location, and would error out using `tf.ImportAsExistsError` if the resource already existed.
 		However, this is a sub-resource, and the API always returns a policy when queried, either a default policy or one configured by the user or by this pluginsdk.
@@ -114,27 +130,29 @@ func resourceApiManagementPolicyCreateUpdate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("Either `xml_content` or `xml_link` must be set")
 	}
 
-	_, err = client.CreateOrUpdate(ctx, resourceGroup, serviceName, parameters, "")
-	if err != nil {
+	if err := client.CreateOrUpdate(ctx, "policy", parameters); err != nil {
 		return fmt.Errorf("creating or updating Policy (Resource Group %q / API Management Service %q): %+v", resourceGroup, serviceName, err)
 	}
 
-	id := parse.NewPolicyID(apiMgmtId.SubscriptionId, apiMgmtId.ResourceGroup, apiMgmtId.ServiceName, "policy")
-	d.SetId(id.ID())
+	if workspaceId != nil {
+		d.SetId(parse.NewWorkspacePolicyID(apiMgmtId.SubscriptionId, apiMgmtId.ResourceGroup, apiMgmtId.ServiceName, workspaceId.Name, "policy").ID())
+	} else {
+		d.SetId(parse.NewPolicyID(apiMgmtId.SubscriptionId, apiMgmtId.ResourceGroup, apiMgmtId.ServiceName, "policy").ID())
+	}
 
 	return resourceApiManagementPolicyRead(d, meta)
 }
 
 func resourceApiManagementPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	serviceClient := meta.(*clients.Client).ApiManagement.ServiceClient
-	client := meta.(*clients.Client).ApiManagement.PolicyClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.PolicyID(d.Id())
+	id, workspaceId, err := parseApiManagementPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := newApiManagementPolicyClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
 	resourceGroup := id.ResourceGroup
 	serviceName := id.ServiceName
 
@@ -150,8 +168,9 @@ func resourceApiManagementPolicyRead(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	d.Set("api_management_id", serviceResp.ID)
+	d.Set("workspace_id", flattenApiManagementWorkspaceID(workspaceId))
 
-	resp, err := client.Get(ctx, resourceGroup, serviceName, apimanagement.PolicyExportFormatXML)
+	resp, err := client.Get(ctx, "policy")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[DEBUG] Policy (Resource Group %q / API Management Service %q) was not found - removing from state!", resourceGroup, serviceName)
@@ -177,18 +196,18 @@ func resourceApiManagementPolicyRead(d *pluginsdk.ResourceData, meta interface{}
 }
 
 func resourceApiManagementPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.PolicyClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.PolicyID(d.Id())
+	id, workspaceId, err := parseApiManagementPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := newApiManagementPolicyClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
 	resourceGroup := id.ResourceGroup
 	serviceName := id.ServiceName
 
-	if resp, err := client.Delete(ctx, resourceGroup, serviceName, ""); err != nil {
+	if resp, err := client.Delete(ctx, "policy"); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
 			return fmt.Errorf("deleting Policy (Resource Group %q / API Management Service %q): %+v", resourceGroup, serviceName, err)
 		}
@@ -196,3 +215,107 @@ func resourceApiManagementPolicyDelete(d *pluginsdk.ResourceData, meta interface
 
 	return nil
 }
+
+// parseApiManagementPolicyID parses the ID of a Policy within either an API Management Service or a Workspace,
+// returning the ID of the Workspace when the Policy is within one
+func parseApiManagementPolicyID(input string) (*parse.PolicyId, *parse.WorkspaceId, error) {
+	if workspacePolicyId, err := parse.WorkspacePolicyID(input); err == nil {
+		id := parse.NewPolicyID(workspacePolicyId.SubscriptionId, workspacePolicyId.ResourceGroup, workspacePolicyId.ServiceName, workspacePolicyId.PolicyName)
+		workspaceId := parse.NewWorkspaceID(workspacePolicyId.SubscriptionId, workspacePolicyId.ResourceGroup, workspacePolicyId.ServiceName, workspacePolicyId.WorkspaceName)
+		return &id, &workspaceId, nil
+	}
+
+	id, err := parse.PolicyID(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	return id, nil, nil
+}
+
+// newApiManagementPolicyClient returns a client managing the Policy for either the API Management Service
+// `serviceName`, or the Workspace `workspaceId` within it when set - where the name of the Policy is always `policy`
+func newApiManagementPolicyClient(meta interface{}, resourceGroup, serviceName string, workspaceId *parse.WorkspaceId) workspaceScopedClient[apimanagement.PolicyContract, apimanagement.PolicyContract] {
+	if workspaceId == nil {
+		client := meta.(*clients.Client).ApiManagement.PolicyClient
+		return workspaceScopedClient[apimanagement.PolicyContract, apimanagement.PolicyContract]{
+			CreateOrUpdate: func(ctx context.Context, _ string, input apimanagement.PolicyContract) error {
+				_, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, input, "")
+				return err
+			},
+			Get: func(ctx context.Context, _ string) (apimanagement.PolicyContract, error) {
+				return client.Get(ctx, resourceGroup, serviceName, apimanagement.PolicyExportFormatXML)
+			},
+			Delete: func(ctx context.Context, _ string) (autorest.Response, error) {
+				return client.Delete(ctx, resourceGroup, serviceName, "")
+			},
+		}
+	}
+
+	client := meta.(*clients.Client).ApiManagement.WorkspacePolicyClient
+	policyId := func(name string) workspacepolicy.WorkspacePolicyId {
+		return workspacepolicy.NewWorkspacePolicyID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.ServiceName, workspaceId.Name, name)
+	}
+	return workspaceScopedClient[apimanagement.PolicyContract, apimanagement.PolicyContract]{
+		CreateOrUpdate: func(ctx context.Context, name string, input apimanagement.PolicyContract) error {
+			_, err := client.CreateOrUpdate(ctx, policyId(name), convertPolicyContractToWorkspace(input), workspacepolicy.DefaultCreateOrUpdateOptions())
+			return err
+		},
+		Get: func(ctx context.Context, name string) (apimanagement.PolicyContract, error) {
+			format := workspacepolicy.PolicyExportFormatXml
+			resp, err := client.Get(ctx, policyId(name), workspacepolicy.GetOptions{
+				Format: &format,
+			})
+			result := convertPolicyContractFromWorkspace(resp.Model)
+			result.Response = autorest.Response{Response: resp.HttpResponse}
+			return result, err
+		},
+		Delete: func(ctx context.Context, name string) (autorest.Response, error) {
+			resp, err := client.Delete(ctx, policyId(name), workspacepolicy.DeleteOptions{
+				IfMatch: utils.String("*"),
+			})
+			return autorest.Response{Response: resp.HttpResponse}, err
+		},
+	}
+}
+
+func convertPolicyContractToWorkspace(input apimanagement.PolicyContract) workspacepolicy.PolicyContract {
+	output := workspacepolicy.PolicyContract{
+		Id:   input.ID,
+		Name: input.Name,
+		Type: input.Type,
+	}
+
+	if props := input.PolicyContractProperties; props != nil {
+		output.Properties = &workspacepolicy.PolicyContractProperties{
+			Value: utils.NormalizeNilableString(props.Value),
+		}
+		if props.Format != "" {
+			format := workspacepolicy.PolicyContentFormat(props.Format)
+			output.Properties.Format = &format
+		}
+	}
+
+	return output
+}
+
+func convertPolicyContractFromWorkspace(input *workspacepolicy.PolicyContract) apimanagement.PolicyContract {
+	output := apimanagement.PolicyContract{}
+	if input == nil {
+		return output
+	}
+
+	output.ID = input.Id
+	output.Name = input.Name
+	output.Type = input.Type
+
+	if props := input.Properties; props != nil {
+		output.PolicyContractProperties = &apimanagement.PolicyContractProperties{
+			Value: utils.String(props.Value),
+		}
+		if props.Format != nil {
+			output.PolicyContractProperties.Format = apimanagement.PolicyContentFormat(*props.Format)
+		}
+	}
+
+	return output
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacepolicy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -67,7 +68,36 @@ func TestAccApiManagementPolicy_customPolicy(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementPolicy_workspace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_policy", "test")
+	r := ApiManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.workspace(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("workspace_id").Exists(),
+			),
+		},
+		data.ImportStep("xml_link"),
+	})
+}
+
 func (ApiManagementPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if workspacePolicyId, err := parse.WorkspacePolicyID(state.ID); err == nil {
+		id := workspacepolicy.NewWorkspacePolicyID(workspacePolicyId.SubscriptionId, workspacePolicyId.ResourceGroup, workspacePolicyId.ServiceName, workspacePolicyId.WorkspaceName, workspacePolicyId.PolicyName)
+		format := workspacepolicy.PolicyExportFormatXml
+		resp, err := clients.ApiManagement.WorkspacePolicyClient.Get(ctx, id, workspacepolicy.GetOptions{
+			Format: &format,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %+v", id, err)
+		}
+
+		return utils.Bool(resp.Model != nil && resp.Model.Id != nil), nil
+	}
+
 	id, err := parse.PolicyID(state.ID)
 	if err != nil {
 		return nil, err
@@ -145,3 +175,21 @@ XML
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (ApiManagementPolicyResource) workspace(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_policy" "test" {
+  api_management_id = azurerm_api_management.test.id
+  workspace_id      = azurerm_api_management_workspace.test.id
+  xml_content       = <<XML
+<policies>
+  <inbound>
+    <set-variable name="abc" value="@(context.Request.Headers.GetValueOrDefault("X-Header-Name", ""))" />
+  </inbound>
+</policies>
+XML
+}
+`, ApiManagementWorkspaceResource{}.basic(data))
+}
@@ -1,16 +1,20 @@
 package apimanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspaceproduct"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -24,7 +28,7 @@ func resourceApiManagementProduct() *pluginsdk.Resource {
 		Update: resourceApiManagementProductCreateUpdate,
 		Delete: resourceApiManagementProductDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ProductID(id)
+			_, _, err := parseApiManagementProductID(id)
 			return err
 		}),
 
@@ -78,12 +82,18 @@ func resourceApiManagementProduct() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeInt,
 				Optional: true,
 			},
+
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
 		},
 	}
 }
 
 func resourceApiManagementProductCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -92,6 +102,12 @@ func resourceApiManagementProductCreateUpdate(d *pluginsdk.ResourceData, meta in
 
 	id := parse.NewProductID(subscriptionId, d.Get("resource_group_name").(string), d.Get("api_management_name").(string), d.Get("product_id").(string))
 
+	workspaceId, err := expandApiManagementWorkspaceID(d.Get("workspace_id").(string), id.SubscriptionId, id.ResourceGroup, id.ServiceName)
+	if err != nil {
+		return err
+	}
+	client := newApiManagementProductClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
+
 	displayName := d.Get("display_name").(string)
 	description := d.Get("description").(string)
 	terms := d.Get("terms").(string)
@@ -101,7 +117,7 @@ func resourceApiManagementProductCreateUpdate(d *pluginsdk.ResourceData, meta in
 	published := d.Get("published").(bool)
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+		existing, err := client.Get(ctx, id.Name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
 				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
@@ -136,26 +152,30 @@ func resourceApiManagementProductCreateUpdate(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("`subscription_required` must be true and `subscriptions_limit` must be greater than 0 to use `approval_required`")
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, id.Name, properties, ""); err != nil {
+	if err := client.CreateOrUpdate(ctx, id.Name, properties); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	d.SetId(id.ID())
+	if workspaceId != nil {
+		d.SetId(parse.NewWorkspaceProductID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, workspaceId.Name, id.Name).ID())
+	} else {
+		d.SetId(id.ID())
+	}
 
 	return resourceApiManagementProductRead(d, meta)
 }
 
 func resourceApiManagementProductRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.ProductID(d.Id())
+	id, workspaceId, err := parseApiManagementProductID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := newApiManagementProductClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	resp, err := client.Get(ctx, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("%s was not found - removing from state!", *id)
@@ -169,6 +189,7 @@ func resourceApiManagementProductRead(d *pluginsdk.ResourceData, meta interface{
 	d.Set("product_id", id.Name)
 	d.Set("api_management_name", id.ServiceName)
 	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("workspace_id", flattenApiManagementWorkspaceID(workspaceId))
 
 	if props := resp.ProductContractProperties; props != nil {
 		d.Set("approval_required", props.ApprovalRequired)
@@ -184,18 +205,17 @@ func resourceApiManagementProductRead(d *pluginsdk.ResourceData, meta interface{
 }
 
 func resourceApiManagementProductDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.ProductsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.ProductID(d.Id())
+	id, workspaceId, err := parseApiManagementProductID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := newApiManagementProductClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
 
 	log.Printf("[DEBUG] Deleting %s", *id)
-	deleteSubscriptions := true
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.ServiceName, id.Name, "", utils.Bool(deleteSubscriptions))
+	resp, err := client.Delete(ctx, id.Name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
 			return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -204,3 +224,119 @@ func resourceApiManagementProductDelete(d *pluginsdk.ResourceData, meta interfac
 
 	return nil
 }
+
+// parseApiManagementProductID parses the ID of a Product within either an API Management Service or a Workspace,
+// returning the ID of the Workspace when the Product is within one
+func parseApiManagementProductID(input string) (*parse.ProductId, *parse.WorkspaceId, error) {
+	if workspaceProductId, err := parse.WorkspaceProductID(input); err == nil {
+		id := parse.NewProductID(workspaceProductId.SubscriptionId, workspaceProductId.ResourceGroup, workspaceProductId.ServiceName, workspaceProductId.ProductName)
+		workspaceId := parse.NewWorkspaceID(workspaceProductId.SubscriptionId, workspaceProductId.ResourceGroup, workspaceProductId.ServiceName, workspaceProductId.WorkspaceName)
+		return &id, &workspaceId, nil
+	}
+
+	id, err := parse.ProductID(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	return id, nil, nil
+}
+
+// newApiManagementProductClient returns a client managing Products within either the API Management Service
+// `serviceName`, or the Workspace `workspaceId` within it when set - deleting any Subscriptions along with a Product
+func newApiManagementProductClient(meta interface{}, resourceGroup, serviceName string, workspaceId *parse.WorkspaceId) workspaceScopedClient[apimanagement.ProductContract, apimanagement.ProductContract] {
+	if workspaceId == nil {
+		client := meta.(*clients.Client).ApiManagement.ProductsClient
+		return workspaceScopedClient[apimanagement.ProductContract, apimanagement.ProductContract]{
+			CreateOrUpdate: func(ctx context.Context, name string, input apimanagement.ProductContract) error {
+				_, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, name, input, "")
+				return err
+			},
+			Get: func(ctx context.Context, name string) (apimanagement.ProductContract, error) {
+				return client.Get(ctx, resourceGroup, serviceName, name)
+			},
+			Delete: func(ctx context.Context, name string) (autorest.Response, error) {
+				return client.Delete(ctx, resourceGroup, serviceName, name, "", utils.Bool(true))
+			},
+		}
+	}
+
+	client := meta.(*clients.Client).ApiManagement.WorkspaceProductClient
+	productId := func(name string) workspaceproduct.WorkspaceProductId {
+		return workspaceproduct.NewWorkspaceProductID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.ServiceName, workspaceId.Name, name)
+	}
+	return workspaceScopedClient[apimanagement.ProductContract, apimanagement.ProductContract]{
+		CreateOrUpdate: func(ctx context.Context, name string, input apimanagement.ProductContract) error {
+			_, err := client.CreateOrUpdate(ctx, productId(name), convertProductContractToWorkspace(input), workspaceproduct.DefaultCreateOrUpdateOptions())
+			return err
+		},
+		Get: func(ctx context.Context, name string) (apimanagement.ProductContract, error) {
+			resp, err := client.Get(ctx, productId(name))
+			result := convertProductContractFromWorkspace(resp.Model)
+			result.Response = autorest.Response{Response: resp.HttpResponse}
+			return result, err
+		},
+		Delete: func(ctx context.Context, name string) (autorest.Response, error) {
+			resp, err := client.Delete(ctx, productId(name), workspaceproduct.DeleteOptions{
+				IfMatch:             utils.String("*"),
+				DeleteSubscriptions: utils.Bool(true),
+			})
+			return autorest.Response{Response: resp.HttpResponse}, err
+		},
+	}
+}
+
+func convertProductContractToWorkspace(input apimanagement.ProductContract) workspaceproduct.ProductContract {
+	output := workspaceproduct.ProductContract{
+		Id:   input.ID,
+		Name: input.Name,
+		Type: input.Type,
+	}
+
+	if props := input.ProductContractProperties; props != nil {
+		output.Properties = &workspaceproduct.ProductContractProperties{
+			ApprovalRequired:     props.ApprovalRequired,
+			Description:          props.Description,
+			DisplayName:          utils.NormalizeNilableString(props.DisplayName),
+			SubscriptionRequired: props.SubscriptionRequired,
+			Terms:                props.Terms,
+		}
+		if props.State != "" {
+			state := workspaceproduct.ProductState(props.State)
+			output.Properties.State = &state
+		}
+		if props.SubscriptionsLimit != nil {
+			output.Properties.SubscriptionsLimit = utils.Int64(int64(*props.SubscriptionsLimit))
+		}
+	}
+
+	return output
+}
+
+func convertProductContractFromWorkspace(input *workspaceproduct.ProductContract) apimanagement.ProductContract {
+	output := apimanagement.ProductContract{}
+	if input == nil {
+		return output
+	}
+
+	output.ID = input.Id
+	output.Name = input.Name
+	output.Type = input.Type
+
+	if props := input.Properties; props != nil {
+		output.ProductContractProperties = &apimanagement.ProductContractProperties{
+			ApprovalRequired:     props.ApprovalRequired,
+			Description:          props.Description,
+			DisplayName:          utils.String(props.DisplayName),
+			SubscriptionRequired: props.SubscriptionRequired,
+			Terms:                props.Terms,
+		}
+		if props.State != nil {
+			output.ProductContractProperties.State = apimanagement.ProductState(*props.State)
+		}
+		if props.SubscriptionsLimit != nil {
+			output.ProductContractProperties.SubscriptionsLimit = utils.Int32(int32(*props.SubscriptionsLimit))
+		}
+	}
+
+	return output
+}
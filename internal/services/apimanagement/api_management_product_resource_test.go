@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspaceproduct"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -177,7 +178,33 @@ func TestAccApiManagementProduct_subscriptionRequiredDefault(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementProduct_workspace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_product", "test")
+	r := ApiManagementProductResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.workspace(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("workspace_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (ApiManagementProductResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if workspaceProductId, err := parse.WorkspaceProductID(state.ID); err == nil {
+		id := workspaceproduct.NewWorkspaceProductID(workspaceProductId.SubscriptionId, workspaceProductId.ResourceGroup, workspaceProductId.ServiceName, workspaceProductId.WorkspaceName, workspaceProductId.ProductName)
+		resp, err := clients.ApiManagement.WorkspaceProductClient.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %+v", id, err)
+		}
+
+		return utils.Bool(resp.Model != nil && resp.Model.Id != nil), nil
+	}
+
 	id, err := parse.ProductID(state.ID)
 	if err != nil {
 		return nil, err
@@ -409,3 +436,19 @@ resource "azurerm_api_management_product" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (ApiManagementProductResource) workspace(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_product" "test" {
+  product_id            = "test-product"
+  api_management_name   = azurerm_api_management.test.name
+  resource_group_name   = azurerm_resource_group.test.name
+  workspace_id          = azurerm_api_management_workspace.test.id
+  display_name          = "Test Product"
+  subscription_required = false
+  published             = false
+}
+`, ApiManagementWorkspaceResource{}.basic(data))
+}
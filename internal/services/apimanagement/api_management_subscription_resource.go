@@ -1,6 +1,7 @@
 package apimanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacesubscription"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -30,7 +32,7 @@ func resourceApiManagementSubscription() *pluginsdk.Resource {
 		Delete: resourceApiManagementSubscriptionDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SubscriptionID(id)
+			_, _, err := parseApiManagementSubscriptionID(id)
 			return err
 		}),
 
@@ -72,7 +74,7 @@ func resourceApiManagementSubscription() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ValidateFunc:  validate.ProductID,
+				ValidateFunc:  validation.Any(validate.ProductID, validate.WorkspaceProductID),
 				ConflictsWith: []string{"api_id"},
 			},
 
@@ -80,7 +82,7 @@ func resourceApiManagementSubscription() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ValidateFunc:  validate.ApiID,
+				ValidateFunc:  validation.Any(validate.ApiID, validate.WorkspaceApiID),
 				ConflictsWith: []string{"product_id"},
 			},
 
@@ -117,12 +119,18 @@ func resourceApiManagementSubscription() *pluginsdk.Resource {
 				Optional: true,
 				Default:  true,
 			},
+
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
 		},
 	}
 }
 
 func resourceApiManagementSubscriptionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.SubscriptionsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -138,8 +146,14 @@ func resourceApiManagementSubscriptionCreateUpdate(d *pluginsdk.ResourceData, me
 	}
 	id := parse.NewSubscriptionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("api_management_name").(string), subName)
 
+	workspaceId, err := expandApiManagementWorkspaceID(d.Get("workspace_id").(string), id.SubscriptionId, id.ResourceGroup, id.ServiceName)
+	if err != nil {
+		return err
+	}
+	client := newApiManagementSubscriptionClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
+
 	if d.IsNewResource() {
-		resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+		resp, err := client.Get(ctx, id.Name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(resp.Response) {
 				return fmt.Errorf("checking for present of existing %s: %+v", id, err)
@@ -187,10 +201,8 @@ func resourceApiManagementSubscriptionCreateUpdate(d *pluginsdk.ResourceData, me
 		params.SubscriptionCreateParameterProperties.SecondaryKey = utils.String(v.(string))
 	}
 
-	sendEmail := utils.Bool(false)
-
-	err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
-		if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, id.Name, params, sendEmail, "", apimanagement.AppTypeDeveloperPortal); err != nil {
+	err = pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
+		if err := client.CreateOrUpdate(ctx, id.Name, params); err != nil {
 			// APIM admins set limit on number of subscriptions to a product.  In order to be able to correctly enforce that limit service cannot let simultaneous creations
 			// to go through and first one wins/subsequent one gets 412 and that client/user can retry. This ensures that we have proper limits enforces as desired by APIM admin.
 			if v, ok := err.(autorest.DetailedError); ok && v.StatusCode == http.StatusPreconditionFailed {
@@ -204,22 +216,26 @@ func resourceApiManagementSubscriptionCreateUpdate(d *pluginsdk.ResourceData, me
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	d.SetId(id.ID())
+	if workspaceId != nil {
+		d.SetId(parse.NewWorkspaceSubscriptionID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, workspaceId.Name, id.Name).ID())
+	} else {
+		d.SetId(id.ID())
+	}
 
 	return resourceApiManagementSubscriptionRead(d, meta)
 }
 
 func resourceApiManagementSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.SubscriptionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.SubscriptionID(d.Id())
+	id, workspaceId, err := parseApiManagementSubscriptionID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := newApiManagementSubscriptionClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	resp, err := client.Get(ctx, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[DEBUG] Subscription %q was not found in API Management Service %q / Resource Group %q - removing from state!", id.Name, id.ServiceName, id.ResourceGroup)
@@ -232,6 +248,7 @@ func resourceApiManagementSubscriptionRead(d *pluginsdk.ResourceData, meta inter
 
 	d.Set("subscription_id", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("workspace_id", flattenApiManagementWorkspaceID(workspaceId))
 	d.Set("api_management_name", id.ServiceName)
 
 	if props := resp.SubscriptionContractProperties; props != nil {
@@ -241,10 +258,13 @@ func resourceApiManagementSubscriptionRead(d *pluginsdk.ResourceData, meta inter
 		apiId := ""
 		// check if the subscription is for all apis or a specific product/ api
 		if props.Scope != nil && *props.Scope != "" && !strings.HasSuffix(*props.Scope, "/apis") {
-			// the scope is either a product or api id
-			parseId, err := parse.ProductID(*props.Scope)
-			if err == nil {
+			// the scope is either a product or api id, which may be within a workspace
+			if parseId, err := parse.WorkspaceProductID(*props.Scope); err == nil {
 				productId = parseId.ID()
+			} else if parseId, err := parse.ProductID(*props.Scope); err == nil {
+				productId = parseId.ID()
+			} else if parsedApiId, err := parse.WorkspaceApiID(*props.Scope); err == nil {
+				apiId = parsedApiId.ID()
 			} else {
 				parsedApiId, err := parse.ApiID(*props.Scope)
 				if err != nil {
@@ -260,27 +280,27 @@ func resourceApiManagementSubscriptionRead(d *pluginsdk.ResourceData, meta inter
 	}
 
 	// Primary and secondary keys must be got from this additional api
-	keyResp, err := client.ListSecrets(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	primaryKey, secondaryKey, err := listApiManagementSubscriptionSecrets(ctx, meta, *id, workspaceId)
 	if err != nil {
 		return fmt.Errorf("listing Subscription %q Primary and Secondary Keys (API Management Service %q / Resource Group %q): %+v", id.Name, id.ServiceName, id.ResourceGroup, err)
 	}
-	d.Set("primary_key", keyResp.PrimaryKey)
-	d.Set("secondary_key", keyResp.SecondaryKey)
+	d.Set("primary_key", primaryKey)
+	d.Set("secondary_key", secondaryKey)
 
 	return nil
 }
 
 func resourceApiManagementSubscriptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ApiManagement.SubscriptionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.SubscriptionID(d.Id())
+	id, workspaceId, err := parseApiManagementSubscriptionID(d.Id())
 	if err != nil {
 		return err
 	}
+	client := newApiManagementSubscriptionClient(meta, id.ResourceGroup, id.ServiceName, workspaceId)
 
-	if resp, err := client.Delete(ctx, id.ResourceGroup, id.ServiceName, id.Name, ""); err != nil {
+	if resp, err := client.Delete(ctx, id.Name); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
 			return fmt.Errorf("removing Subscription %q (API Management Service %q / Resource Group %q): %+v", id.Name, id.ServiceName, id.ResourceGroup, err)
 		}
@@ -288,3 +308,140 @@ func resourceApiManagementSubscriptionDelete(d *pluginsdk.ResourceData, meta int
 
 	return nil
 }
+
+// parseApiManagementSubscriptionID parses the ID of a Subscription within either an API Management Service or a Workspace,
+// returning the ID of the Workspace when the Subscription is within one
+func parseApiManagementSubscriptionID(input string) (*parse.SubscriptionId, *parse.WorkspaceId, error) {
+	if workspaceSubscriptionId, err := parse.WorkspaceSubscriptionID(input); err == nil {
+		id := parse.NewSubscriptionID(workspaceSubscriptionId.SubscriptionId, workspaceSubscriptionId.ResourceGroup, workspaceSubscriptionId.ServiceName, workspaceSubscriptionId.SubscriptionName)
+		workspaceId := parse.NewWorkspaceID(workspaceSubscriptionId.SubscriptionId, workspaceSubscriptionId.ResourceGroup, workspaceSubscriptionId.ServiceName, workspaceSubscriptionId.WorkspaceName)
+		return &id, &workspaceId, nil
+	}
+
+	id, err := parse.SubscriptionID(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	return id, nil, nil
+}
+
+// newApiManagementSubscriptionClient returns a client managing Subscriptions within either the API Management Service
+// `serviceName`, or the Workspace `workspaceId` within it when set - without notifying the owner of any changes
+func newApiManagementSubscriptionClient(meta interface{}, resourceGroup, serviceName string, workspaceId *parse.WorkspaceId) workspaceScopedClient[apimanagement.SubscriptionCreateParameters, apimanagement.SubscriptionContract] {
+	if workspaceId == nil {
+		client := meta.(*clients.Client).ApiManagement.SubscriptionsClient
+		return workspaceScopedClient[apimanagement.SubscriptionCreateParameters, apimanagement.SubscriptionContract]{
+			CreateOrUpdate: func(ctx context.Context, name string, input apimanagement.SubscriptionCreateParameters) error {
+				_, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, name, input, utils.Bool(false), "", apimanagement.AppTypeDeveloperPortal)
+				return err
+			},
+			Get: func(ctx context.Context, name string) (apimanagement.SubscriptionContract, error) {
+				return client.Get(ctx, resourceGroup, serviceName, name)
+			},
+			Delete: func(ctx context.Context, name string) (autorest.Response, error) {
+				return client.Delete(ctx, resourceGroup, serviceName, name, "")
+			},
+		}
+	}
+
+	client := meta.(*clients.Client).ApiManagement.WorkspaceSubscriptionClient
+	return workspaceScopedClient[apimanagement.SubscriptionCreateParameters, apimanagement.SubscriptionContract]{
+		CreateOrUpdate: func(ctx context.Context, name string, input apimanagement.SubscriptionCreateParameters) error {
+			appType := workspacesubscription.AppTypeDeveloperPortal
+			_, err := client.CreateOrUpdate(ctx, apiManagementWorkspaceSubscriptionId(workspaceId, name), convertSubscriptionCreateParametersToWorkspace(input), workspacesubscription.CreateOrUpdateOptions{
+				AppType: &appType,
+				Notify:  utils.Bool(false),
+			})
+			return err
+		},
+		Get: func(ctx context.Context, name string) (apimanagement.SubscriptionContract, error) {
+			resp, err := client.Get(ctx, apiManagementWorkspaceSubscriptionId(workspaceId, name))
+			result := convertSubscriptionContractFromWorkspace(resp.Model)
+			result.Response = autorest.Response{Response: resp.HttpResponse}
+			return result, err
+		},
+		Delete: func(ctx context.Context, name string) (autorest.Response, error) {
+			resp, err := client.Delete(ctx, apiManagementWorkspaceSubscriptionId(workspaceId, name), workspacesubscription.DeleteOptions{
+				IfMatch: utils.String("*"),
+			})
+			return autorest.Response{Response: resp.HttpResponse}, err
+		},
+	}
+}
+
+// listApiManagementSubscriptionSecrets retrieves the Primary and Secondary Keys for the Subscription `id`, which is
+// within the Workspace `workspaceId` when set
+func listApiManagementSubscriptionSecrets(ctx context.Context, meta interface{}, id parse.SubscriptionId, workspaceId *parse.WorkspaceId) (*string, *string, error) {
+	if workspaceId == nil {
+		resp, err := meta.(*clients.Client).ApiManagement.SubscriptionsClient.ListSecrets(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.PrimaryKey, resp.SecondaryKey, nil
+	}
+
+	resp, err := meta.(*clients.Client).ApiManagement.WorkspaceSubscriptionClient.ListSecrets(ctx, apiManagementWorkspaceSubscriptionId(workspaceId, id.Name))
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.Model == nil {
+		return nil, nil, fmt.Errorf("model was nil")
+	}
+	return resp.Model.PrimaryKey, resp.Model.SecondaryKey, nil
+}
+
+func apiManagementWorkspaceSubscriptionId(workspaceId *parse.WorkspaceId, name string) workspacesubscription.WorkspaceSubscriptionId {
+	return workspacesubscription.NewWorkspaceSubscriptionID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.ServiceName, workspaceId.Name, name)
+}
+
+func convertSubscriptionCreateParametersToWorkspace(input apimanagement.SubscriptionCreateParameters) workspacesubscription.SubscriptionCreateParameters {
+	output := workspacesubscription.SubscriptionCreateParameters{}
+
+	if props := input.SubscriptionCreateParameterProperties; props != nil {
+		output.Properties = &workspacesubscription.SubscriptionCreateParameterProperties{
+			AllowTracing: props.AllowTracing,
+			DisplayName:  utils.NormalizeNilableString(props.DisplayName),
+			OwnerId:      props.OwnerID,
+			PrimaryKey:   props.PrimaryKey,
+			Scope:        utils.NormalizeNilableString(props.Scope),
+			SecondaryKey: props.SecondaryKey,
+		}
+		if props.State != "" {
+			state := workspacesubscription.SubscriptionState(props.State)
+			output.Properties.State = &state
+		}
+	}
+
+	return output
+}
+
+func convertSubscriptionContractFromWorkspace(input *workspacesubscription.SubscriptionContract) apimanagement.SubscriptionContract {
+	output := apimanagement.SubscriptionContract{}
+	if input == nil {
+		return output
+	}
+
+	output.ID = input.Id
+	output.Name = input.Name
+	output.Type = input.Type
+
+	if props := input.Properties; props != nil {
+		output.SubscriptionContractProperties = &apimanagement.SubscriptionContractProperties{
+			AllowTracing:     props.AllowTracing,
+			CreatedDate:      convertWorkspaceDateTime(props.CreatedDate),
+			DisplayName:      props.DisplayName,
+			EndDate:          convertWorkspaceDateTime(props.EndDate),
+			ExpirationDate:   convertWorkspaceDateTime(props.ExpirationDate),
+			NotificationDate: convertWorkspaceDateTime(props.NotificationDate),
+			OwnerID:          props.OwnerId,
+			PrimaryKey:       props.PrimaryKey,
+			Scope:            utils.String(props.Scope),
+			SecondaryKey:     props.SecondaryKey,
+			StartDate:        convertWorkspaceDateTime(props.StartDate),
+			State:            apimanagement.SubscriptionState(props.State),
+			StateComment:     props.StateComment,
+		}
+	}
+
+	return output
+}
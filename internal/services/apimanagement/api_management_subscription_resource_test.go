@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacesubscription"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -175,7 +176,33 @@ func TestAccApiManagementSubscription_allApis(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementSubscription_workspace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_subscription", "test")
+	r := ApiManagementSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.workspace(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("workspace_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (ApiManagementSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if workspaceSubscriptionId, err := parse.WorkspaceSubscriptionID(state.ID); err == nil {
+		id := workspacesubscription.NewWorkspaceSubscriptionID(workspaceSubscriptionId.SubscriptionId, workspaceSubscriptionId.ResourceGroup, workspaceSubscriptionId.ServiceName, workspaceSubscriptionId.WorkspaceName, workspaceSubscriptionId.SubscriptionName)
+		resp, err := clients.ApiManagement.WorkspaceSubscriptionClient.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %+v", id, err)
+		}
+
+		return utils.Bool(resp.Model != nil && resp.Model.Id != nil), nil
+	}
+
 	id, err := parse.SubscriptionID(state.ID)
 	if err != nil {
 		return nil, err
@@ -357,3 +384,28 @@ resource "azurerm_api_management_user" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
+
+func (ApiManagementSubscriptionResource) workspace(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_product" "test" {
+  product_id            = "test-product"
+  api_management_name   = azurerm_api_management.test.name
+  resource_group_name   = azurerm_resource_group.test.name
+  workspace_id          = azurerm_api_management_workspace.test.id
+  display_name          = "Test Product"
+  subscription_required = true
+  approval_required     = false
+  published             = true
+}
+
+resource "azurerm_api_management_subscription" "test" {
+  resource_group_name = azurerm_api_management.test.resource_group_name
+  api_management_name = azurerm_api_management.test.name
+  workspace_id        = azurerm_api_management_workspace.test.id
+  product_id          = azurerm_api_management_product.test.id
+  display_name        = "Butter Parser API Enterprise Edition"
+}
+`, ApiManagementWorkspaceResource{}.basic(data))
+}
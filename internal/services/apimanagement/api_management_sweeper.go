@@ -0,0 +1,64 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.UntypedServiceRegistrationWithSweepers = Registration{}
+
+// Sweepers returns the Sweepers for the leaked resources within this Service
+func (r Registration) Sweepers() []sdk.Sweeper {
+	return []sdk.Sweeper{
+		{
+			Name: "azurerm_api_management",
+			// API Management Services are soft-deleted when the Resource Group containing them is deleted
+			Dependencies: []string{"azurerm_resource_group"},
+			Func:         sweepSoftDeletedApiManagementServices,
+		},
+	}
+}
+
+// sweepSoftDeletedApiManagementServices purges the soft-deleted API Management Services created by the
+// Acceptance Tests within the specified Location, since the names of these can't be reused until purged
+func sweepSoftDeletedApiManagementServices(ctx context.Context, client *clients.Client, locationName string) error {
+	deletedServicesClient := client.ApiManagement.DeletedServicesClient
+
+	iterator, err := deletedServicesClient.ListBySubscriptionComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("listing soft-deleted API Management Services: %+v", err)
+	}
+
+	var errs *multierror.Error
+	for iterator.NotDone() {
+		service := iterator.Value()
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing soft-deleted API Management Services: %+v", err)
+		}
+
+		if service.Name == nil || !sdk.IsSweepable(*service.Name) {
+			continue
+		}
+		if service.Location == nil || location.Normalize(*service.Location) != locationName {
+			continue
+		}
+
+		log.Printf("[DEBUG] Purging soft-deleted API Management Service %q..", *service.Name)
+		future, err := deletedServicesClient.Purge(ctx, *service.Name, *service.Location)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("purging soft-deleted API Management Service %q: %+v", *service.Name, err))
+			continue
+		}
+		if err := future.WaitForCompletionRef(ctx, deletedServicesClient.Client); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("waiting for purge of soft-deleted API Management Service %q: %+v", *service.Name, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -2,7 +2,16 @@ package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2021-08-01/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-08-01/graphqlapiresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspaceapi"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacebackend"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacenamedvalue"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacepolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspaceproduct"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacesubscription"
 )
 
 type Client struct {
@@ -24,6 +33,8 @@ type Client struct {
 	GatewayClient                     *apimanagement.GatewayClient
 	GatewayCertificateAuthorityClient *apimanagement.GatewayCertificateAuthorityClient
 	GatewayApisClient                 *apimanagement.GatewayAPIClient
+	GlobalSchemaClient                *schema.SchemaClient
+	GraphQLApiResolverClient          *graphqlapiresolver.GraphQLApiResolverClient
 	GroupClient                       *apimanagement.GroupClient
 	GroupUsersClient                  *apimanagement.GroupUserClient
 	IdentityProviderClient            *apimanagement.IdentityProviderClient
@@ -44,6 +55,13 @@ type Client struct {
 	TagClient                         *apimanagement.TagClient
 	TenantAccessClient                *apimanagement.TenantAccessClient
 	UsersClient                       *apimanagement.UserClient
+	WorkspaceClient                   *workspace.WorkspaceClient
+	WorkspaceApiClient                *workspaceapi.WorkspaceApiClient
+	WorkspaceBackendClient            *workspacebackend.WorkspaceBackendClient
+	WorkspaceNamedValueClient         *workspacenamedvalue.WorkspaceNamedValueClient
+	WorkspacePolicyClient             *workspacepolicy.WorkspacePolicyClient
+	WorkspaceProductClient            *workspaceproduct.WorkspaceProductClient
+	WorkspaceSubscriptionClient       *workspacesubscription.WorkspaceSubscriptionClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -101,6 +119,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	gatewayApisClient := apimanagement.NewGatewayAPIClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&gatewayApisClient.Client, o.ResourceManagerAuthorizer)
 
+	globalSchemaClient := schema.NewSchemaClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&globalSchemaClient.Client, o.ResourceManagerAuthorizer)
+
+	graphQLApiResolverClient := graphqlapiresolver.NewGraphQLApiResolverClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&graphQLApiResolverClient.Client, o.ResourceManagerAuthorizer)
+
 	groupClient := apimanagement.NewGroupClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupClient.Client, o.ResourceManagerAuthorizer)
 
@@ -161,6 +185,27 @@ func NewClient(o *common.ClientOptions) *Client {
 	usersClient := apimanagement.NewUserClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&usersClient.Client, o.ResourceManagerAuthorizer)
 
+	workspaceClient := workspace.NewWorkspaceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceApiClient := workspaceapi.NewWorkspaceApiClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceApiClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceBackendClient := workspacebackend.NewWorkspaceBackendClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceBackendClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceNamedValueClient := workspacenamedvalue.NewWorkspaceNamedValueClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceNamedValueClient.Client, o.ResourceManagerAuthorizer)
+
+	workspacePolicyClient := workspacepolicy.NewWorkspacePolicyClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspacePolicyClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceProductClient := workspaceproduct.NewWorkspaceProductClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceProductClient.Client, o.ResourceManagerAuthorizer)
+
+	workspaceSubscriptionClient := workspacesubscription.NewWorkspaceSubscriptionClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workspaceSubscriptionClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		ApiClient:                         &apiClient,
 		ApiDiagnosticClient:               &apiDiagnosticClient,
@@ -180,6 +225,8 @@ func NewClient(o *common.ClientOptions) *Client {
 		GatewayClient:                     &gatewayClient,
 		GatewayCertificateAuthorityClient: &gatewayCertificateAuthorityClient,
 		GatewayApisClient:                 &gatewayApisClient,
+		GlobalSchemaClient:                &globalSchemaClient,
+		GraphQLApiResolverClient:          &graphQLApiResolverClient,
 		GroupClient:                       &groupClient,
 		GroupUsersClient:                  &groupUsersClient,
 		IdentityProviderClient:            &identityProviderClient,
@@ -200,5 +247,12 @@ func NewClient(o *common.ClientOptions) *Client {
 		TagClient:                         &tagClient,
 		TenantAccessClient:                &tenantAccessClient,
 		UsersClient:                       &usersClient,
+		WorkspaceClient:                   &workspaceClient,
+		WorkspaceApiClient:                &workspaceApiClient,
+		WorkspaceBackendClient:            &workspaceBackendClient,
+		WorkspaceNamedValueClient:         &workspaceNamedValueClient,
+		WorkspacePolicyClient:             &workspacePolicyClient,
+		WorkspaceProductClient:            &workspaceProductClient,
+		WorkspaceSubscriptionClient:       &workspaceSubscriptionClient,
 	}
 }
@@ -79,3 +79,23 @@ func ApiDiagnosticID(input string) (*ApiDiagnosticId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiDiagnosticIDFromNames builds an ApiDiagnosticId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{diagnosticName}", using the specified Subscription ID when this is omitted
+func ApiDiagnosticIDFromNames(subscriptionId, input string) (*ApiDiagnosticId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{diagnosticName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{diagnosticName}")
+		}
+	}
+
+	resourceId := NewApiDiagnosticID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
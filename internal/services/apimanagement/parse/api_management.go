@@ -67,3 +67,23 @@ func ApiManagementID(input string) (*ApiManagementId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiManagementIDFromNames builds an ApiManagementId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}", using the specified Subscription ID when this is omitted
+func ApiManagementIDFromNames(subscriptionId, input string) (*ApiManagementId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}")
+		}
+	}
+
+	resourceId := NewApiManagementID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
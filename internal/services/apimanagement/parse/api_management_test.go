@@ -110,3 +110,82 @@ func TestApiManagementID(t *testing.T) {
 		}
 	}
 }
+
+func TestApiManagementIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *ApiManagementId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resGroup1",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "12345678-1234-9876-4563-123456789012/resGroup1/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resGroup1/service1",
+			Expected: &ApiManagementId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ServiceName:    "service1",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "12345678-1234-9876-4563-123456789012/resGroup1/service1",
+			Expected: &ApiManagementId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ServiceName:    "service1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ApiManagementIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.ServiceName != v.Expected.ServiceName {
+			t.Fatalf("Expected %q but got %q for ServiceName", v.Expected.ServiceName, actual.ServiceName)
+		}
+	}
+}
@@ -79,3 +79,23 @@ func ApiOperationID(input string) (*ApiOperationId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiOperationIDFromNames builds an ApiOperationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{operationName}", using the specified Subscription ID when this is omitted
+func ApiOperationIDFromNames(subscriptionId, input string) (*ApiOperationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{operationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{operationName}")
+		}
+	}
+
+	resourceId := NewApiOperationID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -142,3 +142,92 @@ func TestApiOperationID(t *testing.T) {
 		}
 	}
 }
+
+func TestApiOperationIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *ApiOperationId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resGroup1/service1/api1",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "12345678-1234-9876-4563-123456789012/resGroup1/service1/api1/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resGroup1/service1/api1/operation1",
+			Expected: &ApiOperationId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ServiceName:    "service1",
+				ApiName:        "api1",
+				OperationName:  "operation1",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "12345678-1234-9876-4563-123456789012/resGroup1/service1/api1/operation1",
+			Expected: &ApiOperationId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ServiceName:    "service1",
+				ApiName:        "api1",
+				OperationName:  "operation1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ApiOperationIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.ServiceName != v.Expected.ServiceName {
+			t.Fatalf("Expected %q but got %q for ServiceName", v.Expected.ServiceName, actual.ServiceName)
+		}
+		if actual.ApiName != v.Expected.ApiName {
+			t.Fatalf("Expected %q but got %q for ApiName", v.Expected.ApiName, actual.ApiName)
+		}
+		if actual.OperationName != v.Expected.OperationName {
+			t.Fatalf("Expected %q but got %q for OperationName", v.Expected.OperationName, actual.OperationName)
+		}
+	}
+}
@@ -79,3 +79,23 @@ func ApiPolicyID(input string) (*ApiPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiPolicyIDFromNames builds an ApiPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{policyName}", using the specified Subscription ID when this is omitted
+func ApiPolicyIDFromNames(subscriptionId, input string) (*ApiPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{policyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{policyName}")
+		}
+	}
+
+	resourceId := NewApiPolicyID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func ApiReleaseID(input string) (*ApiReleaseId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiReleaseIDFromNames builds an ApiReleaseId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{releaseName}", using the specified Subscription ID when this is omitted
+func ApiReleaseIDFromNames(subscriptionId, input string) (*ApiReleaseId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{releaseName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{releaseName}")
+		}
+	}
+
+	resourceId := NewApiReleaseID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
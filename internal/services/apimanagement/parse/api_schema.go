@@ -79,3 +79,23 @@ func ApiSchemaID(input string) (*ApiSchemaId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiSchemaIDFromNames builds an ApiSchemaId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{schemaName}", using the specified Subscription ID when this is omitted
+func ApiSchemaIDFromNames(subscriptionId, input string) (*ApiSchemaId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{schemaName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{schemaName}")
+		}
+	}
+
+	resourceId := NewApiSchemaID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
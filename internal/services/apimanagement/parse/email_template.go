@@ -73,3 +73,23 @@ func EmailTemplateID(input string) (*EmailTemplateId, error) {
 
 	return &resourceId, nil
 }
+
+// EmailTemplateIDFromNames builds an EmailTemplateId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{templateName}", using the specified Subscription ID when this is omitted
+func EmailTemplateIDFromNames(subscriptionId, input string) (*EmailTemplateId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{templateName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{templateName}")
+		}
+	}
+
+	resourceId := NewEmailTemplateID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func GatewayApiID(input string) (*GatewayApiId, error) {
 
 	return &resourceId, nil
 }
+
+// GatewayApiIDFromNames builds an GatewayApiId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{gatewayName}/{apiName}", using the specified Subscription ID when this is omitted
+func GatewayApiIDFromNames(subscriptionId, input string) (*GatewayApiId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{gatewayName}/{apiName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{gatewayName}/{apiName}")
+		}
+	}
+
+	resourceId := NewGatewayApiID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
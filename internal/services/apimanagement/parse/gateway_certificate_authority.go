@@ -79,3 +79,23 @@ func GatewayCertificateAuthorityID(input string) (*GatewayCertificateAuthorityId
 
 	return &resourceId, nil
 }
+
+// GatewayCertificateAuthorityIDFromNames builds an GatewayCertificateAuthorityId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{gatewayName}/{certificateAuthorityName}", using the specified Subscription ID when this is omitted
+func GatewayCertificateAuthorityIDFromNames(subscriptionId, input string) (*GatewayCertificateAuthorityId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{gatewayName}/{certificateAuthorityName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{gatewayName}/{certificateAuthorityName}")
+		}
+	}
+
+	resourceId := NewGatewayCertificateAuthorityID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func GroupUserID(input string) (*GroupUserId, error) {
 
 	return &resourceId, nil
 }
+
+// GroupUserIDFromNames builds an GroupUserId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{groupName}/{userName}", using the specified Subscription ID when this is omitted
+func GroupUserIDFromNames(subscriptionId, input string) (*GroupUserId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{groupName}/{userName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{groupName}/{userName}")
+		}
+	}
+
+	resourceId := NewGroupUserID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func LoggerID(input string) (*LoggerId, error) {
 
 	return &resourceId, nil
 }
+
+// LoggerIDFromNames builds an LoggerId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{name}", using the specified Subscription ID when this is omitted
+func LoggerIDFromNames(subscriptionId, input string) (*LoggerId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{name}")
+		}
+	}
+
+	resourceId := NewLoggerID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func NotificationRecipientEmailID(input string) (*NotificationRecipientEmailId,
 
 	return &resourceId, nil
 }
+
+// NotificationRecipientEmailIDFromNames builds an NotificationRecipientEmailId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{notificationName}/{recipientEmailName}", using the specified Subscription ID when this is omitted
+func NotificationRecipientEmailIDFromNames(subscriptionId, input string) (*NotificationRecipientEmailId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{notificationName}/{recipientEmailName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{notificationName}/{recipientEmailName}")
+		}
+	}
+
+	resourceId := NewNotificationRecipientEmailID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func NotificationRecipientUserID(input string) (*NotificationRecipientUserId, er
 
 	return &resourceId, nil
 }
+
+// NotificationRecipientUserIDFromNames builds an NotificationRecipientUserId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{notificationName}/{recipientUserName}", using the specified Subscription ID when this is omitted
+func NotificationRecipientUserIDFromNames(subscriptionId, input string) (*NotificationRecipientUserId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{notificationName}/{recipientUserName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{notificationName}/{recipientUserName}")
+		}
+	}
+
+	resourceId := NewNotificationRecipientUserID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
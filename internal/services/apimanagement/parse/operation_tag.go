@@ -85,3 +85,23 @@ func OperationTagID(input string) (*OperationTagId, error) {
 
 	return &resourceId, nil
 }
+
+// OperationTagIDFromNames builds an OperationTagId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{operationName}/{tagName}", using the specified Subscription ID when this is omitted
+func OperationTagIDFromNames(subscriptionId, input string) (*OperationTagId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 5 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 6 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{operationName}/{tagName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{apiName}/{operationName}/{tagName}")
+		}
+	}
+
+	resourceId := NewOperationTagID(names[0], names[1], names[2], names[3], names[4], names[5])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func ProductApiID(input string) (*ProductApiId, error) {
 
 	return &resourceId, nil
 }
+
+// ProductApiIDFromNames builds an ProductApiId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{productName}/{apiName}", using the specified Subscription ID when this is omitted
+func ProductApiIDFromNames(subscriptionId, input string) (*ProductApiId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{productName}/{apiName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{productName}/{apiName}")
+		}
+	}
+
+	resourceId := NewProductApiID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
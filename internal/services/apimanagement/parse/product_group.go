@@ -79,3 +79,23 @@ func ProductGroupID(input string) (*ProductGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// ProductGroupIDFromNames builds an ProductGroupId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{productName}/{groupName}", using the specified Subscription ID when this is omitted
+func ProductGroupIDFromNames(subscriptionId, input string) (*ProductGroupId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{productName}/{groupName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{productName}/{groupName}")
+		}
+	}
+
+	resourceId := NewProductGroupID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
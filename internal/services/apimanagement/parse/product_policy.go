@@ -79,3 +79,23 @@ func ProductPolicyID(input string) (*ProductPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// ProductPolicyIDFromNames builds an ProductPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{productName}/{policyName}", using the specified Subscription ID when this is omitted
+func ProductPolicyIDFromNames(subscriptionId, input string) (*ProductPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{productName}/{policyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{productName}/{policyName}")
+		}
+	}
+
+	resourceId := NewProductPolicyID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
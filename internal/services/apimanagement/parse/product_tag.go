@@ -79,3 +79,23 @@ func ProductTagID(input string) (*ProductTagId, error) {
 
 	return &resourceId, nil
 }
+
+// ProductTagIDFromNames builds an ProductTagId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{productName}/{tagName}", using the specified Subscription ID when this is omitted
+func ProductTagIDFromNames(subscriptionId, input string) (*ProductTagId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{productName}/{tagName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{productName}/{tagName}")
+		}
+	}
+
+	resourceId := NewProductTagID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
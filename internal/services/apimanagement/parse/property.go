@@ -73,3 +73,23 @@ func PropertyID(input string) (*PropertyId, error) {
 
 	return &resourceId, nil
 }
+
+// PropertyIDFromNames builds an PropertyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{namedValueName}", using the specified Subscription ID when this is omitted
+func PropertyIDFromNames(subscriptionId, input string) (*PropertyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{namedValueName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{namedValueName}")
+		}
+	}
+
+	resourceId := NewPropertyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
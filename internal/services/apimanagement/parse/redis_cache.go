@@ -73,3 +73,23 @@ func RedisCacheID(input string) (*RedisCacheId, error) {
 
 	return &resourceId, nil
 }
+
+// RedisCacheIDFromNames builds an RedisCacheId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{cacheName}", using the specified Subscription ID when this is omitted
+func RedisCacheIDFromNames(subscriptionId, input string) (*RedisCacheId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{cacheName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{cacheName}")
+		}
+	}
+
+	resourceId := NewRedisCacheID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
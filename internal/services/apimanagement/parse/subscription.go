@@ -73,3 +73,23 @@ func SubscriptionID(input string) (*SubscriptionId, error) {
 
 	return &resourceId, nil
 }
+
+// SubscriptionIDFromNames builds an SubscriptionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{name}", using the specified Subscription ID when this is omitted
+func SubscriptionIDFromNames(subscriptionId, input string) (*SubscriptionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{name}")
+		}
+	}
+
+	resourceId := NewSubscriptionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -0,0 +1,101 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WorkspaceApiId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	WorkspaceName  string
+	ApiName        string
+}
+
+func NewWorkspaceApiID(subscriptionId, resourceGroup, serviceName, workspaceName, apiName string) WorkspaceApiId {
+	return WorkspaceApiId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		WorkspaceName:  workspaceName,
+		ApiName:        apiName,
+	}
+}
+
+func (id WorkspaceApiId) String() string {
+	segments := []string{
+		fmt.Sprintf("Api Name %q", id.ApiName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Service Name %q", id.ServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Workspace Api", segmentsStr)
+}
+
+func (id WorkspaceApiId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/workspaces/%s/apis/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.WorkspaceName, id.ApiName)
+}
+
+// WorkspaceApiID parses a WorkspaceApi ID into an WorkspaceApiId struct
+func WorkspaceApiID(input string) (*WorkspaceApiId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := WorkspaceApiId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// WorkspaceApiIDFromNames builds an WorkspaceApiId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{apiName}", using the specified Subscription ID when this is omitted
+func WorkspaceApiIDFromNames(subscriptionId, input string) (*WorkspaceApiId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{apiName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{apiName}")
+		}
+	}
+
+	resourceId := NewWorkspaceApiID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -0,0 +1,101 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WorkspaceBackendId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	WorkspaceName  string
+	BackendName    string
+}
+
+func NewWorkspaceBackendID(subscriptionId, resourceGroup, serviceName, workspaceName, backendName string) WorkspaceBackendId {
+	return WorkspaceBackendId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		WorkspaceName:  workspaceName,
+		BackendName:    backendName,
+	}
+}
+
+func (id WorkspaceBackendId) String() string {
+	segments := []string{
+		fmt.Sprintf("Backend Name %q", id.BackendName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Service Name %q", id.ServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Workspace Backend", segmentsStr)
+}
+
+func (id WorkspaceBackendId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/workspaces/%s/backends/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.WorkspaceName, id.BackendName)
+}
+
+// WorkspaceBackendID parses a WorkspaceBackend ID into an WorkspaceBackendId struct
+func WorkspaceBackendID(input string) (*WorkspaceBackendId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := WorkspaceBackendId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.BackendName, err = id.PopSegment("backends"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// WorkspaceBackendIDFromNames builds an WorkspaceBackendId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{backendName}", using the specified Subscription ID when this is omitted
+func WorkspaceBackendIDFromNames(subscriptionId, input string) (*WorkspaceBackendId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{backendName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{backendName}")
+		}
+	}
+
+	resourceId := NewWorkspaceBackendID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
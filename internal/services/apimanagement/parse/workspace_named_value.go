@@ -0,0 +1,101 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WorkspaceNamedValueId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	WorkspaceName  string
+	NamedValueName string
+}
+
+func NewWorkspaceNamedValueID(subscriptionId, resourceGroup, serviceName, workspaceName, namedValueName string) WorkspaceNamedValueId {
+	return WorkspaceNamedValueId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		WorkspaceName:  workspaceName,
+		NamedValueName: namedValueName,
+	}
+}
+
+func (id WorkspaceNamedValueId) String() string {
+	segments := []string{
+		fmt.Sprintf("Named Value Name %q", id.NamedValueName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Service Name %q", id.ServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Workspace Named Value", segmentsStr)
+}
+
+func (id WorkspaceNamedValueId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/workspaces/%s/namedValues/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.WorkspaceName, id.NamedValueName)
+}
+
+// WorkspaceNamedValueID parses a WorkspaceNamedValue ID into an WorkspaceNamedValueId struct
+func WorkspaceNamedValueID(input string) (*WorkspaceNamedValueId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := WorkspaceNamedValueId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.NamedValueName, err = id.PopSegment("namedValues"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// WorkspaceNamedValueIDFromNames builds an WorkspaceNamedValueId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{namedValueName}", using the specified Subscription ID when this is omitted
+func WorkspaceNamedValueIDFromNames(subscriptionId, input string) (*WorkspaceNamedValueId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{namedValueName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{namedValueName}")
+		}
+	}
+
+	resourceId := NewWorkspaceNamedValueID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -0,0 +1,101 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WorkspacePolicyId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	WorkspaceName  string
+	PolicyName     string
+}
+
+func NewWorkspacePolicyID(subscriptionId, resourceGroup, serviceName, workspaceName, policyName string) WorkspacePolicyId {
+	return WorkspacePolicyId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		WorkspaceName:  workspaceName,
+		PolicyName:     policyName,
+	}
+}
+
+func (id WorkspacePolicyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Policy Name %q", id.PolicyName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Service Name %q", id.ServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Workspace Policy", segmentsStr)
+}
+
+func (id WorkspacePolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/workspaces/%s/policies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.WorkspaceName, id.PolicyName)
+}
+
+// WorkspacePolicyID parses a WorkspacePolicy ID into an WorkspacePolicyId struct
+func WorkspacePolicyID(input string) (*WorkspacePolicyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := WorkspacePolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.PolicyName, err = id.PopSegment("policies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// WorkspacePolicyIDFromNames builds an WorkspacePolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{policyName}", using the specified Subscription ID when this is omitted
+func WorkspacePolicyIDFromNames(subscriptionId, input string) (*WorkspacePolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{policyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{policyName}")
+		}
+	}
+
+	resourceId := NewWorkspacePolicyID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
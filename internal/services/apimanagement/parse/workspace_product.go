@@ -0,0 +1,101 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WorkspaceProductId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	WorkspaceName  string
+	ProductName    string
+}
+
+func NewWorkspaceProductID(subscriptionId, resourceGroup, serviceName, workspaceName, productName string) WorkspaceProductId {
+	return WorkspaceProductId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		WorkspaceName:  workspaceName,
+		ProductName:    productName,
+	}
+}
+
+func (id WorkspaceProductId) String() string {
+	segments := []string{
+		fmt.Sprintf("Product Name %q", id.ProductName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Service Name %q", id.ServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Workspace Product", segmentsStr)
+}
+
+func (id WorkspaceProductId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/workspaces/%s/products/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.WorkspaceName, id.ProductName)
+}
+
+// WorkspaceProductID parses a WorkspaceProduct ID into an WorkspaceProductId struct
+func WorkspaceProductID(input string) (*WorkspaceProductId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := WorkspaceProductId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.ProductName, err = id.PopSegment("products"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// WorkspaceProductIDFromNames builds an WorkspaceProductId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{productName}", using the specified Subscription ID when this is omitted
+func WorkspaceProductIDFromNames(subscriptionId, input string) (*WorkspaceProductId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{productName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{productName}")
+		}
+	}
+
+	resourceId := NewWorkspaceProductID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -0,0 +1,101 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WorkspaceSubscriptionId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	ServiceName      string
+	WorkspaceName    string
+	SubscriptionName string
+}
+
+func NewWorkspaceSubscriptionID(subscriptionId, resourceGroup, serviceName, workspaceName, subscriptionName string) WorkspaceSubscriptionId {
+	return WorkspaceSubscriptionId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		ServiceName:      serviceName,
+		WorkspaceName:    workspaceName,
+		SubscriptionName: subscriptionName,
+	}
+}
+
+func (id WorkspaceSubscriptionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Subscription Name %q", id.SubscriptionName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Service Name %q", id.ServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Workspace Subscription", segmentsStr)
+}
+
+func (id WorkspaceSubscriptionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/workspaces/%s/subscriptions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.WorkspaceName, id.SubscriptionName)
+}
+
+// WorkspaceSubscriptionID parses a WorkspaceSubscription ID into an WorkspaceSubscriptionId struct
+func WorkspaceSubscriptionID(input string) (*WorkspaceSubscriptionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := WorkspaceSubscriptionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.SubscriptionName, err = id.PopSegment("subscriptions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// WorkspaceSubscriptionIDFromNames builds an WorkspaceSubscriptionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{subscriptionName}", using the specified Subscription ID when this is omitted
+func WorkspaceSubscriptionIDFromNames(subscriptionId, input string) (*WorkspaceSubscriptionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{subscriptionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceName}/{workspaceName}/{subscriptionName}")
+		}
+	}
+
+	resourceId := NewWorkspaceSubscriptionID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -91,7 +91,10 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ApiManagementGlobalSchemaResource{},
+		ApiManagementGraphQLApiResolverResource{},
 		ApiManagementNotificationRecipientEmailResource{},
 		ApiManagementNotificationRecipientUserResource{},
+		ApiManagementWorkspaceResource{},
 	}
 }
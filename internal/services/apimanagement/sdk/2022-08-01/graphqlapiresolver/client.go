@@ -0,0 +1,15 @@
+package graphqlapiresolver
+
+import "github.com/Azure/go-autorest/autorest"
+
+type GraphQLApiResolverClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGraphQLApiResolverClientWithBaseURI(endpoint string) GraphQLApiResolverClient {
+	return GraphQLApiResolverClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,137 @@
+package graphqlapiresolver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ApiId{}
+
+// ApiId is a struct representing the Resource ID for an Api
+type ApiId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ServiceName       string
+	ApiId             string
+}
+
+// NewApiID returns a new ApiId struct
+func NewApiID(subscriptionId string, resourceGroupName string, serviceName string, apiId string) ApiId {
+	return ApiId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		ServiceName:       serviceName,
+		ApiId:             apiId,
+	}
+}
+
+// ParseApiID parses 'input' into an ApiId
+func ParseApiID(input string) (*ApiId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ApiId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ApiId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServiceName, ok = parsed.Parsed["serviceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serviceName' was not found in the resource id %q", input)
+	}
+
+	if id.ApiId, ok = parsed.Parsed["apiId"]; !ok {
+		return nil, fmt.Errorf("the segment 'apiId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseApiIDInsensitively parses 'input' case-insensitively into an ApiId
+// note: this method should only be used for API response data and not user input
+func ParseApiIDInsensitively(input string) (*ApiId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ApiId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ApiId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServiceName, ok = parsed.Parsed["serviceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serviceName' was not found in the resource id %q", input)
+	}
+
+	if id.ApiId, ok = parsed.Parsed["apiId"]; !ok {
+		return nil, fmt.Errorf("the segment 'apiId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateApiID checks that 'input' can be parsed as an Api ID
+func ValidateApiID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseApiID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Api ID
+func (id ApiId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Api ID
+func (id ApiId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "serviceValue"),
+		resourceids.StaticSegment("staticApis", "apis", "apis"),
+		resourceids.UserSpecifiedSegment("apiId", "apiIdValue"),
+	}
+}
+
+// String returns a human-readable description of this Api ID
+func (id ApiId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Service Name: %q", id.ServiceName),
+		fmt.Sprintf("Api Id: %q", id.ApiId),
+	}
+	return fmt.Sprintf("Api (%s)", strings.Join(components, "\n"))
+}
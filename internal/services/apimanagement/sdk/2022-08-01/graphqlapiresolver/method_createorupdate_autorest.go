@@ -0,0 +1,84 @@
+package graphqlapiresolver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *ResolverContract
+}
+
+type CreateOrUpdateOptions struct {
+	IfMatch *string
+}
+
+func DefaultCreateOrUpdateOptions() CreateOrUpdateOptions {
+	return CreateOrUpdateOptions{}
+}
+
+func (o CreateOrUpdateOptions) toHeaders() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.IfMatch != nil {
+		out["If-Match"] = *o.IfMatch
+	}
+
+	return out
+}
+
+// CreateOrUpdate ...
+func (c GraphQLApiResolverClient) CreateOrUpdate(ctx context.Context, id ResolverId, input ResolverContract, options CreateOrUpdateOptions) (result CreateOrUpdateResponse, err error) {
+	req, err := c.preparerForCreateOrUpdate(ctx, id, input, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "CreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCreateOrUpdate prepares the CreateOrUpdate request.
+func (c GraphQLApiResolverClient) preparerForCreateOrUpdate(ctx context.Context, id ResolverId, input ResolverContract, options CreateOrUpdateOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithHeaders(options.toHeaders()),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCreateOrUpdate handles the response to the CreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c GraphQLApiResolverClient) responderForCreateOrUpdate(resp *http.Response) (result CreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
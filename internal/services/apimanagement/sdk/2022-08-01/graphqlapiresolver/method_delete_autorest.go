@@ -0,0 +1,81 @@
+package graphqlapiresolver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+type DeleteOptions struct {
+	IfMatch *string
+}
+
+func DefaultDeleteOptions() DeleteOptions {
+	return DeleteOptions{}
+}
+
+func (o DeleteOptions) toHeaders() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.IfMatch != nil {
+		out["If-Match"] = *o.IfMatch
+	}
+
+	return out
+}
+
+// Delete ...
+func (c GraphQLApiResolverClient) Delete(ctx context.Context, id ResolverId, options DeleteOptions) (result DeleteResponse, err error) {
+	req, err := c.preparerForDelete(ctx, id, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "Delete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "Delete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDelete prepares the Delete request.
+func (c GraphQLApiResolverClient) preparerForDelete(ctx context.Context, id ResolverId, options DeleteOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithHeaders(options.toHeaders()),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDelete handles the response to the Delete request. The method always
+// closes the http.Response Body.
+func (c GraphQLApiResolverClient) responderForDelete(resp *http.Response) (result DeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,183 @@
+package graphqlapiresolver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListByApiResponse struct {
+	HttpResponse *http.Response
+	Model        *[]ResolverContract
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (ListByApiResponse, error)
+}
+
+type ListByApiCompleteResult struct {
+	Items []ResolverContract
+}
+
+func (r ListByApiResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r ListByApiResponse) LoadMore(ctx context.Context) (resp ListByApiResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+// ListByApi ...
+func (c GraphQLApiResolverClient) ListByApi(ctx context.Context, id ApiId) (resp ListByApiResponse, err error) {
+	req, err := c.preparerForListByApi(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "ListByApi", nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "ListByApi", resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderForListByApi(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "ListByApi", resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// ListByApiComplete retrieves all of the results into a single object
+func (c GraphQLApiResolverClient) ListByApiComplete(ctx context.Context, id ApiId) (ListByApiCompleteResult, error) {
+	return c.ListByApiCompleteMatchingPredicate(ctx, id, ResolverContractPredicate{})
+}
+
+// ListByApiCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c GraphQLApiResolverClient) ListByApiCompleteMatchingPredicate(ctx context.Context, id ApiId, predicate ResolverContractPredicate) (resp ListByApiCompleteResult, err error) {
+	items := make([]ResolverContract, 0)
+
+	page, err := c.ListByApi(ctx, id)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := ListByApiCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+// preparerForListByApi prepares the ListByApi request.
+func (c GraphQLApiResolverClient) preparerForListByApi(ctx context.Context, id ApiId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/resolvers", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForListByApiWithNextLink prepares the ListByApi request with the given nextLink token.
+func (c GraphQLApiResolverClient) preparerForListByApiWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %q: %+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListByApi handles the response to the ListByApi request. The method always
+// closes the http.Response Body.
+func (c GraphQLApiResolverClient) responderForListByApi(resp *http.Response) (result ListByApiResponse, err error) {
+	type page struct {
+		Values   []ResolverContract `json:"value"`
+		NextLink *string            `json:"nextLink"`
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result ListByApiResponse, err error) {
+			req, err := c.preparerForListByApiWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "ListByApi", nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "ListByApi", result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderForListByApi(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "ListByApi", result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}
+	return
+}
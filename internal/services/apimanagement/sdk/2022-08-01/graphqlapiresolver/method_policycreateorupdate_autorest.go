@@ -0,0 +1,85 @@
+package graphqlapiresolver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type PolicyCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *PolicyContract
+}
+
+type PolicyCreateOrUpdateOptions struct {
+	IfMatch *string
+}
+
+func DefaultPolicyCreateOrUpdateOptions() PolicyCreateOrUpdateOptions {
+	return PolicyCreateOrUpdateOptions{}
+}
+
+func (o PolicyCreateOrUpdateOptions) toHeaders() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.IfMatch != nil {
+		out["If-Match"] = *o.IfMatch
+	}
+
+	return out
+}
+
+// PolicyCreateOrUpdate ...
+func (c GraphQLApiResolverClient) PolicyCreateOrUpdate(ctx context.Context, id ResolverId, input PolicyContract, options PolicyCreateOrUpdateOptions) (result PolicyCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForPolicyCreateOrUpdate(ctx, id, input, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "PolicyCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "PolicyCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForPolicyCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "PolicyCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForPolicyCreateOrUpdate prepares the PolicyCreateOrUpdate request.
+func (c GraphQLApiResolverClient) preparerForPolicyCreateOrUpdate(ctx context.Context, id ResolverId, input PolicyContract, options PolicyCreateOrUpdateOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithHeaders(options.toHeaders()),
+		autorest.WithPath(fmt.Sprintf("%s/policies/policy", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForPolicyCreateOrUpdate handles the response to the PolicyCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c GraphQLApiResolverClient) responderForPolicyCreateOrUpdate(resp *http.Response) (result PolicyCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
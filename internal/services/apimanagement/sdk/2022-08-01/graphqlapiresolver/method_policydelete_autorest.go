@@ -0,0 +1,82 @@
+package graphqlapiresolver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type PolicyDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+type PolicyDeleteOptions struct {
+	IfMatch *string
+}
+
+func DefaultPolicyDeleteOptions() PolicyDeleteOptions {
+	return PolicyDeleteOptions{}
+}
+
+func (o PolicyDeleteOptions) toHeaders() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.IfMatch != nil {
+		out["If-Match"] = *o.IfMatch
+	}
+
+	return out
+}
+
+// PolicyDelete ...
+func (c GraphQLApiResolverClient) PolicyDelete(ctx context.Context, id ResolverId, options PolicyDeleteOptions) (result PolicyDeleteResponse, err error) {
+	req, err := c.preparerForPolicyDelete(ctx, id, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "PolicyDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "PolicyDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForPolicyDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "PolicyDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForPolicyDelete prepares the PolicyDelete request.
+func (c GraphQLApiResolverClient) preparerForPolicyDelete(ctx context.Context, id ResolverId, options PolicyDeleteOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithHeaders(options.toHeaders()),
+		autorest.WithPath(fmt.Sprintf("%s/policies/policy", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForPolicyDelete handles the response to the PolicyDelete request. The method always
+// closes the http.Response Body.
+func (c GraphQLApiResolverClient) responderForPolicyDelete(resp *http.Response) (result PolicyDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,65 @@
+package graphqlapiresolver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type PolicyGetResponse struct {
+	HttpResponse *http.Response
+	Model        *PolicyContract
+}
+
+// PolicyGet ...
+func (c GraphQLApiResolverClient) PolicyGet(ctx context.Context, id ResolverId) (result PolicyGetResponse, err error) {
+	req, err := c.preparerForPolicyGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "PolicyGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "PolicyGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForPolicyGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "graphqlapiresolver.GraphQLApiResolverClient", "PolicyGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForPolicyGet prepares the PolicyGet request.
+func (c GraphQLApiResolverClient) preparerForPolicyGet(ctx context.Context, id ResolverId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/policies/policy", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForPolicyGet handles the response to the PolicyGet request. The method always
+// closes the http.Response Body.
+func (c GraphQLApiResolverClient) responderForPolicyGet(resp *http.Response) (result PolicyGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,8 @@
+package graphqlapiresolver
+
+type PolicyContract struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *PolicyContractProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package graphqlapiresolver
+
+type PolicyContractProperties struct {
+	Format *PolicyContentFormat `json:"format,omitempty"`
+	Value  string               `json:"value"`
+}
@@ -0,0 +1,8 @@
+package graphqlapiresolver
+
+type ResolverContract struct {
+	Id         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties *ResolverEntityBaseContract `json:"properties,omitempty"`
+	Type       *string                     `json:"type,omitempty"`
+}
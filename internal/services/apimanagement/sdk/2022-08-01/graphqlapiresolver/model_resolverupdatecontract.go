@@ -0,0 +1,5 @@
+package graphqlapiresolver
+
+type ResolverUpdateContract struct {
+	Properties *ResolverUpdateContractProperties `json:"properties,omitempty"`
+}
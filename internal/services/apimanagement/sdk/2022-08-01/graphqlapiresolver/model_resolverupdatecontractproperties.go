@@ -0,0 +1,7 @@
+package graphqlapiresolver
+
+type ResolverUpdateContractProperties struct {
+	Description *string `json:"description,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	Path        *string `json:"path,omitempty"`
+}
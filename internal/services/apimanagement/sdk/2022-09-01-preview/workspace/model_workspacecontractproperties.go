@@ -0,0 +1,6 @@
+package workspace
+
+type WorkspaceContractProperties struct {
+	Description *string `json:"description,omitempty"`
+	DisplayName string  `json:"displayName"`
+}
@@ -0,0 +1,9 @@
+package workspace
+
+import "fmt"
+
+const defaultApiVersion = "2022-09-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/workspace/%s", defaultApiVersion)
+}
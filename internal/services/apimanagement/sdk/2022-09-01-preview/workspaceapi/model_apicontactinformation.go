@@ -0,0 +1,7 @@
+package workspaceapi
+
+type ApiContactInformation struct {
+	Email *string `json:"email,omitempty"`
+	Name  *string `json:"name,omitempty"`
+	Url   *string `json:"url,omitempty"`
+}
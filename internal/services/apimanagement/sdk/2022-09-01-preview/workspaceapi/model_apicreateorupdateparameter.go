@@ -0,0 +1,5 @@
+package workspaceapi
+
+type ApiCreateOrUpdateParameter struct {
+	Properties *ApiCreateOrUpdateProperties `json:"properties,omitempty"`
+}
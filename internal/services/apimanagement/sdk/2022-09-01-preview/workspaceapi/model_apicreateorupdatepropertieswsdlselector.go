@@ -0,0 +1,6 @@
+package workspaceapi
+
+type ApiCreateOrUpdatePropertiesWsdlSelector struct {
+	WsdlEndpointName *string `json:"wsdlEndpointName,omitempty"`
+	WsdlServiceName  *string `json:"wsdlServiceName,omitempty"`
+}
@@ -0,0 +1,6 @@
+package workspaceapi
+
+type ApiLicenseInformation struct {
+	Name *string `json:"name,omitempty"`
+	Url  *string `json:"url,omitempty"`
+}
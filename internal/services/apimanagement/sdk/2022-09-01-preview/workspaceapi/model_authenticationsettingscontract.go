@@ -0,0 +1,8 @@
+package workspaceapi
+
+type AuthenticationSettingsContract struct {
+	OAuth2                       *OAuth2AuthenticationSettingsContract   `json:"oAuth2,omitempty"`
+	OAuth2AuthenticationSettings *[]OAuth2AuthenticationSettingsContract `json:"oAuth2AuthenticationSettings,omitempty"`
+	Openid                       *OpenIdAuthenticationSettingsContract   `json:"openid,omitempty"`
+	OpenidAuthenticationSettings *[]OpenIdAuthenticationSettingsContract `json:"openidAuthenticationSettings,omitempty"`
+}
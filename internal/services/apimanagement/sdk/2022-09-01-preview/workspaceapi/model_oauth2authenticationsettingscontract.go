@@ -0,0 +1,6 @@
+package workspaceapi
+
+type OAuth2AuthenticationSettingsContract struct {
+	AuthorizationServerId *string `json:"authorizationServerId,omitempty"`
+	Scope                 *string `json:"scope,omitempty"`
+}
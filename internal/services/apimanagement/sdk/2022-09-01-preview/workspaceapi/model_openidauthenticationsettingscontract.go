@@ -0,0 +1,6 @@
+package workspaceapi
+
+type OpenIdAuthenticationSettingsContract struct {
+	BearerTokenSendingMethods *[]BearerTokenSendingMethods `json:"bearerTokenSendingMethods,omitempty"`
+	OpenidProviderId          *string                      `json:"openidProviderId,omitempty"`
+}
@@ -0,0 +1,6 @@
+package workspaceapi
+
+type SubscriptionKeyParameterNamesContract struct {
+	Header *string `json:"header,omitempty"`
+	Query  *string `json:"query,omitempty"`
+}
@@ -0,0 +1,15 @@
+package workspacebackend
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WorkspaceBackendClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspaceBackendClientWithBaseURI(endpoint string) WorkspaceBackendClient {
+	return WorkspaceBackendClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,6 @@
+package workspacebackend
+
+type BackendAuthorizationHeaderCredentials struct {
+	Parameter string `json:"parameter"`
+	Scheme    string `json:"scheme"`
+}
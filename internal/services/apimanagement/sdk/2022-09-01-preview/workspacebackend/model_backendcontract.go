@@ -0,0 +1,8 @@
+package workspacebackend
+
+type BackendContract struct {
+	Id         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *BackendContractProperties `json:"properties,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package workspacebackend
+
+type BackendCredentialsContract struct {
+	Authorization  *BackendAuthorizationHeaderCredentials `json:"authorization,omitempty"`
+	Certificate    *[]string                              `json:"certificate,omitempty"`
+	CertificateIds *[]string                              `json:"certificateIds,omitempty"`
+	Header         *map[string][]string                   `json:"header,omitempty"`
+	Query          *map[string][]string                   `json:"query,omitempty"`
+}
@@ -0,0 +1,5 @@
+package workspacebackend
+
+type BackendProperties struct {
+	ServiceFabricCluster *BackendServiceFabricClusterProperties `json:"serviceFabricCluster,omitempty"`
+}
@@ -0,0 +1,7 @@
+package workspacebackend
+
+type BackendProxyContract struct {
+	Password *string `json:"password,omitempty"`
+	Url      string  `json:"url"`
+	Username *string `json:"username,omitempty"`
+}
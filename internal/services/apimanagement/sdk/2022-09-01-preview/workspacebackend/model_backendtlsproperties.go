@@ -0,0 +1,6 @@
+package workspacebackend
+
+type BackendTlsProperties struct {
+	ValidateCertificateChain *bool `json:"validateCertificateChain,omitempty"`
+	ValidateCertificateName  *bool `json:"validateCertificateName,omitempty"`
+}
@@ -0,0 +1,6 @@
+package workspacebackend
+
+type X509CertificateName struct {
+	IssuerCertificateThumbprint *string `json:"issuerCertificateThumbprint,omitempty"`
+	Name                        *string `json:"name,omitempty"`
+}
@@ -0,0 +1,15 @@
+package workspacenamedvalue
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WorkspaceNamedValueClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspaceNamedValueClientWithBaseURI(endpoint string) WorkspaceNamedValueClient {
+	return WorkspaceNamedValueClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
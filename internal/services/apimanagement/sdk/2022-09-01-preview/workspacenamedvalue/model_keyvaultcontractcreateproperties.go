@@ -0,0 +1,6 @@
+package workspacenamedvalue
+
+type KeyVaultContractCreateProperties struct {
+	IdentityClientId *string `json:"identityClientId,omitempty"`
+	SecretIdentifier *string `json:"secretIdentifier,omitempty"`
+}
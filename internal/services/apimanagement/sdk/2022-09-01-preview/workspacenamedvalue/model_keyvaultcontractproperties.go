@@ -0,0 +1,7 @@
+package workspacenamedvalue
+
+type KeyVaultContractProperties struct {
+	IdentityClientId *string                                     `json:"identityClientId,omitempty"`
+	LastStatus       *KeyVaultLastAccessStatusContractProperties `json:"lastStatus,omitempty"`
+	SecretIdentifier *string                                     `json:"secretIdentifier,omitempty"`
+}
@@ -0,0 +1,8 @@
+package workspacenamedvalue
+
+type NamedValueContract struct {
+	Id         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *NamedValueContractProperties `json:"properties,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package workspacenamedvalue
+
+type NamedValueCreateContract struct {
+	Id         *string                             `json:"id,omitempty"`
+	Name       *string                             `json:"name,omitempty"`
+	Properties *NamedValueCreateContractProperties `json:"properties,omitempty"`
+	Type       *string                             `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package workspacenamedvalue
+
+type NamedValueCreateContractProperties struct {
+	DisplayName string                            `json:"displayName"`
+	KeyVault    *KeyVaultContractCreateProperties `json:"keyVault,omitempty"`
+	Secret      *bool                             `json:"secret,omitempty"`
+	Tags        *[]string                         `json:"tags,omitempty"`
+	Value       *string                           `json:"value,omitempty"`
+}
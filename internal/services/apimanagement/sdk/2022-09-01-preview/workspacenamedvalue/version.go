@@ -0,0 +1,9 @@
+package workspacenamedvalue
+
+import "fmt"
+
+const defaultApiVersion = "2022-09-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/workspacenamedvalue/%s", defaultApiVersion)
+}
@@ -0,0 +1,150 @@
+package workspacepolicy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = WorkspacePolicyId{}
+
+// WorkspacePolicyId is a struct representing the Resource ID for a Workspace Policy
+type WorkspacePolicyId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ServiceName       string
+	WorkspaceId       string
+	PolicyId          string
+}
+
+// NewWorkspacePolicyID returns a new WorkspacePolicyId struct
+func NewWorkspacePolicyID(subscriptionId string, resourceGroupName string, serviceName string, workspaceId string, policyId string) WorkspacePolicyId {
+	return WorkspacePolicyId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		ServiceName:       serviceName,
+		WorkspaceId:       workspaceId,
+		PolicyId:          policyId,
+	}
+}
+
+// ParseWorkspacePolicyID parses 'input' into a WorkspacePolicyId
+func ParseWorkspacePolicyID(input string) (*WorkspacePolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(WorkspacePolicyId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := WorkspacePolicyId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServiceName, ok = parsed.Parsed["serviceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serviceName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkspaceId, ok = parsed.Parsed["workspaceId"]; !ok {
+		return nil, fmt.Errorf("the segment 'workspaceId' was not found in the resource id %q", input)
+	}
+
+	if id.PolicyId, ok = parsed.Parsed["policyId"]; !ok {
+		return nil, fmt.Errorf("the segment 'policyId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseWorkspacePolicyIDInsensitively parses 'input' case-insensitively into a WorkspacePolicyId
+// note: this method should only be used for API response data and not user input
+func ParseWorkspacePolicyIDInsensitively(input string) (*WorkspacePolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(WorkspacePolicyId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := WorkspacePolicyId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ServiceName, ok = parsed.Parsed["serviceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'serviceName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkspaceId, ok = parsed.Parsed["workspaceId"]; !ok {
+		return nil, fmt.Errorf("the segment 'workspaceId' was not found in the resource id %q", input)
+	}
+
+	if id.PolicyId, ok = parsed.Parsed["policyId"]; !ok {
+		return nil, fmt.Errorf("the segment 'policyId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateWorkspacePolicyID checks that 'input' can be parsed as a Workspace Policy ID
+func ValidateWorkspacePolicyID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseWorkspacePolicyID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Workspace Policy ID
+func (id WorkspacePolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/workspaces/%s/policies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceId, id.PolicyId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Workspace Policy ID
+func (id WorkspacePolicyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement", "Microsoft.ApiManagement"),
+		resourceids.StaticSegment("staticService", "service", "service"),
+		resourceids.UserSpecifiedSegment("serviceName", "serviceValue"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceId", "workspaceIdValue"),
+		resourceids.StaticSegment("staticPolicies", "policies", "policies"),
+		resourceids.UserSpecifiedSegment("policyId", "policyIdValue"),
+	}
+}
+
+// String returns a human-readable description of this Workspace Policy ID
+func (id WorkspacePolicyId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Service Name: %q", id.ServiceName),
+		fmt.Sprintf("Workspace Id: %q", id.WorkspaceId),
+		fmt.Sprintf("Policy Id: %q", id.PolicyId),
+	}
+	return fmt.Sprintf("Workspace Policy (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,93 @@
+package workspacepolicy
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetResponse struct {
+	HttpResponse *http.Response
+	Model        *PolicyContract
+}
+
+type GetOptions struct {
+	Format *PolicyExportFormat
+}
+
+func DefaultGetOptions() GetOptions {
+	return GetOptions{}
+}
+
+func (o GetOptions) toHeaders() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	return out
+}
+
+func (o GetOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.Format != nil {
+		out["format"] = *o.Format
+	}
+
+	return out
+}
+
+// Get ...
+func (c WorkspacePolicyClient) Get(ctx context.Context, id WorkspacePolicyId, options GetOptions) (result GetResponse, err error) {
+	req, err := c.preparerForGet(ctx, id, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workspacepolicy.WorkspacePolicyClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workspacepolicy.WorkspacePolicyClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workspacepolicy.WorkspacePolicyClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGet prepares the Get request.
+func (c WorkspacePolicyClient) preparerForGet(ctx context.Context, id WorkspacePolicyId, options GetOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithHeaders(options.toHeaders()),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGet handles the response to the Get request. The method always
+// closes the http.Response Body.
+func (c WorkspacePolicyClient) responderForGet(resp *http.Response) (result GetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
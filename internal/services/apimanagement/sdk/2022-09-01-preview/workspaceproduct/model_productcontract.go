@@ -0,0 +1,8 @@
+package workspaceproduct
+
+type ProductContract struct {
+	Id         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *ProductContractProperties `json:"properties,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
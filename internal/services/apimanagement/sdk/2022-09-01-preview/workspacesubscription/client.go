@@ -0,0 +1,15 @@
+package workspacesubscription
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WorkspaceSubscriptionClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspaceSubscriptionClientWithBaseURI(endpoint string) WorkspaceSubscriptionClient {
+	return WorkspaceSubscriptionClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
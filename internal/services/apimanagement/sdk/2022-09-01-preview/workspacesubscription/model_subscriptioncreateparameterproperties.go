@@ -0,0 +1,11 @@
+package workspacesubscription
+
+type SubscriptionCreateParameterProperties struct {
+	AllowTracing *bool              `json:"allowTracing,omitempty"`
+	DisplayName  string             `json:"displayName"`
+	OwnerId      *string            `json:"ownerId,omitempty"`
+	PrimaryKey   *string            `json:"primaryKey,omitempty"`
+	Scope        string             `json:"scope"`
+	SecondaryKey *string            `json:"secondaryKey,omitempty"`
+	State        *SubscriptionState `json:"state,omitempty"`
+}
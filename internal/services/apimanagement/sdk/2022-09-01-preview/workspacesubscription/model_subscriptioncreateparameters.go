@@ -0,0 +1,5 @@
+package workspacesubscription
+
+type SubscriptionCreateParameters struct {
+	Properties *SubscriptionCreateParameterProperties `json:"properties,omitempty"`
+}
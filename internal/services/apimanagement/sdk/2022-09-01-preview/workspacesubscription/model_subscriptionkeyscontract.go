@@ -0,0 +1,6 @@
+package workspacesubscription
+
+type SubscriptionKeysContract struct {
+	PrimaryKey   *string `json:"primaryKey,omitempty"`
+	SecondaryKey *string `json:"secondaryKey,omitempty"`
+}
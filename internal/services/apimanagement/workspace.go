@@ -0,0 +1,63 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+)
+
+// workspaceScopedClient performs the operations for an item which can be within either an API Management Service or
+// a Workspace within it - using the API Management Service-scoped models, so that the Create/Read/Update/Delete
+// functions can be shared between both. The operations are bound to the API Management Service-scoped or the
+// Workspace-scoped SDK Client when the client is built, with the models converted explicitly for a Workspace.
+type workspaceScopedClient[TInput any, TOutput any] struct {
+	CreateOrUpdate func(ctx context.Context, name string, input TInput) error
+	Get            func(ctx context.Context, name string) (TOutput, error)
+	Delete         func(ctx context.Context, name string) (autorest.Response, error)
+}
+
+// expandApiManagementWorkspaceID parses the Workspace ID specified in `input` (when set), ensuring that it's
+// a Workspace within the API Management Service `serviceName` in the Resource Group `resourceGroup` and
+// Subscription `subscriptionId`.
+func expandApiManagementWorkspaceID(input, subscriptionId, resourceGroup, serviceName string) (*parse.WorkspaceId, error) {
+	if input == "" {
+		return nil, nil
+	}
+
+	id, err := parse.WorkspaceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(id.SubscriptionId, subscriptionId) || !strings.EqualFold(id.ResourceGroup, resourceGroup) || !strings.EqualFold(id.ServiceName, serviceName) {
+		return nil, fmt.Errorf("`workspace_id` must be a Workspace within the API Management Service %q (Resource Group %q / Subscription %q) but got %s", serviceName, resourceGroup, subscriptionId, id)
+	}
+
+	return id, nil
+}
+
+func flattenApiManagementWorkspaceID(input *parse.WorkspaceId) string {
+	if input == nil {
+		return ""
+	}
+	return input.ID()
+}
+
+// convertWorkspaceDateTime converts a date/time returned within a Workspace (which is returned as an RFC3339 string)
+// into the representation used by the API Management Service-scoped models
+func convertWorkspaceDateTime(input *string) *date.Time {
+	if input == nil {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, *input)
+	if err != nil {
+		return nil
+	}
+	return &date.Time{Time: t}
+}
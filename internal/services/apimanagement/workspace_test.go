@@ -0,0 +1,192 @@
+package apimanagement
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspaceapi"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2022-09-01-preview/workspacesubscription"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestExpandApiManagementWorkspaceID(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+		valid    bool
+	}{
+		{
+			input: "",
+			valid: true,
+		},
+		{
+			input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/workspaces/workspace1",
+			expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/workspaces/workspace1",
+			valid:    true,
+		},
+		{
+			// a Workspace within another Subscription
+			input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/workspaces/workspace1",
+			valid: false,
+		},
+		{
+			// a Workspace within another Resource Group
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.ApiManagement/service/service1/workspaces/workspace1",
+			valid: false,
+		},
+		{
+			// a Workspace within another API Management Service
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service2/workspaces/workspace1",
+			valid: false,
+		},
+		{
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1",
+			valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual, err := expandApiManagementWorkspaceID(v.input, "00000000-0000-0000-0000-000000000000", "group1", "service1")
+		if !v.valid {
+			if err == nil {
+				t.Fatalf("expected an error but got %+v", actual)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if flattened := flattenApiManagementWorkspaceID(actual); flattened != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, flattened)
+		}
+	}
+}
+
+func TestConvertApiCreateOrUpdateParameterToWorkspace(t *testing.T) {
+	input := apimanagement.APICreateOrUpdateParameter{
+		APICreateOrUpdateProperties: &apimanagement.APICreateOrUpdateProperties{
+			DisplayName: utils.String("Example"),
+			Path:        utils.String("example"),
+			Protocols:   &[]apimanagement.Protocol{apimanagement.ProtocolHTTPS},
+			APIType:     apimanagement.APITypeSoap,
+			SoapAPIType: apimanagement.SoapAPITypeSoapPassThrough,
+			Format:      apimanagement.ContentFormatWsdl,
+			Value:       utils.String("<definitions />"),
+			WsdlSelector: &apimanagement.APICreateOrUpdatePropertiesWsdlSelector{
+				WsdlServiceName:  utils.String("service"),
+				WsdlEndpointName: utils.String("endpoint"),
+			},
+			AuthenticationSettings: &apimanagement.AuthenticationSettingsContract{
+				OAuth2: &apimanagement.OAuth2AuthenticationSettingsContract{
+					AuthorizationServerID: utils.String("server"),
+				},
+				Openid: &apimanagement.OpenIDAuthenticationSettingsContract{
+					OpenidProviderID:          utils.String("provider"),
+					BearerTokenSendingMethods: &[]apimanagement.BearerTokenSendingMethods{apimanagement.BearerTokenSendingMethodsQuery},
+				},
+			},
+		},
+	}
+
+	props := convertApiCreateOrUpdateParameterToWorkspace(input).Properties
+	if props == nil {
+		t.Fatalf("expected `properties` to be converted")
+	}
+	if props.DisplayName == nil || *props.DisplayName != "Example" || props.Path != "example" {
+		t.Fatalf("expected the Display Name and Path to be converted but got %+v", props)
+	}
+	if props.Protocols == nil || len(*props.Protocols) != 1 || (*props.Protocols)[0] != workspaceapi.ProtocolHttps {
+		t.Fatalf("expected the Protocols to be converted but got %+v", props.Protocols)
+	}
+	if props.Type == nil || *props.Type != workspaceapi.ApiTypeSoap {
+		t.Fatalf("expected the Type to be converted but got %+v", props.Type)
+	}
+	if props.ApiType == nil || *props.ApiType != workspaceapi.SoapApiTypeSoap {
+		t.Fatalf("expected the API Type to be converted but got %+v", props.ApiType)
+	}
+	if props.Format == nil || *props.Format != workspaceapi.ContentFormatWsdl || props.Value == nil || *props.Value != "<definitions />" {
+		t.Fatalf("expected the Format and Value to be converted but got %+v / %+v", props.Format, props.Value)
+	}
+	if props.WsdlSelector == nil || *props.WsdlSelector.WsdlServiceName != "service" || *props.WsdlSelector.WsdlEndpointName != "endpoint" {
+		t.Fatalf("expected the WSDL Selector to be converted but got %+v", props.WsdlSelector)
+	}
+	auth := props.AuthenticationSettings
+	if auth == nil || auth.OAuth2 == nil || *auth.OAuth2.AuthorizationServerId != "server" {
+		t.Fatalf("expected the OAuth2 Authentication Settings to be converted but got %+v", auth)
+	}
+	if auth.Openid == nil || *auth.Openid.OpenidProviderId != "provider" || auth.Openid.BearerTokenSendingMethods == nil || (*auth.Openid.BearerTokenSendingMethods)[0] != workspaceapi.BearerTokenSendingMethodsQuery {
+		t.Fatalf("expected the OpenID Authentication Settings to be converted but got %+v", auth.Openid)
+	}
+}
+
+func TestConvertApiContractFromWorkspace(t *testing.T) {
+	if actual := convertApiContractFromWorkspace(nil); actual.APIContractProperties != nil {
+		t.Fatalf("expected no properties for a nil model but got %+v", actual.APIContractProperties)
+	}
+
+	apiType := workspaceapi.ApiTypeSoap
+	input := workspaceapi.ApiContract{
+		Id:   utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ApiManagement/service/service1/workspaces/workspace1/apis/api1"),
+		Name: utils.String("api1"),
+		Properties: &workspaceapi.ApiContractProperties{
+			DisplayName: utils.String("Example"),
+			Path:        "example",
+			Type:        &apiType,
+			SubscriptionKeyParameterNames: &workspaceapi.SubscriptionKeyParameterNamesContract{
+				Header: utils.String("header"),
+				Query:  utils.String("query"),
+			},
+		},
+	}
+
+	actual := convertApiContractFromWorkspace(&input)
+	if actual.ID == nil || *actual.ID != *input.Id || actual.Name == nil || *actual.Name != "api1" {
+		t.Fatalf("expected the ID and Name to be converted but got %+v", actual)
+	}
+	props := actual.APIContractProperties
+	if props == nil {
+		t.Fatalf("expected `properties` to be converted")
+	}
+	if props.DisplayName == nil || *props.DisplayName != "Example" || props.Path == nil || *props.Path != "example" {
+		t.Fatalf("expected the Display Name and Path to be converted but got %+v", props)
+	}
+	if props.APIType != apimanagement.APITypeSoap {
+		t.Fatalf("expected the API Type to be %q but got %q", apimanagement.APITypeSoap, props.APIType)
+	}
+	if props.SubscriptionKeyParameterNames == nil || *props.SubscriptionKeyParameterNames.Header != "header" || *props.SubscriptionKeyParameterNames.Query != "query" {
+		t.Fatalf("expected the Subscription Key Parameter Names to be converted but got %+v", props.SubscriptionKeyParameterNames)
+	}
+	// the Authentication Settings are always returned (as with the API Management Service) since the flatten functions dereference them
+	if props.AuthenticationSettings == nil {
+		t.Fatalf("expected the Authentication Settings to be set")
+	}
+}
+
+func TestConvertSubscriptionContractFromWorkspace(t *testing.T) {
+	input := workspacesubscription.SubscriptionContract{
+		Properties: &workspacesubscription.SubscriptionContractProperties{
+			DisplayName: utils.String("Example"),
+			Scope:       "/apis",
+			State:       workspacesubscription.SubscriptionStateActive,
+			CreatedDate: utils.String("2023-01-02T03:04:05Z"),
+			EndDate:     utils.String("not-a-date"),
+		},
+	}
+
+	props := convertSubscriptionContractFromWorkspace(&input).SubscriptionContractProperties
+	if props == nil {
+		t.Fatalf("expected `properties` to be converted")
+	}
+	if props.Scope == nil || *props.Scope != "/apis" || props.State != apimanagement.SubscriptionStateActive {
+		t.Fatalf("expected the Scope and State to be converted but got %+v", props)
+	}
+	if props.CreatedDate == nil || props.CreatedDate.Year() != 2023 || props.CreatedDate.Second() != 5 {
+		t.Fatalf("expected the Created Date to be converted but got %+v", props.CreatedDate)
+	}
+	if props.EndDate != nil {
+		t.Fatalf("expected an invalid End Date to be omitted but got %+v", props.EndDate)
+	}
+}
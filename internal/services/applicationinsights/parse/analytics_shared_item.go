@@ -73,3 +73,23 @@ func AnalyticsSharedItemID(input string) (*AnalyticsSharedItemId, error) {
 
 	return &resourceId, nil
 }
+
+// AnalyticsSharedItemIDFromNames builds an AnalyticsSharedItemId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{componentName}/{analyticsItemName}", using the specified Subscription ID when this is omitted
+func AnalyticsSharedItemIDFromNames(subscriptionId, input string) (*AnalyticsSharedItemId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{componentName}/{analyticsItemName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{componentName}/{analyticsItemName}")
+		}
+	}
+
+	resourceId := NewAnalyticsSharedItemID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func AnalyticsUserItemID(input string) (*AnalyticsUserItemId, error) {
 
 	return &resourceId, nil
 }
+
+// AnalyticsUserItemIDFromNames builds an AnalyticsUserItemId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{componentName}/{myAnalyticsItemName}", using the specified Subscription ID when this is omitted
+func AnalyticsUserItemIDFromNames(subscriptionId, input string) (*AnalyticsUserItemId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{componentName}/{myAnalyticsItemName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{componentName}/{myAnalyticsItemName}")
+		}
+	}
+
+	resourceId := NewAnalyticsUserItemID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func ApiKeyIDInsensitively(input string) (*ApiKeyId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiKeyIDFromNames builds an ApiKeyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{componentName}/{name}", using the specified Subscription ID when this is omitted
+func ApiKeyIDFromNames(subscriptionId, input string) (*ApiKeyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{componentName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{componentName}/{name}")
+		}
+	}
+
+	resourceId := NewApiKeyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func SmartDetectionRuleIDInsensitively(input string) (*SmartDetectionRuleId, err
 
 	return &resourceId, nil
 }
+
+// SmartDetectionRuleIDFromNames builds an SmartDetectionRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{componentName}/{smartDetectionRuleName}", using the specified Subscription ID when this is omitted
+func SmartDetectionRuleIDFromNames(subscriptionId, input string) (*SmartDetectionRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{componentName}/{smartDetectionRuleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{componentName}/{smartDetectionRuleName}")
+		}
+	}
+
+	resourceId := NewSmartDetectionRuleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func AppHybridConnectionID(input string) (*AppHybridConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// AppHybridConnectionIDFromNames builds an AppHybridConnectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{siteName}/{hybridConnectionNamespaceName}/{relayName}", using the specified Subscription ID when this is omitted
+func AppHybridConnectionIDFromNames(subscriptionId, input string) (*AppHybridConnectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{hybridConnectionNamespaceName}/{relayName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{hybridConnectionNamespaceName}/{relayName}")
+		}
+	}
+
+	resourceId := NewAppHybridConnectionID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func FunctionAppFunctionID(input string) (*FunctionAppFunctionId, error) {
 
 	return &resourceId, nil
 }
+
+// FunctionAppFunctionIDFromNames builds an FunctionAppFunctionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{siteName}/{functionName}", using the specified Subscription ID when this is omitted
+func FunctionAppFunctionIDFromNames(subscriptionId, input string) (*FunctionAppFunctionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{functionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{functionName}")
+		}
+	}
+
+	resourceId := NewFunctionAppFunctionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -67,3 +67,23 @@ func ServicePlanID(input string) (*ServicePlanId, error) {
 
 	return &resourceId, nil
 }
+
+// ServicePlanIDFromNames builds an ServicePlanId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverfarmName}", using the specified Subscription ID when this is omitted
+func ServicePlanIDFromNames(subscriptionId, input string) (*ServicePlanId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverfarmName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverfarmName}")
+		}
+	}
+
+	resourceId := NewServicePlanID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -67,3 +67,23 @@ func WebAppID(input string) (*WebAppId, error) {
 
 	return &resourceId, nil
 }
+
+// WebAppIDFromNames builds an WebAppId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{siteName}", using the specified Subscription ID when this is omitted
+func WebAppIDFromNames(subscriptionId, input string) (*WebAppId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}")
+		}
+	}
+
+	resourceId := NewWebAppID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
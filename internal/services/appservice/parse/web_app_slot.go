@@ -73,3 +73,23 @@ func WebAppSlotID(input string) (*WebAppSlotId, error) {
 
 	return &resourceId, nil
 }
+
+// WebAppSlotIDFromNames builds an WebAppSlotId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}", using the specified Subscription ID when this is omitted
+func WebAppSlotIDFromNames(subscriptionId, input string) (*WebAppSlotId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}")
+		}
+	}
+
+	resourceId := NewWebAppSlotID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
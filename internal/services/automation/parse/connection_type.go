@@ -73,3 +73,23 @@ func ConnectionTypeID(input string) (*ConnectionTypeId, error) {
 
 	return &resourceId, nil
 }
+
+// ConnectionTypeIDFromNames builds an ConnectionTypeId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{automationAccountName}/{name}", using the specified Subscription ID when this is omitted
+func ConnectionTypeIDFromNames(subscriptionId, input string) (*ConnectionTypeId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{automationAccountName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{automationAccountName}/{name}")
+		}
+	}
+
+	resourceId := NewConnectionTypeID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
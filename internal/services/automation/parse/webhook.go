@@ -73,3 +73,23 @@ func WebhookID(input string) (*WebhookId, error) {
 
 	return &resourceId, nil
 }
+
+// WebhookIDFromNames builds an WebhookId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{automationAccountName}/{name}", using the specified Subscription ID when this is omitted
+func WebhookIDFromNames(subscriptionId, input string) (*WebhookId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{automationAccountName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{automationAccountName}/{name}")
+		}
+	}
+
+	resourceId := NewWebhookID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func ApplicationID(input string) (*ApplicationId, error) {
 
 	return &resourceId, nil
 }
+
+// ApplicationIDFromNames builds an ApplicationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{batchAccountName}/{name}", using the specified Subscription ID when this is omitted
+func ApplicationIDFromNames(subscriptionId, input string) (*ApplicationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{batchAccountName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{batchAccountName}/{name}")
+		}
+	}
+
+	resourceId := NewApplicationID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func JobID(input string) (*JobId, error) {
 
 	return &resourceId, nil
 }
+
+// JobIDFromNames builds an JobId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{batchAccountName}/{poolName}/{name}", using the specified Subscription ID when this is omitted
+func JobIDFromNames(subscriptionId, input string) (*JobId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{batchAccountName}/{poolName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{batchAccountName}/{poolName}/{name}")
+		}
+	}
+
+	resourceId := NewJobID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func BotChannelID(input string) (*BotChannelId, error) {
 
 	return &resourceId, nil
 }
+
+// BotChannelIDFromNames builds an BotChannelId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{botServiceName}/{channelName}", using the specified Subscription ID when this is omitted
+func BotChannelIDFromNames(subscriptionId, input string) (*BotChannelId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{botServiceName}/{channelName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{botServiceName}/{channelName}")
+		}
+	}
+
+	resourceId := NewBotChannelID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
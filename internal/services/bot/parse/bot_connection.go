@@ -73,3 +73,23 @@ func BotConnectionID(input string) (*BotConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// BotConnectionIDFromNames builds an BotConnectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{botServiceName}/{connectionName}", using the specified Subscription ID when this is omitted
+func BotConnectionIDFromNames(subscriptionId, input string) (*BotConnectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{botServiceName}/{connectionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{botServiceName}/{connectionName}")
+		}
+	}
+
+	resourceId := NewBotConnectionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -67,3 +67,23 @@ func BotHealthbotID(input string) (*BotHealthbotId, error) {
 
 	return &resourceId, nil
 }
+
+// BotHealthbotIDFromNames builds an BotHealthbotId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{healthBotName}", using the specified Subscription ID when this is omitted
+func BotHealthbotIDFromNames(subscriptionId, input string) (*BotHealthbotId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{healthBotName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{healthBotName}")
+		}
+	}
+
+	resourceId := NewBotHealthbotID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -0,0 +1,408 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorCustomDomain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorCustomDomainCreate,
+		Read:   resourceCdnFrontDoorCustomDomainRead,
+		Update: resourceCdnFrontDoorCustomDomainUpdate,
+		Delete: resourceCdnFrontDoorCustomDomainDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(12 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(24 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(12 * time.Hour),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorCustomDomainID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CdnFrontDoorCustomDomainName,
+			},
+
+			"cdn_frontdoor_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorProfileID,
+			},
+
+			"host_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 253),
+			},
+
+			"dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: zones.ValidateDnsZoneID,
+			},
+
+			"tls": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"certificate_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdCertificateTypeManagedCertificate),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdCertificateTypeCustomerCertificate),
+								string(cdn.AfdCertificateTypeManagedCertificate),
+							}, false),
+						},
+
+						"minimum_tls_version": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdMinimumTLSVersionTLS12),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdMinimumTLSVersionTLS10),
+								string(cdn.AfdMinimumTLSVersionTLS12),
+							}, false),
+						},
+
+						// NOTE: when a Managed Certificate is used, Front Door provisions (and exposes) the Secret itself
+						"cdn_frontdoor_secret_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.FrontDoorSecretID,
+						},
+					},
+				},
+			},
+
+			"expiration_date": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"validation_token": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.FrontDoorProfileID(d.Get("cdn_frontdoor_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorCustomDomainID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.ProfileName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_custom_domain", id.ID())
+	}
+
+	tls, err := expandCdnFrontDoorCustomDomainTlsParameters(d.Get("tls").([]interface{}), *profileId)
+	if err != nil {
+		return fmt.Errorf("expanding `tls`: %+v", err)
+	}
+
+	props := cdn.AFDDomainProperties{
+		HostName:    utils.String(d.Get("host_name").(string)),
+		TLSSettings: tls,
+	}
+
+	if v := d.Get("dns_zone_id").(string); v != "" {
+		props.AzureDNSZone = &cdn.ResourceReference{
+			ID: utils.String(v),
+		}
+	}
+
+	payload := cdn.AFDDomain{
+		AFDDomainProperties: &props,
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName, payload)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCdnFrontDoorCustomDomainRead(d, meta)
+}
+
+func resourceCdnFrontDoorCustomDomainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.CustomDomainName)
+	d.Set("cdn_frontdoor_profile_id", parse.NewFrontDoorProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID())
+
+	if props := resp.AFDDomainProperties; props != nil {
+		d.Set("host_name", props.HostName)
+
+		dnsZoneId := ""
+		if props.AzureDNSZone != nil && props.AzureDNSZone.ID != nil {
+			parsed, err := zones.ParseDnsZoneIDInsensitively(*props.AzureDNSZone.ID)
+			if err != nil {
+				return err
+			}
+			dnsZoneId = parsed.ID()
+		}
+		d.Set("dns_zone_id", dnsZoneId)
+
+		tls, err := flattenCdnFrontDoorCustomDomainTlsParameters(props.TLSSettings)
+		if err != nil {
+			return fmt.Errorf("flattening `tls`: %+v", err)
+		}
+		if err := d.Set("tls", tls); err != nil {
+			return fmt.Errorf("setting `tls`: %+v", err)
+		}
+
+		expirationDate := ""
+		validationToken := ""
+		if validationProps := props.ValidationProperties; validationProps != nil {
+			expirationDate = utils.NormalizeNilableString(validationProps.ExpirationDate)
+			validationToken = utils.NormalizeNilableString(validationProps.ValidationToken)
+		}
+		d.Set("expiration_date", expirationDate)
+		d.Set("validation_token", validationToken)
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorCustomDomainUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := cdn.AFDDomainUpdatePropertiesParameters{}
+
+	if d.HasChange("dns_zone_id") {
+		// NOTE: an empty reference is sent to remove the DNS Zone, since omitting it leaves it unchanged
+		params.AzureDNSZone = &cdn.ResourceReference{}
+		if v := d.Get("dns_zone_id").(string); v != "" {
+			params.AzureDNSZone.ID = utils.String(v)
+		}
+	}
+
+	if d.HasChange("tls") {
+		profileId := parse.NewFrontDoorProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName)
+		tls, err := expandCdnFrontDoorCustomDomainTlsParameters(d.Get("tls").([]interface{}), profileId)
+		if err != nil {
+			return fmt.Errorf("expanding `tls`: %+v", err)
+		}
+		params.TLSSettings = tls
+	}
+
+	payload := cdn.AFDDomainUpdateParameters{
+		AFDDomainUpdatePropertiesParameters: &params,
+	}
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName, payload)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorCustomDomainRead(d, meta)
+}
+
+func resourceCdnFrontDoorCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorCustomDomainsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// a Custom Domain can't be deleted whilst it's associated with a Route, rather than the (rather opaque)
+	// error returned from the API we surface which Routes need to be updated (or removed) first
+	routeIds, err := findCdnFrontDoorRoutesForCustomDomain(ctx, meta.(*clients.Client), *id)
+	if err != nil {
+		return err
+	}
+	if len(routeIds) > 0 {
+		return fmt.Errorf("deleting %s: the Custom Domain is still associated with the Front Door Route(s) %q - the Custom Domain must be removed from `cdn_frontdoor_custom_domain_ids` on these Routes before it can be deleted", *id, strings.Join(routeIds, ", "))
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// findCdnFrontDoorRoutesForCustomDomain returns the IDs of the Routes (across each of the Endpoints within
+// the Profile) which the Custom Domain is associated with
+func findCdnFrontDoorRoutesForCustomDomain(ctx context.Context, client *clients.Client, id parse.FrontDoorCustomDomainId) ([]string, error) {
+	endpointsClient := client.Cdn.FrontDoorEndpointsClient
+	routesClient := client.Cdn.FrontDoorRoutesClient
+
+	profileId := parse.NewFrontDoorProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName)
+	endpoints, err := endpointsClient.ListByProfileComplete(ctx, id.ResourceGroup, id.ProfileName)
+	if err != nil {
+		return nil, fmt.Errorf("listing the Endpoints within %s: %+v", profileId, err)
+	}
+
+	routeIds := make([]string, 0)
+	for endpoints.NotDone() {
+		endpoint := endpoints.Value()
+		if endpoint.Name != nil {
+			endpointId := parse.NewFrontDoorEndpointID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, *endpoint.Name)
+			routes, err := routesClient.ListByEndpointComplete(ctx, id.ResourceGroup, id.ProfileName, endpointId.AfdEndpointName)
+			if err != nil {
+				return nil, fmt.Errorf("listing the Routes within %s: %+v", endpointId, err)
+			}
+
+			for routes.NotDone() {
+				route := routes.Value()
+				if route.Name != nil && route.RouteProperties != nil && route.RouteProperties.CustomDomains != nil {
+					for _, domain := range *route.RouteProperties.CustomDomains {
+						if domain.ID != nil && strings.EqualFold(*domain.ID, id.ID()) {
+							routeIds = append(routeIds, parse.NewFrontDoorRouteID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, endpointId.AfdEndpointName, *route.Name).ID())
+							break
+						}
+					}
+				}
+
+				if err := routes.NextWithContext(ctx); err != nil {
+					return nil, fmt.Errorf("listing the Routes within %s: %+v", endpointId, err)
+				}
+			}
+		}
+
+		if err := endpoints.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing the Endpoints within %s: %+v", profileId, err)
+		}
+	}
+
+	return routeIds, nil
+}
+
+func expandCdnFrontDoorCustomDomainTlsParameters(input []interface{}, profileId parse.FrontDoorProfileId) (*cdn.AFDDomainHTTPSParameters, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, fmt.Errorf("the `tls` block must be specified")
+	}
+	v := input[0].(map[string]interface{})
+
+	certificateType := cdn.AfdCertificateType(v["certificate_type"].(string))
+	output := cdn.AFDDomainHTTPSParameters{
+		CertificateType:   certificateType,
+		MinimumTLSVersion: cdn.AfdMinimumTLSVersion(v["minimum_tls_version"].(string)),
+	}
+
+	// when a Managed Certificate is used the Secret is managed by Front Door (and exposed once provisioned),
+	// as such it's only sent when bringing our own Certificate
+	if certificateType == cdn.AfdCertificateTypeCustomerCertificate {
+		secretIdRaw := v["cdn_frontdoor_secret_id"].(string)
+		if secretIdRaw == "" {
+			return nil, fmt.Errorf("`cdn_frontdoor_secret_id` must be specified when the `certificate_type` is %q", certificateType)
+		}
+
+		secretId, err := parse.FrontDoorSecretID(secretIdRaw)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(parse.NewFrontDoorProfileID(secretId.SubscriptionId, secretId.ResourceGroup, secretId.ProfileName).ID(), profileId.ID()) {
+			return nil, fmt.Errorf("the Front Door Secret %q must be within the same Front Door Profile as the Custom Domain (%q)", secretIdRaw, profileId.ID())
+		}
+
+		output.Secret = &cdn.ResourceReference{
+			ID: utils.String(secretId.ID()),
+		}
+	}
+
+	return &output, nil
+}
+
+func flattenCdnFrontDoorCustomDomainTlsParameters(input *cdn.AFDDomainHTTPSParameters) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	secretId := ""
+	if input.Secret != nil && input.Secret.ID != nil {
+		parsed, err := parse.FrontDoorSecretIDInsensitively(*input.Secret.ID)
+		if err != nil {
+			return nil, err
+		}
+		secretId = parsed.ID()
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cdn_frontdoor_secret_id": secretId,
+			"certificate_type":        string(input.CertificateType),
+			"minimum_tls_version":     string(input.MinimumTLSVersion),
+		},
+	}, nil
+}
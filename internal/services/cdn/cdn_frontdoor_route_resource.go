@@ -0,0 +1,690 @@
+package cdn
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorRoute() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorRouteCreate,
+		Read:   resourceCdnFrontDoorRouteRead,
+		Update: resourceCdnFrontDoorRouteUpdate,
+		Delete: resourceCdnFrontDoorRouteDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorRouteID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CdnFrontDoorRouteName,
+			},
+
+			"cdn_frontdoor_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorEndpointID,
+			},
+
+			"cdn_frontdoor_origin_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.FrontDoorOriginGroupID,
+			},
+
+			// NOTE: the Origins aren't sent to the API, however a Route can't be created until the Origin Group
+			// contains at least one Origin - as such these are used to ensure the correct ordering of operations
+			"cdn_frontdoor_origin_ids": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.FrontDoorOriginID,
+				},
+			},
+
+			"patterns_to_match": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 25,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.CdnFrontDoorUrlPathConditionMatchValue,
+				},
+			},
+
+			"supported_protocols": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MaxItems: 2,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(cdn.AFDEndpointProtocolsHTTP),
+						string(cdn.AFDEndpointProtocolsHTTPS),
+					}, false),
+				},
+			},
+
+			"cache": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"query_string_caching_behavior": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(cdn.AfdQueryStringCachingBehaviorIgnoreQueryString),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.AfdQueryStringCachingBehaviorIgnoreQueryString),
+								string(cdn.AfdQueryStringCachingBehaviorIgnoreSpecifiedQueryStrings),
+								string(cdn.AfdQueryStringCachingBehaviorIncludeSpecifiedQueryStrings),
+								string(cdn.AfdQueryStringCachingBehaviorUseQueryString),
+							}, false),
+						},
+
+						"query_strings": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"compression_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"content_types_to_compress": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 100,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"cdn_frontdoor_custom_domain_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.FrontDoorCustomDomainID,
+				},
+			},
+
+			"cdn_frontdoor_origin_path": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cdn_frontdoor_rule_set_ids": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.FrontDoorRuleSetID,
+				},
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"forwarding_protocol": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(cdn.ForwardingProtocolMatchRequest),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cdn.ForwardingProtocolHTTPOnly),
+					string(cdn.ForwardingProtocolHTTPSOnly),
+					string(cdn.ForwardingProtocolMatchRequest),
+				}, false),
+			},
+
+			"https_redirect_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"link_to_default_domain": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorRouteCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	endpointId, err := parse.FrontDoorEndpointID(d.Get("cdn_frontdoor_endpoint_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorRouteID(endpointId.SubscriptionId, endpointId.ResourceGroup, endpointId.ProfileName, endpointId.AfdEndpointName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_route", id.ID())
+	}
+
+	protocols := expandCdnFrontDoorRouteSupportedProtocols(d.Get("supported_protocols").(*pluginsdk.Set).List())
+	httpsRedirect := d.Get("https_redirect_enabled").(bool)
+	if err := validateCdnFrontDoorRouteHttpsRedirect(httpsRedirect, protocols); err != nil {
+		return err
+	}
+
+	customDomains, err := expandCdnFrontDoorRouteCustomDomains(d.Get("cdn_frontdoor_custom_domain_ids").([]interface{}), *endpointId)
+	if err != nil {
+		return err
+	}
+	linkToDefaultDomain := d.Get("link_to_default_domain").(bool)
+	if err := validateCdnFrontDoorRouteLinkToDefaultDomain(linkToDefaultDomain, customDomains); err != nil {
+		return err
+	}
+
+	originGroupId, err := parse.FrontDoorOriginGroupID(d.Get("cdn_frontdoor_origin_group_id").(string))
+	if err != nil {
+		return err
+	}
+	if err := validateCdnFrontDoorRouteOrigins(d.Get("cdn_frontdoor_origin_ids").([]interface{}), *originGroupId); err != nil {
+		return err
+	}
+
+	props := cdn.RouteProperties{
+		CacheConfiguration:  expandCdnFrontDoorRouteCacheConfiguration(d.Get("cache").([]interface{})),
+		CustomDomains:       customDomains,
+		EnabledState:        expandEnabledBool(d.Get("enabled").(bool)),
+		ForwardingProtocol:  cdn.ForwardingProtocol(d.Get("forwarding_protocol").(string)),
+		HTTPSRedirect:       expandCdnFrontDoorRouteHttpsRedirect(httpsRedirect),
+		LinkToDefaultDomain: expandCdnFrontDoorRouteLinkToDefaultDomain(linkToDefaultDomain),
+		OriginGroup: &cdn.ResourceReference{
+			ID: utils.String(originGroupId.ID()),
+		},
+		PatternsToMatch:    utils.ExpandStringSlice(d.Get("patterns_to_match").([]interface{})),
+		RuleSets:           expandCdnFrontDoorRouteRuleSets(d.Get("cdn_frontdoor_rule_set_ids").([]interface{})),
+		SupportedProtocols: protocols,
+	}
+
+	if v := d.Get("cdn_frontdoor_origin_path").(string); v != "" {
+		props.OriginPath = utils.String(v)
+	}
+
+	payload := cdn.Route{
+		RouteProperties: &props,
+	}
+
+	// Routes within an Endpoint can't be modified concurrently
+	locks.ByID(endpointId.ID())
+	defer locks.UnlockByID(endpointId.ID())
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, payload)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCdnFrontDoorRouteRead(d, meta)
+}
+
+func resourceCdnFrontDoorRouteRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RouteName)
+	d.Set("cdn_frontdoor_endpoint_id", parse.NewFrontDoorEndpointID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName).ID())
+
+	if props := resp.RouteProperties; props != nil {
+		d.Set("enabled", flattenEnabledBool(props.EnabledState))
+		d.Set("forwarding_protocol", string(props.ForwardingProtocol))
+		d.Set("https_redirect_enabled", props.HTTPSRedirect == cdn.HTTPSRedirectEnabled)
+		d.Set("link_to_default_domain", props.LinkToDefaultDomain == cdn.LinkToDefaultDomainEnabled)
+		d.Set("cdn_frontdoor_origin_path", utils.NormalizeNilableString(props.OriginPath))
+
+		if err := d.Set("cache", flattenCdnFrontDoorRouteCacheConfiguration(props.CacheConfiguration)); err != nil {
+			return fmt.Errorf("setting `cache`: %+v", err)
+		}
+
+		customDomains, err := flattenCdnFrontDoorRouteCustomDomains(props.CustomDomains)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("cdn_frontdoor_custom_domain_ids", customDomains); err != nil {
+			return fmt.Errorf("setting `cdn_frontdoor_custom_domain_ids`: %+v", err)
+		}
+
+		originGroupId := ""
+		if props.OriginGroup != nil && props.OriginGroup.ID != nil {
+			parsed, err := parse.FrontDoorOriginGroupIDInsensitively(*props.OriginGroup.ID)
+			if err != nil {
+				return err
+			}
+			originGroupId = parsed.ID()
+		}
+		d.Set("cdn_frontdoor_origin_group_id", originGroupId)
+
+		if err := d.Set("patterns_to_match", utils.FlattenStringSlice(props.PatternsToMatch)); err != nil {
+			return fmt.Errorf("setting `patterns_to_match`: %+v", err)
+		}
+
+		ruleSets, err := flattenCdnFrontDoorRouteRuleSets(props.RuleSets)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("cdn_frontdoor_rule_set_ids", ruleSets); err != nil {
+			return fmt.Errorf("setting `cdn_frontdoor_rule_set_ids`: %+v", err)
+		}
+
+		if err := d.Set("supported_protocols", flattenCdnFrontDoorRouteSupportedProtocols(props.SupportedProtocols)); err != nil {
+			return fmt.Errorf("setting `supported_protocols`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorRouteUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+	endpointId := parse.NewFrontDoorEndpointID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName)
+
+	protocols := expandCdnFrontDoorRouteSupportedProtocols(d.Get("supported_protocols").(*pluginsdk.Set).List())
+	httpsRedirect := d.Get("https_redirect_enabled").(bool)
+	if err := validateCdnFrontDoorRouteHttpsRedirect(httpsRedirect, protocols); err != nil {
+		return err
+	}
+
+	customDomains, err := expandCdnFrontDoorRouteCustomDomains(d.Get("cdn_frontdoor_custom_domain_ids").([]interface{}), endpointId)
+	if err != nil {
+		return err
+	}
+	linkToDefaultDomain := d.Get("link_to_default_domain").(bool)
+	if err := validateCdnFrontDoorRouteLinkToDefaultDomain(linkToDefaultDomain, customDomains); err != nil {
+		return err
+	}
+
+	originGroupId, err := parse.FrontDoorOriginGroupID(d.Get("cdn_frontdoor_origin_group_id").(string))
+	if err != nil {
+		return err
+	}
+	if err := validateCdnFrontDoorRouteOrigins(d.Get("cdn_frontdoor_origin_ids").([]interface{}), *originGroupId); err != nil {
+		return err
+	}
+
+	params := cdn.RouteUpdatePropertiesParameters{}
+
+	if d.HasChange("cache") {
+		params.CacheConfiguration = expandCdnFrontDoorRouteCacheConfiguration(d.Get("cache").([]interface{}))
+	}
+
+	if d.HasChange("cdn_frontdoor_custom_domain_ids") {
+		params.CustomDomains = customDomains
+	}
+
+	if d.HasChange("cdn_frontdoor_origin_group_id") {
+		params.OriginGroup = &cdn.ResourceReference{
+			ID: utils.String(originGroupId.ID()),
+		}
+	}
+
+	if d.HasChange("cdn_frontdoor_origin_path") {
+		params.OriginPath = utils.String(d.Get("cdn_frontdoor_origin_path").(string))
+	}
+
+	if d.HasChange("cdn_frontdoor_rule_set_ids") {
+		params.RuleSets = expandCdnFrontDoorRouteRuleSets(d.Get("cdn_frontdoor_rule_set_ids").([]interface{}))
+	}
+
+	if d.HasChange("enabled") {
+		params.EnabledState = expandEnabledBool(d.Get("enabled").(bool))
+	}
+
+	if d.HasChange("forwarding_protocol") {
+		params.ForwardingProtocol = cdn.ForwardingProtocol(d.Get("forwarding_protocol").(string))
+	}
+
+	if d.HasChange("https_redirect_enabled") {
+		params.HTTPSRedirect = expandCdnFrontDoorRouteHttpsRedirect(httpsRedirect)
+	}
+
+	if d.HasChange("link_to_default_domain") {
+		params.LinkToDefaultDomain = expandCdnFrontDoorRouteLinkToDefaultDomain(linkToDefaultDomain)
+	}
+
+	if d.HasChange("patterns_to_match") {
+		params.PatternsToMatch = utils.ExpandStringSlice(d.Get("patterns_to_match").([]interface{}))
+	}
+
+	if d.HasChange("supported_protocols") {
+		params.SupportedProtocols = protocols
+	}
+
+	locks.ByID(endpointId.ID())
+	defer locks.UnlockByID(endpointId.ID())
+
+	payload := cdn.RouteUpdateParameters{
+		RouteUpdatePropertiesParameters: &params,
+	}
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName, payload)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorRouteRead(d, meta)
+}
+
+func resourceCdnFrontDoorRouteDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRoutesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRouteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	endpointId := parse.NewFrontDoorEndpointID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName)
+	locks.ByID(endpointId.ID())
+	defer locks.UnlockByID(endpointId.ID())
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func validateCdnFrontDoorRouteHttpsRedirect(httpsRedirect bool, protocols *[]cdn.AFDEndpointProtocols) error {
+	if !httpsRedirect {
+		return nil
+	}
+
+	supportsHttp := false
+	supportsHttps := false
+	for _, v := range *protocols {
+		switch v {
+		case cdn.AFDEndpointProtocolsHTTP:
+			supportsHttp = true
+		case cdn.AFDEndpointProtocolsHTTPS:
+			supportsHttps = true
+		}
+	}
+
+	if !supportsHttp || !supportsHttps {
+		return fmt.Errorf("`supported_protocols` must contain both `Http` and `Https` when `https_redirect_enabled` is set to `true`")
+	}
+
+	return nil
+}
+
+func validateCdnFrontDoorRouteLinkToDefaultDomain(linkToDefaultDomain bool, customDomains *[]cdn.ActivatedResourceReference) error {
+	if !linkToDefaultDomain && len(*customDomains) == 0 {
+		return fmt.Errorf("at least one `cdn_frontdoor_custom_domain_ids` must be specified when `link_to_default_domain` is set to `false`")
+	}
+
+	return nil
+}
+
+func validateCdnFrontDoorRouteOrigins(input []interface{}, originGroupId parse.FrontDoorOriginGroupId) error {
+	for _, v := range input {
+		originId, err := parse.FrontDoorOriginID(v.(string))
+		if err != nil {
+			return err
+		}
+
+		parentId := parse.NewFrontDoorOriginGroupID(originId.SubscriptionId, originId.ResourceGroup, originId.ProfileName, originId.OriginGroupName)
+		if !strings.EqualFold(parentId.ID(), originGroupId.ID()) {
+			return fmt.Errorf("the Front Door Origin %q must be within the Origin Group defined in `cdn_frontdoor_origin_group_id` (%q)", v.(string), originGroupId.ID())
+		}
+	}
+
+	return nil
+}
+
+func expandCdnFrontDoorRouteCacheConfiguration(input []interface{}) *cdn.AfdRouteCacheConfiguration {
+	// caching is disabled when the Cache Configuration is omitted
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	output := cdn.AfdRouteCacheConfiguration{
+		QueryStringCachingBehavior: cdn.AfdQueryStringCachingBehavior(v["query_string_caching_behavior"].(string)),
+		CompressionSettings: &cdn.CompressionSettings{
+			ContentTypesToCompress: utils.ExpandStringSlice(v["content_types_to_compress"].([]interface{})),
+			IsCompressionEnabled:   utils.Bool(v["compression_enabled"].(bool)),
+		},
+	}
+
+	if queryStrings := v["query_strings"].([]interface{}); len(queryStrings) > 0 {
+		output.QueryParameters = utils.String(strings.Join(*utils.ExpandStringSlice(queryStrings), ","))
+	}
+
+	return &output
+}
+
+func flattenCdnFrontDoorRouteCacheConfiguration(input *cdn.AfdRouteCacheConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	queryStrings := make([]interface{}, 0)
+	if input.QueryParameters != nil && *input.QueryParameters != "" {
+		for _, v := range strings.Split(*input.QueryParameters, ",") {
+			queryStrings = append(queryStrings, v)
+		}
+	}
+
+	compressionEnabled := false
+	contentTypesToCompress := make([]interface{}, 0)
+	if settings := input.CompressionSettings; settings != nil {
+		if settings.IsCompressionEnabled != nil {
+			compressionEnabled = *settings.IsCompressionEnabled
+		}
+		contentTypesToCompress = utils.FlattenStringSlice(settings.ContentTypesToCompress)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"compression_enabled":           compressionEnabled,
+			"content_types_to_compress":     contentTypesToCompress,
+			"query_string_caching_behavior": string(input.QueryStringCachingBehavior),
+			"query_strings":                 queryStrings,
+		},
+	}
+}
+
+func expandCdnFrontDoorRouteCustomDomains(input []interface{}, endpointId parse.FrontDoorEndpointId) (*[]cdn.ActivatedResourceReference, error) {
+	output := make([]cdn.ActivatedResourceReference, 0)
+	profileId := parse.NewFrontDoorProfileID(endpointId.SubscriptionId, endpointId.ResourceGroup, endpointId.ProfileName)
+
+	for _, v := range input {
+		customDomainId, err := parse.FrontDoorCustomDomainID(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		// Custom Domains can only be associated with Routes within the same Front Door Profile
+		parentId := parse.NewFrontDoorProfileID(customDomainId.SubscriptionId, customDomainId.ResourceGroup, customDomainId.ProfileName)
+		if !strings.EqualFold(parentId.ID(), profileId.ID()) {
+			return nil, fmt.Errorf("the Front Door Custom Domain %q must be within the same Front Door Profile as the Endpoint (%q)", v.(string), profileId.ID())
+		}
+
+		output = append(output, cdn.ActivatedResourceReference{
+			ID: utils.String(customDomainId.ID()),
+		})
+	}
+
+	return &output, nil
+}
+
+func flattenCdnFrontDoorRouteCustomDomains(input *[]cdn.ActivatedResourceReference) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, v := range *input {
+		if v.ID == nil {
+			continue
+		}
+
+		id, err := parse.FrontDoorCustomDomainIDInsensitively(*v.ID)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, id.ID())
+	}
+
+	return output, nil
+}
+
+func expandCdnFrontDoorRouteRuleSets(input []interface{}) *[]cdn.ResourceReference {
+	output := make([]cdn.ResourceReference, 0)
+	for _, v := range input {
+		output = append(output, cdn.ResourceReference{
+			ID: utils.String(v.(string)),
+		})
+	}
+
+	return &output
+}
+
+func flattenCdnFrontDoorRouteRuleSets(input *[]cdn.ResourceReference) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, v := range *input {
+		if v.ID == nil {
+			continue
+		}
+
+		id, err := parse.FrontDoorRuleSetIDInsensitively(*v.ID)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, id.ID())
+	}
+
+	return output, nil
+}
+
+func expandCdnFrontDoorRouteSupportedProtocols(input []interface{}) *[]cdn.AFDEndpointProtocols {
+	output := make([]cdn.AFDEndpointProtocols, 0)
+	for _, v := range input {
+		output = append(output, cdn.AFDEndpointProtocols(v.(string)))
+	}
+
+	return &output
+}
+
+func flattenCdnFrontDoorRouteSupportedProtocols(input *[]cdn.AFDEndpointProtocols) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, string(v))
+	}
+
+	return output
+}
+
+func expandCdnFrontDoorRouteHttpsRedirect(input bool) cdn.HTTPSRedirect {
+	if input {
+		return cdn.HTTPSRedirectEnabled
+	}
+
+	return cdn.HTTPSRedirectDisabled
+}
+
+func expandCdnFrontDoorRouteLinkToDefaultDomain(input bool) cdn.LinkToDefaultDomain {
+	if input {
+		return cdn.LinkToDefaultDomainEnabled
+	}
+
+	return cdn.LinkToDefaultDomainDisabled
+}
@@ -0,0 +1,271 @@
+package cdn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	cdnfrontdoorruleactions "github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/frontdoorruleactions"
+	cdnfrontdoorruleconditions "github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/frontdoorruleconditions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorRuleCreate,
+		Read:   resourceCdnFrontDoorRuleRead,
+		Update: resourceCdnFrontDoorRuleUpdate,
+		Delete: resourceCdnFrontDoorRuleDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorRuleID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorRuleName,
+			},
+
+			"cdn_frontdoor_rule_set_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorRuleSetID,
+			},
+
+			"order": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"behavior_on_match": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(cdn.MatchProcessingBehaviorContinue),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cdn.MatchProcessingBehaviorContinue),
+					string(cdn.MatchProcessingBehaviorStop),
+				}, false),
+			},
+
+			"actions": cdnfrontdoorruleactions.Schema(),
+
+			"conditions": cdnfrontdoorruleconditions.Schema(),
+
+			"cdn_frontdoor_rule_set_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRulesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	ruleSetId, err := parse.FrontDoorRuleSetID(d.Get("cdn_frontdoor_rule_set_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorRuleID(ruleSetId.SubscriptionId, ruleSetId.ResourceGroup, ruleSetId.ProfileName, ruleSetId.RuleSetName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_rule", id.ID())
+	}
+
+	actions, err := cdnfrontdoorruleactions.ExpandActions(d.Get("actions").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `actions`: %+v", err)
+	}
+
+	conditions, err := cdnfrontdoorruleconditions.ExpandConditions(d.Get("conditions").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `conditions`: %+v", err)
+	}
+
+	payload := cdn.Rule{
+		RuleProperties: &cdn.RuleProperties{
+			Actions:                 actions,
+			Conditions:              conditions,
+			MatchProcessingBehavior: cdn.MatchProcessingBehavior(d.Get("behavior_on_match").(string)),
+			Order:                   utils.Int32(int32(d.Get("order").(int))),
+		},
+	}
+
+	// the Rules within a Rule Set can't be modified concurrently
+	locks.ByID(ruleSetId.ID())
+	defer locks.UnlockByID(ruleSetId.ID())
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName, payload)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCdnFrontDoorRuleRead(d, meta)
+}
+
+func resourceCdnFrontDoorRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RuleName)
+	d.Set("cdn_frontdoor_rule_set_id", parse.NewFrontDoorRuleSetID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.RuleSetName).ID())
+	d.Set("cdn_frontdoor_rule_set_name", id.RuleSetName)
+
+	if props := resp.RuleProperties; props != nil {
+		d.Set("behavior_on_match", string(props.MatchProcessingBehavior))
+
+		order := 0
+		if props.Order != nil {
+			order = int(*props.Order)
+		}
+		d.Set("order", order)
+
+		actions, err := cdnfrontdoorruleactions.FlattenActions(props.Actions)
+		if err != nil {
+			return fmt.Errorf("flattening `actions`: %+v", err)
+		}
+		if err := d.Set("actions", actions); err != nil {
+			return fmt.Errorf("setting `actions`: %+v", err)
+		}
+
+		conditions, err := cdnfrontdoorruleconditions.FlattenConditions(props.Conditions)
+		if err != nil {
+			return fmt.Errorf("flattening `conditions`: %+v", err)
+		}
+		if err := d.Set("conditions", conditions); err != nil {
+			return fmt.Errorf("setting `conditions`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorRuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRulesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	params := cdn.RuleUpdatePropertiesParameters{}
+
+	if d.HasChange("actions") {
+		actions, err := cdnfrontdoorruleactions.ExpandActions(d.Get("actions").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `actions`: %+v", err)
+		}
+		params.Actions = actions
+	}
+
+	if d.HasChange("behavior_on_match") {
+		params.MatchProcessingBehavior = cdn.MatchProcessingBehavior(d.Get("behavior_on_match").(string))
+	}
+
+	if d.HasChange("conditions") {
+		conditions, err := cdnfrontdoorruleconditions.ExpandConditions(d.Get("conditions").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `conditions`: %+v", err)
+		}
+		params.Conditions = conditions
+	}
+
+	if d.HasChange("order") {
+		params.Order = utils.Int32(int32(d.Get("order").(int)))
+	}
+
+	ruleSetId := parse.NewFrontDoorRuleSetID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.RuleSetName)
+	locks.ByID(ruleSetId.ID())
+	defer locks.UnlockByID(ruleSetId.ID())
+
+	payload := cdn.RuleUpdateParameters{
+		RuleUpdatePropertiesParameters: &params,
+	}
+	future, err := client.Update(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName, payload)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceCdnFrontDoorRuleRead(d, meta)
+}
+
+func resourceCdnFrontDoorRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ruleSetId := parse.NewFrontDoorRuleSetID(id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.RuleSetName)
+	locks.ByID(ruleSetId.ID())
+	defer locks.UnlockByID(ruleSetId.ID())
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.RuleSetName, id.RuleName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
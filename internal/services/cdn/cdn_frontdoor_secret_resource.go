@@ -0,0 +1,298 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	keyvaultClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
+	keyvaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyvaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorSecret() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorSecretCreate,
+		Read:   resourceCdnFrontDoorSecretRead,
+		Delete: resourceCdnFrontDoorSecretDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FrontDoorSecretID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CdnFrontDoorSecretName,
+			},
+
+			"cdn_frontdoor_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FrontDoorProfileID,
+			},
+
+			"secret": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"customer_certificate": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									// NOTE: when the Version is omitted the latest version of the Certificate is used
+									"key_vault_certificate_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: keyvaultValidate.NestedItemIdWithOptionalVersion,
+									},
+
+									"subject_alternative_names": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"cdn_frontdoor_profile_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorSecretCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecretsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := parse.FrontDoorProfileID(d.Get("cdn_frontdoor_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFrontDoorSecretID(profileId.SubscriptionId, profileId.ResourceGroup, profileId.ProfileName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecretName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cdn_frontdoor_secret", id.ID())
+	}
+
+	params, err := expandCdnFrontDoorSecretParameters(ctx, meta.(*clients.Client), d.Get("secret").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `secret`: %+v", err)
+	}
+
+	payload := cdn.Secret{
+		SecretProperties: &cdn.SecretProperties{
+			Parameters: params,
+		},
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.ProfileName, id.SecretName, payload)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCdnFrontDoorSecretRead(d, meta)
+}
+
+func resourceCdnFrontDoorSecretRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecretsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.SecretName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SecretName)
+	d.Set("cdn_frontdoor_profile_id", parse.NewFrontDoorProfileID(id.SubscriptionId, id.ResourceGroup, id.ProfileName).ID())
+	d.Set("cdn_frontdoor_profile_name", id.ProfileName)
+
+	if props := resp.SecretProperties; props != nil {
+		secret, err := flattenCdnFrontDoorSecretParameters(ctx, meta.(*clients.Client).KeyVault, props.Parameters)
+		if err != nil {
+			return fmt.Errorf("flattening `secret`: %+v", err)
+		}
+		if err := d.Set("secret", secret); err != nil {
+			return fmt.Errorf("setting `secret`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorSecretDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorSecretsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FrontDoorSecretID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ProfileName, id.SecretName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandCdnFrontDoorSecretParameters(ctx context.Context, client *clients.Client, input []interface{}) (*cdn.CustomerCertificateParameters, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, fmt.Errorf("the `secret` block must be specified")
+	}
+
+	customerCertificate := input[0].(map[string]interface{})["customer_certificate"].([]interface{})
+	if len(customerCertificate) == 0 || customerCertificate[0] == nil {
+		return nil, fmt.Errorf("the `customer_certificate` block must be specified")
+	}
+	v := customerCertificate[0].(map[string]interface{})
+
+	certificateId, err := keyvaultParse.ParseOptionallyVersionedNestedItemID(v["key_vault_certificate_id"].(string))
+	if err != nil {
+		return nil, err
+	}
+	if certificateId.NestedItemType != "certificates" {
+		return nil, fmt.Errorf("`key_vault_certificate_id` must be the ID of a Key Vault Certificate, got a Key Vault %q", certificateId.NestedItemType)
+	}
+
+	keyVaultIdRaw, err := client.KeyVault.KeyVaultIDFromBaseUrl(ctx, client.Resource, certificateId.KeyVaultBaseUrl)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", certificateId.KeyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		return nil, fmt.Errorf("unexpected nil Key Vault ID retrieved at URL %q", certificateId.KeyVaultBaseUrl)
+	}
+	keyVaultId, err := keyvaultParse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	// Front Door references the Secret backing the Certificate, via its Resource Manager ID
+	output := cdn.CustomerCertificateParameters{
+		SecretSource: &cdn.ResourceReference{
+			ID: utils.String(fmt.Sprintf("%s/secrets/%s", keyVaultId.ID(), certificateId.Name)),
+		},
+		UseLatestVersion: utils.Bool(certificateId.Version == ""),
+		Type:             cdn.TypeBasicSecretParametersTypeCustomerCertificate,
+	}
+	if certificateId.Version != "" {
+		output.SecretVersion = utils.String(certificateId.Version)
+	}
+
+	return &output, nil
+}
+
+func flattenCdnFrontDoorSecretParameters(ctx context.Context, keyVaultsClient *keyvaultClient.Client, input cdn.BasicSecretParameters) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	certificate, ok := input.AsCustomerCertificateParameters()
+	if !ok {
+		return nil, fmt.Errorf("expected the Secret to be a Customer Certificate")
+	}
+
+	keyVaultCertificateId := ""
+	if certificate.SecretSource != nil && certificate.SecretSource.ID != nil {
+		// the Secret Source is in the format `{keyVaultId}/secrets/{name}`
+		segments := strings.Split(*certificate.SecretSource.ID, "/secrets/")
+		if len(segments) != 2 {
+			return nil, fmt.Errorf("parsing the Secret Source %q: expected the format `{keyVaultId}/secrets/{name}`", *certificate.SecretSource.ID)
+		}
+
+		keyVaultId, err := keyvaultParse.VaultID(segments[0])
+		if err != nil {
+			return nil, err
+		}
+
+		keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+		if err != nil {
+			return nil, fmt.Errorf("looking up the Base URI for %s: %+v", *keyVaultId, err)
+		}
+
+		version := ""
+		if certificate.UseLatestVersion == nil || !*certificate.UseLatestVersion {
+			version = utils.NormalizeNilableString(certificate.SecretVersion)
+		}
+
+		certificateId, err := keyvaultParse.NewNestedItemID(*keyVaultBaseUri, "certificates", segments[1], version)
+		if err != nil {
+			return nil, err
+		}
+		keyVaultCertificateId = certificateId.ID()
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"customer_certificate": []interface{}{
+				map[string]interface{}{
+					"key_vault_certificate_id":  keyVaultCertificateId,
+					"subject_alternative_names": utils.FlattenStringSlice(certificate.SubjectAlternativeNames),
+				},
+			},
+		},
+	}, nil
+}
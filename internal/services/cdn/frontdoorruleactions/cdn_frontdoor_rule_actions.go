@@ -0,0 +1,549 @@
+package cdnfrontdoorruleactions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// Schema returns the `actions` block for a Front Door Rule
+func Schema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"request_header_action": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 5,
+					Elem:     headerActionSchema(),
+				},
+
+				"response_header_action": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 5,
+					Elem:     headerActionSchema(),
+				},
+
+				"route_configuration_override_action": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"cdn_frontdoor_origin_group_id": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validate.FrontDoorOriginGroupID,
+							},
+
+							"forwarding_protocol": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(cdn.ForwardingProtocolHTTPOnly),
+									string(cdn.ForwardingProtocolHTTPSOnly),
+									string(cdn.ForwardingProtocolMatchRequest),
+								}, false),
+							},
+
+							// NOTE: `Disabled` isn't a value within the API, rather caching is disabled by omitting the Cache Configuration
+							"cache_behavior": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								Default:  "Disabled",
+								ValidateFunc: validation.StringInSlice([]string{
+									"Disabled",
+									string(cdn.RuleCacheBehaviorHonorOrigin),
+									string(cdn.RuleCacheBehaviorOverrideAlways),
+									string(cdn.RuleCacheBehaviorOverrideIfOriginMissing),
+								}, false),
+							},
+
+							"cache_duration": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validate.CdnFrontDoorCacheDuration,
+							},
+
+							"compression_enabled": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"query_string_caching_behavior": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								Default:  string(cdn.RuleQueryStringCachingBehaviorIgnoreQueryString),
+								ValidateFunc: validation.StringInSlice([]string{
+									string(cdn.RuleQueryStringCachingBehaviorIgnoreQueryString),
+									string(cdn.RuleQueryStringCachingBehaviorIgnoreSpecifiedQueryStrings),
+									string(cdn.RuleQueryStringCachingBehaviorIncludeSpecifiedQueryStrings),
+									string(cdn.RuleQueryStringCachingBehaviorUseQueryString),
+								}, false),
+							},
+
+							"query_string_parameters": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								MaxItems: 100,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+				},
+
+				"url_redirect_action": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"redirect_type": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(cdn.RedirectTypeFound),
+									string(cdn.RedirectTypeMoved),
+									string(cdn.RedirectTypePermanentRedirect),
+									string(cdn.RedirectTypeTemporaryRedirect),
+								}, false),
+							},
+
+							"destination_hostname": {
+								Type:     pluginsdk.TypeString,
+								Required: true,
+							},
+
+							"redirect_protocol": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								Default:  string(cdn.DestinationProtocolMatchRequest),
+								ValidateFunc: validation.StringInSlice([]string{
+									string(cdn.DestinationProtocolHTTP),
+									string(cdn.DestinationProtocolHTTPS),
+									string(cdn.DestinationProtocolMatchRequest),
+								}, false),
+							},
+
+							"destination_path": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validate.CdnFrontDoorUrlRedirectActionDestinationPath,
+							},
+
+							"query_string": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validate.CdnFrontDoorUrlRedirectActionQueryString,
+							},
+
+							"destination_fragment": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(0, 1024),
+							},
+						},
+					},
+				},
+
+				"url_rewrite_action": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"source_pattern": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "`source_pattern` must begin with a `/`"),
+							},
+
+							"destination": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "`destination` must begin with a `/`"),
+							},
+
+							"preserve_unmatched_path": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func headerActionSchema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"header_action": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cdn.HeaderActionAppend),
+					string(cdn.HeaderActionDelete),
+					string(cdn.HeaderActionOverwrite),
+				}, false),
+			},
+
+			"header_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"value": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+// ExpandActions expands the `actions` block of a Front Door Rule into the Delivery Rule Actions
+func ExpandActions(input []interface{}) (*[]cdn.BasicDeliveryRuleAction, error) {
+	output := make([]cdn.BasicDeliveryRuleAction, 0)
+	if len(input) == 0 || input[0] == nil {
+		return nil, fmt.Errorf("at least one action must be specified within the `actions` block")
+	}
+	raw := input[0].(map[string]interface{})
+
+	requestHeaderActions, err := expandHeaderActions(raw["request_header_action"].([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("expanding `request_header_action`: %+v", err)
+	}
+	for _, v := range requestHeaderActions {
+		output = append(output, cdn.DeliveryRuleRequestHeaderAction{
+			Name:       cdn.NameBasicDeliveryRuleActionNameModifyRequestHeader,
+			Parameters: v,
+		})
+	}
+
+	responseHeaderActions, err := expandHeaderActions(raw["response_header_action"].([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("expanding `response_header_action`: %+v", err)
+	}
+	for _, v := range responseHeaderActions {
+		output = append(output, cdn.DeliveryRuleResponseHeaderAction{
+			Name:       cdn.NameBasicDeliveryRuleActionNameModifyResponseHeader,
+			Parameters: v,
+		})
+	}
+
+	if v := raw["route_configuration_override_action"].([]interface{}); len(v) > 0 && v[0] != nil {
+		params, err := expandRouteConfigurationOverrideAction(v[0].(map[string]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("expanding `route_configuration_override_action`: %+v", err)
+		}
+		output = append(output, cdn.DeliveryRuleRouteConfigurationOverrideAction{
+			Name:       cdn.NameBasicDeliveryRuleActionNameRouteConfigurationOverride,
+			Parameters: params,
+		})
+	}
+
+	if v := raw["url_redirect_action"].([]interface{}); len(v) > 0 && v[0] != nil {
+		item := v[0].(map[string]interface{})
+		params := cdn.URLRedirectActionParameters{
+			TypeName:            utils.String("DeliveryRuleUrlRedirectActionParameters"),
+			RedirectType:        cdn.RedirectType(item["redirect_type"].(string)),
+			DestinationProtocol: cdn.DestinationProtocol(item["redirect_protocol"].(string)),
+			CustomHostname:      utils.String(item["destination_hostname"].(string)),
+		}
+		if path := item["destination_path"].(string); path != "" {
+			params.CustomPath = utils.String(path)
+		}
+		if queryString := item["query_string"].(string); queryString != "" {
+			params.CustomQueryString = utils.String(queryString)
+		}
+		if fragment := item["destination_fragment"].(string); fragment != "" {
+			params.CustomFragment = utils.String(fragment)
+		}
+
+		output = append(output, cdn.URLRedirectAction{
+			Name:       cdn.NameBasicDeliveryRuleActionNameURLRedirect,
+			Parameters: &params,
+		})
+	}
+
+	if v := raw["url_rewrite_action"].([]interface{}); len(v) > 0 && v[0] != nil {
+		item := v[0].(map[string]interface{})
+		output = append(output, cdn.URLRewriteAction{
+			Name: cdn.NameBasicDeliveryRuleActionNameURLRewrite,
+			Parameters: &cdn.URLRewriteActionParameters{
+				TypeName:              utils.String("DeliveryRuleUrlRewriteActionParameters"),
+				SourcePattern:         utils.String(item["source_pattern"].(string)),
+				Destination:           utils.String(item["destination"].(string)),
+				PreserveUnmatchedPath: utils.Bool(item["preserve_unmatched_path"].(bool)),
+			},
+		})
+	}
+
+	if len(output) == 0 {
+		return nil, fmt.Errorf("at least one action must be specified within the `actions` block")
+	}
+
+	if err := validate.CdnFrontDoorActionsBlock(output); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func expandHeaderActions(input []interface{}) ([]*cdn.HeaderActionParameters, error) {
+	output := make([]*cdn.HeaderActionParameters, 0)
+
+	for _, v := range input {
+		item := v.(map[string]interface{})
+		headerAction := cdn.HeaderAction(item["header_action"].(string))
+		value := item["value"].(string)
+
+		// the value is required when appending or overwriting a header, but can't be specified when deleting one
+		if headerAction == cdn.HeaderActionDelete && value != "" {
+			return nil, fmt.Errorf("`value` must not be specified when the `header_action` is %q", headerAction)
+		}
+		if headerAction != cdn.HeaderActionDelete && value == "" {
+			return nil, fmt.Errorf("`value` must be specified when the `header_action` is %q", headerAction)
+		}
+
+		params := cdn.HeaderActionParameters{
+			TypeName:     utils.String("DeliveryRuleHeaderActionParameters"),
+			HeaderAction: headerAction,
+			HeaderName:   utils.String(item["header_name"].(string)),
+		}
+		if value != "" {
+			params.Value = utils.String(value)
+		}
+
+		output = append(output, &params)
+	}
+
+	return output, nil
+}
+
+func expandRouteConfigurationOverrideAction(input map[string]interface{}) (*cdn.RouteConfigurationOverrideActionParameters, error) {
+	output := cdn.RouteConfigurationOverrideActionParameters{
+		TypeName: utils.String("DeliveryRuleRouteConfigurationOverrideActionParameters"),
+	}
+
+	originGroupId := input["cdn_frontdoor_origin_group_id"].(string)
+	forwardingProtocol := input["forwarding_protocol"].(string)
+	if originGroupId != "" {
+		if forwardingProtocol == "" {
+			forwardingProtocol = string(cdn.ForwardingProtocolMatchRequest)
+		}
+		output.OriginGroupOverride = &cdn.OriginGroupOverride{
+			OriginGroup: &cdn.ResourceReference{
+				ID: utils.String(originGroupId),
+			},
+			ForwardingProtocol: cdn.ForwardingProtocol(forwardingProtocol),
+		}
+	} else if forwardingProtocol != "" {
+		return nil, fmt.Errorf("`forwarding_protocol` can only be specified when `cdn_frontdoor_origin_group_id` is specified")
+	}
+
+	cacheBehavior := input["cache_behavior"].(string)
+	cacheDuration := input["cache_duration"].(string)
+	queryStringCachingBehavior := cdn.RuleQueryStringCachingBehavior(input["query_string_caching_behavior"].(string))
+	queryStringParameters := utils.ExpandStringSlice(input["query_string_parameters"].([]interface{}))
+
+	// caching is disabled by omitting the Cache Configuration, so there's nothing further to send
+	if cacheBehavior == "Disabled" {
+		if cacheDuration != "" || len(*queryStringParameters) > 0 || input["compression_enabled"].(bool) {
+			return nil, fmt.Errorf("`cache_duration`, `compression_enabled` and `query_string_parameters` can only be specified when `cache_behavior` isn't `Disabled`")
+		}
+
+		return &output, nil
+	}
+
+	switch cdn.RuleCacheBehavior(cacheBehavior) {
+	case cdn.RuleCacheBehaviorHonorOrigin:
+		if cacheDuration != "" {
+			return nil, fmt.Errorf("`cache_duration` must not be specified when the `cache_behavior` is %q", cacheBehavior)
+		}
+	default:
+		if cacheDuration == "" {
+			return nil, fmt.Errorf("`cache_duration` must be specified when the `cache_behavior` is %q", cacheBehavior)
+		}
+	}
+
+	switch queryStringCachingBehavior {
+	case cdn.RuleQueryStringCachingBehaviorIncludeSpecifiedQueryStrings, cdn.RuleQueryStringCachingBehaviorIgnoreSpecifiedQueryStrings:
+		if len(*queryStringParameters) == 0 {
+			return nil, fmt.Errorf("`query_string_parameters` must be specified when the `query_string_caching_behavior` is %q", queryStringCachingBehavior)
+		}
+	default:
+		if len(*queryStringParameters) > 0 {
+			return nil, fmt.Errorf("`query_string_parameters` can only be specified when the `query_string_caching_behavior` is %q or %q", cdn.RuleQueryStringCachingBehaviorIncludeSpecifiedQueryStrings, cdn.RuleQueryStringCachingBehaviorIgnoreSpecifiedQueryStrings)
+		}
+	}
+
+	isCompressionEnabled := cdn.RuleIsCompressionEnabledDisabled
+	if input["compression_enabled"].(bool) {
+		isCompressionEnabled = cdn.RuleIsCompressionEnabledEnabled
+	}
+
+	output.CacheConfiguration = &cdn.CacheConfiguration{
+		CacheBehavior:              cdn.RuleCacheBehavior(cacheBehavior),
+		IsCompressionEnabled:       isCompressionEnabled,
+		QueryStringCachingBehavior: queryStringCachingBehavior,
+	}
+	if cacheDuration != "" {
+		output.CacheConfiguration.CacheDuration = utils.String(cacheDuration)
+	}
+	if len(*queryStringParameters) > 0 {
+		output.CacheConfiguration.QueryParameters = utils.String(strings.Join(*queryStringParameters, ","))
+	}
+
+	return &output, nil
+}
+
+// FlattenActions flattens the Delivery Rule Actions into the `actions` block of a Front Door Rule
+func FlattenActions(input *[]cdn.BasicDeliveryRuleAction) ([]interface{}, error) {
+	requestHeaderActions := make([]interface{}, 0)
+	responseHeaderActions := make([]interface{}, 0)
+	routeConfigurationOverrideActions := make([]interface{}, 0)
+	urlRedirectActions := make([]interface{}, 0)
+	urlRewriteActions := make([]interface{}, 0)
+
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	for _, item := range *input {
+		if action, ok := item.AsDeliveryRuleRequestHeaderAction(); ok {
+			requestHeaderActions = append(requestHeaderActions, flattenHeaderAction(action.Parameters))
+			continue
+		}
+
+		if action, ok := item.AsDeliveryRuleResponseHeaderAction(); ok {
+			responseHeaderActions = append(responseHeaderActions, flattenHeaderAction(action.Parameters))
+			continue
+		}
+
+		if action, ok := item.AsDeliveryRuleRouteConfigurationOverrideAction(); ok {
+			flattened, err := flattenRouteConfigurationOverrideAction(action.Parameters)
+			if err != nil {
+				return nil, fmt.Errorf("flattening `route_configuration_override_action`: %+v", err)
+			}
+			routeConfigurationOverrideActions = append(routeConfigurationOverrideActions, flattened)
+			continue
+		}
+
+		if action, ok := item.AsURLRedirectAction(); ok {
+			if params := action.Parameters; params != nil {
+				urlRedirectActions = append(urlRedirectActions, map[string]interface{}{
+					"destination_fragment": utils.NormalizeNilableString(params.CustomFragment),
+					"destination_hostname": utils.NormalizeNilableString(params.CustomHostname),
+					"destination_path":     utils.NormalizeNilableString(params.CustomPath),
+					"query_string":         utils.NormalizeNilableString(params.CustomQueryString),
+					"redirect_protocol":    string(params.DestinationProtocol),
+					"redirect_type":        string(params.RedirectType),
+				})
+			}
+			continue
+		}
+
+		if action, ok := item.AsURLRewriteAction(); ok {
+			if params := action.Parameters; params != nil {
+				preserveUnmatchedPath := false
+				if params.PreserveUnmatchedPath != nil {
+					preserveUnmatchedPath = *params.PreserveUnmatchedPath
+				}
+
+				urlRewriteActions = append(urlRewriteActions, map[string]interface{}{
+					"destination":             utils.NormalizeNilableString(params.Destination),
+					"preserve_unmatched_path": preserveUnmatchedPath,
+					"source_pattern":          utils.NormalizeNilableString(params.SourcePattern),
+				})
+			}
+			continue
+		}
+
+		return nil, fmt.Errorf("unsupported Delivery Rule Action %+v", item)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"request_header_action":               requestHeaderActions,
+			"response_header_action":              responseHeaderActions,
+			"route_configuration_override_action": routeConfigurationOverrideActions,
+			"url_redirect_action":                 urlRedirectActions,
+			"url_rewrite_action":                  urlRewriteActions,
+		},
+	}, nil
+}
+
+func flattenHeaderAction(input *cdn.HeaderActionParameters) map[string]interface{} {
+	if input == nil {
+		return map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"header_action": string(input.HeaderAction),
+		"header_name":   utils.NormalizeNilableString(input.HeaderName),
+		"value":         utils.NormalizeNilableString(input.Value),
+	}
+}
+
+func flattenRouteConfigurationOverrideAction(input *cdn.RouteConfigurationOverrideActionParameters) (map[string]interface{}, error) {
+	output := map[string]interface{}{
+		"cache_behavior":                "Disabled",
+		"cache_duration":                "",
+		"cdn_frontdoor_origin_group_id": "",
+		"compression_enabled":           false,
+		"forwarding_protocol":           "",
+		"query_string_caching_behavior": string(cdn.RuleQueryStringCachingBehaviorIgnoreQueryString),
+		"query_string_parameters":       []interface{}{},
+	}
+	if input == nil {
+		return output, nil
+	}
+
+	if override := input.OriginGroupOverride; override != nil {
+		if override.OriginGroup != nil && override.OriginGroup.ID != nil {
+			originGroupId, err := parse.FrontDoorOriginGroupIDInsensitively(*override.OriginGroup.ID)
+			if err != nil {
+				return nil, err
+			}
+			output["cdn_frontdoor_origin_group_id"] = originGroupId.ID()
+		}
+		output["forwarding_protocol"] = string(override.ForwardingProtocol)
+	}
+
+	if cache := input.CacheConfiguration; cache != nil {
+		output["cache_behavior"] = string(cache.CacheBehavior)
+		output["cache_duration"] = utils.NormalizeNilableString(cache.CacheDuration)
+		output["compression_enabled"] = cache.IsCompressionEnabled == cdn.RuleIsCompressionEnabledEnabled
+		output["query_string_caching_behavior"] = string(cache.QueryStringCachingBehavior)
+
+		queryStringParameters := make([]interface{}, 0)
+		if cache.QueryParameters != nil && *cache.QueryParameters != "" {
+			for _, v := range strings.Split(*cache.QueryParameters, ",") {
+				queryStringParameters = append(queryStringParameters, v)
+			}
+		}
+		output["query_string_parameters"] = queryStringParameters
+	}
+
+	return output, nil
+}
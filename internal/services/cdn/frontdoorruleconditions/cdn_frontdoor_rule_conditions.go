@@ -0,0 +1,900 @@
+package cdnfrontdoorruleconditions
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// matchCondition is the shape shared by each of the Match Condition Parameters within the SDK, which
+// allows the schema, expand and flatten logic to be shared across each of the Conditions
+type matchCondition struct {
+	Selector        *string
+	Operator        string
+	NegateCondition *bool
+	MatchValues     *[]string
+	Transforms      *[]cdn.Transform
+}
+
+type conditionType struct {
+	// SchemaName is the name of the block within the `conditions` block
+	SchemaName string
+
+	// Operators are the Operators supported by this Condition, where only a single Operator
+	// is supported this is defaulted, as such it's not required to be specified
+	Operators []string
+
+	// SelectorName is the name of the field used to specify the Selector (e.g. the Header Name),
+	// which is only supported by some Conditions
+	SelectorName string
+
+	// MatchValuesValidateFunc validates each of the Match Values, when specified
+	MatchValuesValidateFunc pluginsdk.SchemaValidateFunc
+
+	// MatchValuesMaxItems is the maximum number of Match Values which can be specified, when non-zero
+	MatchValuesMaxItems int
+
+	// SupportsTransforms specifies whether the Transforms can be specified for this Condition
+	SupportsTransforms bool
+
+	expandFunc  func(input matchCondition) cdn.BasicDeliveryRuleCondition
+	flattenFunc func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool)
+}
+
+var stringOperators = []string{
+	"Any",
+	"Equal",
+	"Contains",
+	"BeginsWith",
+	"EndsWith",
+	"LessThan",
+	"LessThanOrEqual",
+	"GreaterThan",
+	"GreaterThanOrEqual",
+	"RegEx",
+}
+
+// conditionTypes returns the Conditions supported by a Front Door Rule - note that these are intentionally
+// a superset of the Conditions supported by a CDN Endpoint's Delivery Rule
+func conditionTypes() []conditionType {
+	return []conditionType{
+		{
+			SchemaName: "client_port_condition",
+			Operators:  stringOperators,
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleClientPortCondition{
+					Name: cdn.NameClientPort,
+					Parameters: &cdn.ClientPortMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleClientPortConditionParameters"),
+						Operator:        cdn.ClientPortOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleClientPortCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+					Transforms:      params.Transforms,
+				}, true
+			},
+		},
+		{
+			SchemaName:         "cookies_condition",
+			Operators:          stringOperators,
+			SelectorName:       "cookie_name",
+			SupportsTransforms: true,
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleCookiesCondition{
+					Name: cdn.NameCookies,
+					Parameters: &cdn.CookiesMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleCookiesConditionParameters"),
+						Selector:        input.Selector,
+						Operator:        cdn.CookiesOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+						Transforms:      input.Transforms,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleCookiesCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Selector:        params.Selector,
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+					Transforms:      params.Transforms,
+				}, true
+			},
+		},
+		{
+			SchemaName:         "host_name_condition",
+			Operators:          stringOperators,
+			SupportsTransforms: true,
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleHostNameCondition{
+					Name: cdn.NameHostName,
+					Parameters: &cdn.HostNameMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleHostNameConditionParameters"),
+						Operator:        cdn.HostNameOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+						Transforms:      input.Transforms,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleHostNameCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+					Transforms:      params.Transforms,
+				}, true
+			},
+		},
+		{
+			SchemaName: "http_version_condition",
+			Operators:  []string{"Equal"},
+			MatchValuesValidateFunc: validation.StringInSlice([]string{
+				"2.0",
+				"1.1",
+				"1.0",
+				"0.9",
+			}, false),
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleHTTPVersionCondition{
+					Name: cdn.NameHTTPVersion,
+					Parameters: &cdn.HTTPVersionMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleHttpVersionConditionParameters"),
+						Operator:        utils.String(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleHTTPVersionCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        utils.NormalizeNilableString(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+				}, true
+			},
+		},
+		{
+			SchemaName: "is_device_condition",
+			Operators:  []string{"Equal"},
+			MatchValuesValidateFunc: validation.StringInSlice([]string{
+				"Desktop",
+				"Mobile",
+			}, false),
+			MatchValuesMaxItems: 1,
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleIsDeviceCondition{
+					Name: cdn.NameIsDevice,
+					Parameters: &cdn.IsDeviceMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleIsDeviceConditionParameters"),
+						Operator:        utils.String(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleIsDeviceCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        utils.NormalizeNilableString(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+				}, true
+			},
+		},
+		{
+			SchemaName:         "post_args_condition",
+			Operators:          stringOperators,
+			SelectorName:       "post_args_name",
+			SupportsTransforms: true,
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRulePostArgsCondition{
+					Name: cdn.NamePostArgs,
+					Parameters: &cdn.PostArgsMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRulePostArgsConditionParameters"),
+						Selector:        input.Selector,
+						Operator:        cdn.PostArgsOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+						Transforms:      input.Transforms,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRulePostArgsCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Selector:        params.Selector,
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+					Transforms:      params.Transforms,
+				}, true
+			},
+		},
+		{
+			SchemaName:         "query_string_condition",
+			Operators:          stringOperators,
+			SupportsTransforms: true,
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleQueryStringCondition{
+					Name: cdn.NameQueryString,
+					Parameters: &cdn.QueryStringMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleQueryStringConditionParameters"),
+						Operator:        cdn.QueryStringOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+						Transforms:      input.Transforms,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleQueryStringCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+					Transforms:      params.Transforms,
+				}, true
+			},
+		},
+		{
+			SchemaName: "remote_address_condition",
+			Operators: []string{
+				"Any",
+				"GeoMatch",
+				"IPMatch",
+			},
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleRemoteAddressCondition{
+					Name: cdn.NameRemoteAddress,
+					Parameters: &cdn.RemoteAddressMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleRemoteAddressConditionParameters"),
+						Operator:        cdn.RemoteAddressOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleRemoteAddressCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+				}, true
+			},
+		},
+		{
+			SchemaName:         "request_body_condition",
+			Operators:          stringOperators,
+			SupportsTransforms: true,
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleRequestBodyCondition{
+					Name: cdn.NameRequestBody,
+					Parameters: &cdn.RequestBodyMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleRequestBodyConditionParameters"),
+						Operator:        cdn.RequestBodyOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+						Transforms:      input.Transforms,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleRequestBodyCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+					Transforms:      params.Transforms,
+				}, true
+			},
+		},
+		{
+			SchemaName:         "request_header_condition",
+			Operators:          stringOperators,
+			SelectorName:       "header_name",
+			SupportsTransforms: true,
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleRequestHeaderCondition{
+					Name: cdn.NameRequestHeader,
+					Parameters: &cdn.RequestHeaderMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleRequestHeaderConditionParameters"),
+						Selector:        input.Selector,
+						Operator:        cdn.RequestHeaderOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+						Transforms:      input.Transforms,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleRequestHeaderCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Selector:        params.Selector,
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+					Transforms:      params.Transforms,
+				}, true
+			},
+		},
+		{
+			SchemaName: "request_method_condition",
+			Operators:  []string{"Equal"},
+			MatchValuesValidateFunc: validation.StringInSlice([]string{
+				"DELETE",
+				"GET",
+				"HEAD",
+				"OPTIONS",
+				"POST",
+				"PUT",
+				"TRACE",
+			}, false),
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleRequestMethodCondition{
+					Name: cdn.NameRequestMethod,
+					Parameters: &cdn.RequestMethodMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleRequestMethodConditionParameters"),
+						Operator:        utils.String(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleRequestMethodCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        utils.NormalizeNilableString(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+				}, true
+			},
+		},
+		{
+			SchemaName: "request_scheme_condition",
+			Operators:  []string{"Equal"},
+			MatchValuesValidateFunc: validation.StringInSlice([]string{
+				"HTTP",
+				"HTTPS",
+			}, false),
+			MatchValuesMaxItems: 1,
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleRequestSchemeCondition{
+					Name: cdn.NameRequestScheme,
+					Parameters: &cdn.RequestSchemeMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleRequestSchemeConditionParameters"),
+						Operator:        utils.String(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleRequestSchemeCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        utils.NormalizeNilableString(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+				}, true
+			},
+		},
+		{
+			SchemaName:         "request_uri_condition",
+			Operators:          stringOperators,
+			SupportsTransforms: true,
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleRequestURICondition{
+					Name: cdn.NameRequestURI,
+					Parameters: &cdn.RequestURIMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleRequestUriConditionParameters"),
+						Operator:        cdn.RequestURIOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+						Transforms:      input.Transforms,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleRequestURICondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+					Transforms:      params.Transforms,
+				}, true
+			},
+		},
+		{
+			SchemaName: "server_port_condition",
+			Operators:  stringOperators,
+			MatchValuesValidateFunc: validation.StringInSlice([]string{
+				"80",
+				"443",
+			}, false),
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleServerPortCondition{
+					Name: cdn.NameServerPort,
+					Parameters: &cdn.ServerPortMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleServerPortConditionParameters"),
+						Operator:        cdn.ServerPortOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleServerPortCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+				}, true
+			},
+		},
+		{
+			SchemaName: "socket_address_condition",
+			Operators: []string{
+				"Any",
+				"IPMatch",
+			},
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleSocketAddrCondition{
+					Name: cdn.NameSocketAddr,
+					Parameters: &cdn.SocketAddrMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleSocketAddrConditionParameters"),
+						Operator:        cdn.SocketAddrOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleSocketAddrCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+				}, true
+			},
+		},
+		{
+			SchemaName: "ssl_protocol_condition",
+			Operators:  []string{"Equal"},
+			MatchValuesValidateFunc: validation.StringInSlice([]string{
+				string(cdn.SslProtocolTLSv1),
+				string(cdn.SslProtocolTLSv11),
+				string(cdn.SslProtocolTLSv12),
+			}, false),
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				protocols := make([]cdn.SslProtocol, 0)
+				if input.MatchValues != nil {
+					for _, v := range *input.MatchValues {
+						protocols = append(protocols, cdn.SslProtocol(v))
+					}
+				}
+
+				return cdn.DeliveryRuleSslProtocolCondition{
+					Name: cdn.NameSslProtocol,
+					Parameters: &cdn.SslProtocolMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleSslProtocolConditionParameters"),
+						Operator:        utils.String(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     &protocols,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleSslProtocolCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+
+				protocols := make([]string, 0)
+				if params.MatchValues != nil {
+					for _, v := range *params.MatchValues {
+						protocols = append(protocols, string(v))
+					}
+				}
+
+				return &matchCondition{
+					Operator:        utils.NormalizeNilableString(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     &protocols,
+				}, true
+			},
+		},
+		{
+			SchemaName:         "url_file_extension_condition",
+			Operators:          stringOperators,
+			SupportsTransforms: true,
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleURLFileExtensionCondition{
+					Name: cdn.NameURLFileExtension,
+					Parameters: &cdn.URLFileExtensionMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleUrlFileExtensionMatchConditionParameters"),
+						Operator:        cdn.URLFileExtensionOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+						Transforms:      input.Transforms,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleURLFileExtensionCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+					Transforms:      params.Transforms,
+				}, true
+			},
+		},
+		{
+			SchemaName:         "url_filename_condition",
+			Operators:          stringOperators,
+			SupportsTransforms: true,
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleURLFileNameCondition{
+					Name: cdn.NameURLFileName,
+					Parameters: &cdn.URLFileNameMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleUrlFilenameConditionParameters"),
+						Operator:        cdn.URLFileNameOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+						Transforms:      input.Transforms,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleURLFileNameCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+					Transforms:      params.Transforms,
+				}, true
+			},
+		},
+		{
+			SchemaName:         "url_path_condition",
+			Operators:          append([]string{"Wildcard"}, stringOperators...),
+			SupportsTransforms: true,
+			expandFunc: func(input matchCondition) cdn.BasicDeliveryRuleCondition {
+				return cdn.DeliveryRuleURLPathCondition{
+					Name: cdn.NameURLPath,
+					Parameters: &cdn.URLPathMatchConditionParameters{
+						TypeName:        utils.String("DeliveryRuleUrlPathMatchConditionParameters"),
+						Operator:        cdn.URLPathOperator(input.Operator),
+						NegateCondition: input.NegateCondition,
+						MatchValues:     input.MatchValues,
+						Transforms:      input.Transforms,
+					},
+				}
+			},
+			flattenFunc: func(input cdn.BasicDeliveryRuleCondition) (*matchCondition, bool) {
+				condition, ok := input.AsDeliveryRuleURLPathCondition()
+				if !ok || condition.Parameters == nil {
+					return nil, ok
+				}
+				params := condition.Parameters
+				return &matchCondition{
+					Operator:        string(params.Operator),
+					NegateCondition: params.NegateCondition,
+					MatchValues:     params.MatchValues,
+					Transforms:      params.Transforms,
+				}, true
+			},
+		},
+	}
+}
+
+// Schema returns the `conditions` block for a Front Door Rule
+func Schema() *pluginsdk.Schema {
+	conditions := make(map[string]*pluginsdk.Schema)
+	for _, v := range conditionTypes() {
+		conditions[v.SchemaName] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 10,
+			Elem:     v.schema(),
+		}
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: conditions,
+		},
+	}
+}
+
+func (c conditionType) schema() *pluginsdk.Resource {
+	s := map[string]*pluginsdk.Schema{
+		"negate_condition": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"match_values": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 25,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+
+	if len(c.Operators) == 1 {
+		s["operator"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      c.Operators[0],
+			ValidateFunc: validation.StringInSlice(c.Operators, false),
+		}
+	} else {
+		s["operator"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(c.Operators, false),
+		}
+	}
+
+	if c.MatchValuesValidateFunc != nil {
+		s["match_values"].Required = true
+		s["match_values"].Optional = false
+		s["match_values"].Elem = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: c.MatchValuesValidateFunc,
+		}
+	}
+	if c.MatchValuesMaxItems > 0 {
+		s["match_values"].MaxItems = c.MatchValuesMaxItems
+	}
+
+	if c.SelectorName != "" {
+		s[c.SelectorName] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		}
+	}
+
+	if c.SupportsTransforms {
+		s["transforms"] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			MaxItems: 6,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cdn.TransformLowercase),
+					string(cdn.TransformRemoveNulls),
+					string(cdn.TransformTrim),
+					string(cdn.TransformUppercase),
+					string(cdn.TransformURLDecode),
+					string(cdn.TransformURLEncode),
+				}, false),
+			},
+		}
+	}
+
+	return &pluginsdk.Resource{
+		Schema: s,
+	}
+}
+
+// ExpandConditions expands the `conditions` block of a Front Door Rule into the Delivery Rule Conditions
+func ExpandConditions(input []interface{}) (*[]cdn.BasicDeliveryRuleCondition, error) {
+	output := make([]cdn.BasicDeliveryRuleCondition, 0)
+	if len(input) == 0 || input[0] == nil {
+		return &output, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	for _, conditionType := range conditionTypes() {
+		for _, item := range raw[conditionType.SchemaName].([]interface{}) {
+			v := item.(map[string]interface{})
+			condition, err := conditionType.expand(v)
+			if err != nil {
+				return nil, fmt.Errorf("expanding `%s`: %+v", conditionType.SchemaName, err)
+			}
+
+			output = append(output, conditionType.expandFunc(*condition))
+		}
+	}
+
+	// a Rule supports a maximum of 10 Conditions across all of the Condition types
+	if len(output) > 10 {
+		return nil, fmt.Errorf("a maximum of 10 conditions can be specified within the `conditions` block, got %d", len(output))
+	}
+
+	return &output, nil
+}
+
+func (c conditionType) expand(input map[string]interface{}) (*matchCondition, error) {
+	operator := input["operator"].(string)
+	matchValues := utils.ExpandStringSlice(input["match_values"].([]interface{}))
+
+	// when the `Any` operator is used there's nothing to match against - so the Match Values must be omitted
+	if operator == "Any" {
+		if len(*matchValues) > 0 {
+			return nil, fmt.Errorf("`match_values` must not be specified when the `operator` is `Any`")
+		}
+	} else if len(*matchValues) == 0 {
+		return nil, fmt.Errorf("`match_values` must be specified when the `operator` is %q", operator)
+	}
+
+	output := matchCondition{
+		Operator:        operator,
+		NegateCondition: utils.Bool(input["negate_condition"].(bool)),
+		MatchValues:     matchValues,
+	}
+
+	if c.SelectorName != "" {
+		output.Selector = utils.String(input[c.SelectorName].(string))
+	}
+
+	if c.SupportsTransforms {
+		transforms := make([]cdn.Transform, 0)
+		for _, v := range input["transforms"].(*pluginsdk.Set).List() {
+			transforms = append(transforms, cdn.Transform(v.(string)))
+		}
+		output.Transforms = &transforms
+	}
+
+	return &output, nil
+}
+
+// FlattenConditions flattens the Delivery Rule Conditions into the `conditions` block of a Front Door Rule
+func FlattenConditions(input *[]cdn.BasicDeliveryRuleCondition) ([]interface{}, error) {
+	if input == nil || len(*input) == 0 {
+		return []interface{}{}, nil
+	}
+
+	types := conditionTypes()
+	output := make(map[string]interface{})
+	for _, conditionType := range types {
+		output[conditionType.SchemaName] = make([]interface{}, 0)
+	}
+
+	for _, item := range *input {
+		found := false
+		for _, conditionType := range types {
+			condition, ok := conditionType.flattenFunc(item)
+			if !ok {
+				continue
+			}
+
+			found = true
+			if condition != nil {
+				output[conditionType.SchemaName] = append(output[conditionType.SchemaName].([]interface{}), conditionType.flatten(*condition))
+			}
+			break
+		}
+
+		if !found {
+			return nil, fmt.Errorf("unsupported Delivery Rule Condition %+v", item)
+		}
+	}
+
+	return []interface{}{output}, nil
+}
+
+func (c conditionType) flatten(input matchCondition) map[string]interface{} {
+	negateCondition := false
+	if input.NegateCondition != nil {
+		negateCondition = *input.NegateCondition
+	}
+
+	output := map[string]interface{}{
+		"match_values":     utils.FlattenStringSlice(input.MatchValues),
+		"negate_condition": negateCondition,
+		"operator":         input.Operator,
+	}
+
+	if c.SelectorName != "" {
+		output[c.SelectorName] = utils.NormalizeNilableString(input.Selector)
+	}
+
+	if c.SupportsTransforms {
+		transforms := make([]interface{}, 0)
+		if input.Transforms != nil {
+			for _, v := range *input.Transforms {
+				transforms = append(transforms, string(v))
+			}
+		}
+		output["transforms"] = transforms
+	}
+
+	return output
+}
@@ -79,3 +79,23 @@ func CustomDomainID(input string) (*CustomDomainId, error) {
 
 	return &resourceId, nil
 }
+
+// CustomDomainIDFromNames builds an CustomDomainId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{profileName}/{endpointName}/{name}", using the specified Subscription ID when this is omitted
+func CustomDomainIDFromNames(subscriptionId, input string) (*CustomDomainId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{endpointName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{endpointName}/{name}")
+		}
+	}
+
+	resourceId := NewCustomDomainID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func EndpointIDInsensitively(input string) (*EndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// EndpointIDFromNames builds an EndpointId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{profileName}/{name}", using the specified Subscription ID when this is omitted
+func EndpointIDFromNames(subscriptionId, input string) (*EndpointId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{name}")
+		}
+	}
+
+	resourceId := NewEndpointID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func FrontDoorCustomDomainIDInsensitively(input string) (*FrontDoorCustomDomainI
 
 	return &resourceId, nil
 }
+
+// FrontDoorCustomDomainIDFromNames builds an FrontDoorCustomDomainId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{profileName}/{customDomainName}", using the specified Subscription ID when this is omitted
+func FrontDoorCustomDomainIDFromNames(subscriptionId, input string) (*FrontDoorCustomDomainId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{customDomainName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{customDomainName}")
+		}
+	}
+
+	resourceId := NewFrontDoorCustomDomainID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func FrontDoorEndpointIDInsensitively(input string) (*FrontDoorEndpointId, error
 
 	return &resourceId, nil
 }
+
+// FrontDoorEndpointIDFromNames builds an FrontDoorEndpointId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{profileName}/{afdEndpointName}", using the specified Subscription ID when this is omitted
+func FrontDoorEndpointIDFromNames(subscriptionId, input string) (*FrontDoorEndpointId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{afdEndpointName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{afdEndpointName}")
+		}
+	}
+
+	resourceId := NewFrontDoorEndpointID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -111,3 +111,23 @@ func FrontDoorFirewallPolicyIDInsensitively(input string) (*FrontDoorFirewallPol
 
 	return &resourceId, nil
 }
+
+// FrontDoorFirewallPolicyIDFromNames builds an FrontDoorFirewallPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{frontDoorWebApplicationFirewallPolicyName}", using the specified Subscription ID when this is omitted
+func FrontDoorFirewallPolicyIDFromNames(subscriptionId, input string) (*FrontDoorFirewallPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{frontDoorWebApplicationFirewallPolicyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{frontDoorWebApplicationFirewallPolicyName}")
+		}
+	}
+
+	resourceId := NewFrontDoorFirewallPolicyID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -147,3 +147,23 @@ func FrontDoorOriginIDInsensitively(input string) (*FrontDoorOriginId, error) {
 
 	return &resourceId, nil
 }
+
+// FrontDoorOriginIDFromNames builds an FrontDoorOriginId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{profileName}/{originGroupName}/{originName}", using the specified Subscription ID when this is omitted
+func FrontDoorOriginIDFromNames(subscriptionId, input string) (*FrontDoorOriginId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{originGroupName}/{originName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{originGroupName}/{originName}")
+		}
+	}
+
+	resourceId := NewFrontDoorOriginID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func FrontDoorOriginGroupIDInsensitively(input string) (*FrontDoorOriginGroupId,
 
 	return &resourceId, nil
 }
+
+// FrontDoorOriginGroupIDFromNames builds an FrontDoorOriginGroupId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{profileName}/{originGroupName}", using the specified Subscription ID when this is omitted
+func FrontDoorOriginGroupIDFromNames(subscriptionId, input string) (*FrontDoorOriginGroupId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{originGroupName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{originGroupName}")
+		}
+	}
+
+	resourceId := NewFrontDoorOriginGroupID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -111,3 +111,23 @@ func FrontDoorProfileIDInsensitively(input string) (*FrontDoorProfileId, error)
 
 	return &resourceId, nil
 }
+
+// FrontDoorProfileIDFromNames builds an FrontDoorProfileId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{profileName}", using the specified Subscription ID when this is omitted
+func FrontDoorProfileIDFromNames(subscriptionId, input string) (*FrontDoorProfileId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}")
+		}
+	}
+
+	resourceId := NewFrontDoorProfileID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
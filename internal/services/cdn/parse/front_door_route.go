@@ -0,0 +1,169 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FrontDoorRouteId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	ProfileName     string
+	AfdEndpointName string
+	RouteName       string
+}
+
+func NewFrontDoorRouteID(subscriptionId, resourceGroup, profileName, afdEndpointName, routeName string) FrontDoorRouteId {
+	return FrontDoorRouteId{
+		SubscriptionId:  subscriptionId,
+		ResourceGroup:   resourceGroup,
+		ProfileName:     profileName,
+		AfdEndpointName: afdEndpointName,
+		RouteName:       routeName,
+	}
+}
+
+func (id FrontDoorRouteId) String() string {
+	segments := []string{
+		fmt.Sprintf("Route Name %q", id.RouteName),
+		fmt.Sprintf("Afd Endpoint Name %q", id.AfdEndpointName),
+		fmt.Sprintf("Profile Name %q", id.ProfileName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Front Door Route", segmentsStr)
+}
+
+func (id FrontDoorRouteId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s/afdEndpoints/%s/routes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.AfdEndpointName, id.RouteName)
+}
+
+// FrontDoorRouteID parses a FrontDoorRoute ID into an FrontDoorRouteId struct
+func FrontDoorRouteID(input string) (*FrontDoorRouteId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FrontDoorRouteId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ProfileName, err = id.PopSegment("profiles"); err != nil {
+		return nil, err
+	}
+	if resourceId.AfdEndpointName, err = id.PopSegment("afdEndpoints"); err != nil {
+		return nil, err
+	}
+	if resourceId.RouteName, err = id.PopSegment("routes"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// FrontDoorRouteIDInsensitively parses an FrontDoorRoute ID into an FrontDoorRouteId struct, insensitively
+// This should only be used to parse an ID for rewriting, the FrontDoorRouteID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func FrontDoorRouteIDInsensitively(input string) (*FrontDoorRouteId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FrontDoorRouteId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'profiles' segment
+	profilesKey := "profiles"
+	for key := range id.Path {
+		if strings.EqualFold(key, profilesKey) {
+			profilesKey = key
+			break
+		}
+	}
+	if resourceId.ProfileName, err = id.PopSegment(profilesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'afdEndpoints' segment
+	afdEndpointsKey := "afdEndpoints"
+	for key := range id.Path {
+		if strings.EqualFold(key, afdEndpointsKey) {
+			afdEndpointsKey = key
+			break
+		}
+	}
+	if resourceId.AfdEndpointName, err = id.PopSegment(afdEndpointsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'routes' segment
+	routesKey := "routes"
+	for key := range id.Path {
+		if strings.EqualFold(key, routesKey) {
+			routesKey = key
+			break
+		}
+	}
+	if resourceId.RouteName, err = id.PopSegment(routesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// FrontDoorRouteIDFromNames builds an FrontDoorRouteId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{profileName}/{afdEndpointName}/{routeName}", using the specified Subscription ID when this is omitted
+func FrontDoorRouteIDFromNames(subscriptionId, input string) (*FrontDoorRouteId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{afdEndpointName}/{routeName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{afdEndpointName}/{routeName}")
+		}
+	}
+
+	resourceId := NewFrontDoorRouteID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
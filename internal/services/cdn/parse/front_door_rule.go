@@ -147,3 +147,23 @@ func FrontDoorRuleIDInsensitively(input string) (*FrontDoorRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// FrontDoorRuleIDFromNames builds an FrontDoorRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{profileName}/{ruleSetName}/{ruleName}", using the specified Subscription ID when this is omitted
+func FrontDoorRuleIDFromNames(subscriptionId, input string) (*FrontDoorRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{ruleSetName}/{ruleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{ruleSetName}/{ruleName}")
+		}
+	}
+
+	resourceId := NewFrontDoorRuleID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
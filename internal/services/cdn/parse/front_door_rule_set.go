@@ -129,3 +129,23 @@ func FrontDoorRuleSetIDInsensitively(input string) (*FrontDoorRuleSetId, error)
 
 	return &resourceId, nil
 }
+
+// FrontDoorRuleSetIDFromNames builds an FrontDoorRuleSetId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{profileName}/{ruleSetName}", using the specified Subscription ID when this is omitted
+func FrontDoorRuleSetIDFromNames(subscriptionId, input string) (*FrontDoorRuleSetId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{ruleSetName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{ruleSetName}")
+		}
+	}
+
+	resourceId := NewFrontDoorRuleSetID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -0,0 +1,151 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FrontDoorSecretId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ProfileName    string
+	SecretName     string
+}
+
+func NewFrontDoorSecretID(subscriptionId, resourceGroup, profileName, secretName string) FrontDoorSecretId {
+	return FrontDoorSecretId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ProfileName:    profileName,
+		SecretName:     secretName,
+	}
+}
+
+func (id FrontDoorSecretId) String() string {
+	segments := []string{
+		fmt.Sprintf("Secret Name %q", id.SecretName),
+		fmt.Sprintf("Profile Name %q", id.ProfileName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Front Door Secret", segmentsStr)
+}
+
+func (id FrontDoorSecretId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s/secrets/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.SecretName)
+}
+
+// FrontDoorSecretID parses a FrontDoorSecret ID into an FrontDoorSecretId struct
+func FrontDoorSecretID(input string) (*FrontDoorSecretId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FrontDoorSecretId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ProfileName, err = id.PopSegment("profiles"); err != nil {
+		return nil, err
+	}
+	if resourceId.SecretName, err = id.PopSegment("secrets"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// FrontDoorSecretIDInsensitively parses an FrontDoorSecret ID into an FrontDoorSecretId struct, insensitively
+// This should only be used to parse an ID for rewriting, the FrontDoorSecretID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func FrontDoorSecretIDInsensitively(input string) (*FrontDoorSecretId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FrontDoorSecretId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'profiles' segment
+	profilesKey := "profiles"
+	for key := range id.Path {
+		if strings.EqualFold(key, profilesKey) {
+			profilesKey = key
+			break
+		}
+	}
+	if resourceId.ProfileName, err = id.PopSegment(profilesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'secrets' segment
+	secretsKey := "secrets"
+	for key := range id.Path {
+		if strings.EqualFold(key, secretsKey) {
+			secretsKey = key
+			break
+		}
+	}
+	if resourceId.SecretName, err = id.PopSegment(secretsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// FrontDoorSecretIDFromNames builds an FrontDoorSecretId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{profileName}/{secretName}", using the specified Subscription ID when this is omitted
+func FrontDoorSecretIDFromNames(subscriptionId, input string) (*FrontDoorSecretId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{secretName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{secretName}")
+		}
+	}
+
+	resourceId := NewFrontDoorSecretID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
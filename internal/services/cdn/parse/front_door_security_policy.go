@@ -129,3 +129,23 @@ func FrontDoorSecurityPolicyIDInsensitively(input string) (*FrontDoorSecurityPol
 
 	return &resourceId, nil
 }
+
+// FrontDoorSecurityPolicyIDFromNames builds an FrontDoorSecurityPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{profileName}/{securityPolicyName}", using the specified Subscription ID when this is omitted
+func FrontDoorSecurityPolicyIDFromNames(subscriptionId, input string) (*FrontDoorSecurityPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{securityPolicyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{profileName}/{securityPolicyName}")
+		}
+	}
+
+	resourceId := NewFrontDoorSecurityPolicyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -0,0 +1,157 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2022-10-01-preview/capabilities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2022-10-01-preview/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ChaosStudioCapabilityResource struct{}
+
+var _ sdk.Resource = ChaosStudioCapabilityResource{}
+
+type ChaosStudioCapabilityModel struct {
+	CapabilityType      string `tfschema:"capability_type"`
+	ChaosStudioTargetId string `tfschema:"chaos_studio_target_id"`
+	Urn                 string `tfschema:"urn"`
+}
+
+func (r ChaosStudioCapabilityResource) ResourceType() string {
+	return "azurerm_chaos_studio_capability"
+}
+
+func (r ChaosStudioCapabilityResource) ModelObject() interface{} {
+	return &ChaosStudioCapabilityModel{}
+}
+
+func (r ChaosStudioCapabilityResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return capabilities.ValidateScopedCapabilityID
+}
+
+func (r ChaosStudioCapabilityResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"chaos_studio_target_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: targets.ValidateScopedTargetID,
+		},
+
+		"capability_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"urn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ChaosStudioCapabilityModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.ChaosStudio.CapabilitiesClient
+
+			targetId, err := targets.ParseScopedTargetID(model.ChaosStudioTargetId)
+			if err != nil {
+				return err
+			}
+
+			id := capabilities.NewScopedCapabilityID(targetId.ResourceId, targetId.TargetName, model.CapabilityType)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, capabilities.Capability{}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.CapabilitiesClient
+
+			id, err := capabilities.ParseScopedCapabilityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ChaosStudioCapabilityModel{
+				CapabilityType:      id.CapabilityName,
+				ChaosStudioTargetId: targets.NewScopedTargetID(id.ResourceId, id.TargetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Urn = utils.NormalizeNilableString(props.Urn)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.CapabilitiesClient
+
+			id, err := capabilities.ParseScopedCapabilityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
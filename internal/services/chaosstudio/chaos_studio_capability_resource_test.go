@@ -0,0 +1,87 @@
+package chaosstudio_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2022-10-01-preview/capabilities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ChaosStudioCapabilityResource struct{}
+
+func TestAccChaosStudioCapability_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_capability", "test")
+	r := ChaosStudioCapabilityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("urn").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccChaosStudioCapability_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_capability", "test")
+	r := ChaosStudioCapabilityResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r ChaosStudioCapabilityResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := capabilities.ParseScopedCapabilityID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ChaosStudio.CapabilitiesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ChaosStudioCapabilityResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_capability" "test" {
+  chaos_studio_target_id = azurerm_chaos_studio_target.test.id
+  capability_type        = "SecurityRule-1.0"
+}
+`, ChaosStudioTargetResource{}.basic(data))
+}
+
+func (r ChaosStudioCapabilityResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_capability" "import" {
+  chaos_studio_target_id = azurerm_chaos_studio_capability.test.chaos_studio_target_id
+  capability_type        = azurerm_chaos_studio_capability.test.capability_type
+}
+`, r.basic(data))
+}
@@ -0,0 +1,572 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2022-10-01-preview/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2022-10-01-preview/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	experimentActionTypeContinuous = "continuous"
+	experimentActionTypeDelay      = "delay"
+	experimentActionTypeDiscrete   = "discrete"
+)
+
+type ChaosStudioExperimentResource struct{}
+
+var _ sdk.ResourceWithUpdate = ChaosStudioExperimentResource{}
+
+type ChaosStudioExperimentModel struct {
+	Name              string                                     `tfschema:"name"`
+	ResourceGroupName string                                     `tfschema:"resource_group_name"`
+	Location          string                                     `tfschema:"location"`
+	Identity          []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Selectors         []ChaosStudioExperimentSelectorModel       `tfschema:"selector"`
+	Steps             []ChaosStudioExperimentStepModel           `tfschema:"step"`
+	Tags              map[string]string                          `tfschema:"tags"`
+}
+
+type ChaosStudioExperimentSelectorModel struct {
+	Name                 string   `tfschema:"name"`
+	ChaosStudioTargetIds []string `tfschema:"chaos_studio_target_ids"`
+}
+
+type ChaosStudioExperimentStepModel struct {
+	Name     string                             `tfschema:"name"`
+	Branches []ChaosStudioExperimentBranchModel `tfschema:"branch"`
+}
+
+type ChaosStudioExperimentBranchModel struct {
+	Name    string                             `tfschema:"name"`
+	Actions []ChaosStudioExperimentActionModel `tfschema:"action"`
+}
+
+type ChaosStudioExperimentActionModel struct {
+	ActionType   string            `tfschema:"action_type"`
+	Duration     string            `tfschema:"duration"`
+	Parameters   map[string]string `tfschema:"parameters"`
+	SelectorName string            `tfschema:"selector_name"`
+	Urn          string            `tfschema:"urn"`
+}
+
+func (r ChaosStudioExperimentResource) ResourceType() string {
+	return "azurerm_chaos_studio_experiment"
+}
+
+func (r ChaosStudioExperimentResource) ModelObject() interface{} {
+	return &ChaosStudioExperimentModel{}
+}
+
+func (r ChaosStudioExperimentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return experiments.ValidateExperimentID
+}
+
+func (r ChaosStudioExperimentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[^<>%&:?#/\\]{1,90}$`),
+				"`name` must be between 1 and 90 characters long and cannot contain the characters `<`, `>`, `%`, `&`, `:`, `?`, `#`, `/` or `\\`",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemOrUserAssignedIdentityRequired(),
+
+		"selector": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"chaos_studio_target_ids": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: targets.ValidateScopedTargetID,
+						},
+					},
+				},
+			},
+		},
+
+		"step": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"branch": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"action": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"action_type": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+												ValidateFunc: validation.StringInSlice([]string{
+													experimentActionTypeContinuous,
+													experimentActionTypeDelay,
+													experimentActionTypeDiscrete,
+												}, false),
+											},
+
+											"urn": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"duration": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validate.ISO8601Duration,
+											},
+
+											"parameters": {
+												Type:     pluginsdk.TypeMap,
+												Optional: true,
+												Elem: &pluginsdk.Schema{
+													Type: pluginsdk.TypeString,
+												},
+											},
+
+											"selector_name": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ChaosStudioExperimentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ChaosStudioExperimentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ChaosStudioExperimentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := experiments.NewExperimentID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandChaosStudioExperiment(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, *properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioExperimentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExperimentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ChaosStudioExperimentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Experiment has to be sent in full, so rebuild it from the configuration
+			properties, err := expandChaosStudioExperiment(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioExperimentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExperimentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			state := ChaosStudioExperimentModel{
+				Name:              id.ExperimentName,
+				ResourceGroupName: id.ResourceGroupName,
+				Location:          location.Normalize(model.Location),
+			}
+
+			identityValue, err := identity.FlattenSystemOrUserAssignedMapToModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("flattening `identity`: %+v", err)
+			}
+			state.Identity = *identityValue
+
+			selectors, err := flattenChaosStudioExperimentSelectors(model.Properties.Selectors)
+			if err != nil {
+				return err
+			}
+			state.Selectors = selectors
+
+			steps, err := flattenChaosStudioExperimentSteps(model.Properties.Steps)
+			if err != nil {
+				return err
+			}
+			state.Steps = steps
+
+			if model.Tags != nil {
+				state.Tags = *model.Tags
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ChaosStudioExperimentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExperimentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandChaosStudioExperiment(model ChaosStudioExperimentModel) (*experiments.Experiment, error) {
+	identityValue, err := identity.ExpandSystemOrUserAssignedMapFromModel(model.Identity)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	selectorNames := make(map[string]struct{})
+	for _, v := range model.Selectors {
+		selectorNames[v.Name] = struct{}{}
+	}
+
+	steps, err := expandChaosStudioExperimentSteps(model.Steps, selectorNames)
+	if err != nil {
+		return nil, err
+	}
+
+	return &experiments.Experiment{
+		Identity: identityValue,
+		Location: location.Normalize(model.Location),
+		Properties: experiments.ExperimentProperties{
+			Selectors: expandChaosStudioExperimentSelectors(model.Selectors),
+			Steps:     steps,
+		},
+		Tags: &model.Tags,
+	}, nil
+}
+
+func expandChaosStudioExperimentSelectors(input []ChaosStudioExperimentSelectorModel) []experiments.Selector {
+	output := make([]experiments.Selector, 0)
+	for _, v := range input {
+		targetReferences := make([]experiments.TargetReference, 0)
+		for _, targetId := range v.ChaosStudioTargetIds {
+			targetReferences = append(targetReferences, experiments.TargetReference{
+				Id:   targetId,
+				Type: experiments.TargetReferenceTypeChaosTarget,
+			})
+		}
+
+		output = append(output, experiments.ListSelector{
+			Id:      v.Name,
+			Targets: targetReferences,
+		})
+	}
+
+	return output
+}
+
+func expandChaosStudioExperimentSteps(input []ChaosStudioExperimentStepModel, selectorNames map[string]struct{}) ([]experiments.Step, error) {
+	output := make([]experiments.Step, 0)
+	for _, step := range input {
+		branches := make([]experiments.Branch, 0)
+		for _, branch := range step.Branches {
+			actions := make([]experiments.Action, 0)
+			for _, action := range branch.Actions {
+				v, err := expandChaosStudioExperimentAction(action, selectorNames)
+				if err != nil {
+					return nil, fmt.Errorf("expanding the `action` %q within the `branch` %q of the `step` %q: %+v", action.Urn, branch.Name, step.Name, err)
+				}
+				actions = append(actions, v)
+			}
+
+			branches = append(branches, experiments.Branch{
+				Name:    branch.Name,
+				Actions: actions,
+			})
+		}
+
+		output = append(output, experiments.Step{
+			Name:     step.Name,
+			Branches: branches,
+		})
+	}
+
+	return output, nil
+}
+
+func expandChaosStudioExperimentAction(input ChaosStudioExperimentActionModel, selectorNames map[string]struct{}) (experiments.Action, error) {
+	if input.ActionType == experimentActionTypeDelay {
+		if input.Duration == "" {
+			return nil, fmt.Errorf("`duration` must be specified when `action_type` is `%s`", experimentActionTypeDelay)
+		}
+		if input.SelectorName != "" || len(input.Parameters) > 0 {
+			return nil, fmt.Errorf("`selector_name` and `parameters` cannot be specified when `action_type` is `%s`", experimentActionTypeDelay)
+		}
+
+		return experiments.DelayAction{
+			Duration: input.Duration,
+			Name:     input.Urn,
+		}, nil
+	}
+
+	if input.SelectorName == "" {
+		return nil, fmt.Errorf("`selector_name` must be specified when `action_type` is `%s`", input.ActionType)
+	}
+	if _, ok := selectorNames[input.SelectorName]; !ok {
+		return nil, fmt.Errorf("the `selector_name` %q was not defined as a `selector`", input.SelectorName)
+	}
+
+	parameters := expandChaosStudioExperimentActionParameters(input.Parameters)
+
+	if input.ActionType == experimentActionTypeContinuous {
+		if input.Duration == "" {
+			return nil, fmt.Errorf("`duration` must be specified when `action_type` is `%s`", experimentActionTypeContinuous)
+		}
+
+		return experiments.ContinuousAction{
+			Duration:   input.Duration,
+			Name:       input.Urn,
+			Parameters: parameters,
+			SelectorId: input.SelectorName,
+		}, nil
+	}
+
+	if input.Duration != "" {
+		return nil, fmt.Errorf("`duration` cannot be specified when `action_type` is `%s`", experimentActionTypeDiscrete)
+	}
+
+	return experiments.DiscreteAction{
+		Name:       input.Urn,
+		Parameters: parameters,
+		SelectorId: input.SelectorName,
+	}, nil
+}
+
+func expandChaosStudioExperimentActionParameters(input map[string]string) []experiments.KeyValuePair {
+	output := make([]experiments.KeyValuePair, 0)
+	for k, v := range input {
+		output = append(output, experiments.KeyValuePair{
+			Key:   k,
+			Value: v,
+		})
+	}
+
+	return output
+}
+
+func flattenChaosStudioExperimentSelectors(input []experiments.Selector) ([]ChaosStudioExperimentSelectorModel, error) {
+	output := make([]ChaosStudioExperimentSelectorModel, 0)
+	for _, v := range input {
+		selector, ok := v.(experiments.ListSelector)
+		if !ok {
+			return nil, fmt.Errorf("unsupported Selector type %T", v)
+		}
+
+		targetIds := make([]string, 0)
+		for _, target := range selector.Targets {
+			targetId, err := targets.ParseScopedTargetIDInsensitively(target.Id)
+			if err != nil {
+				return nil, err
+			}
+			targetIds = append(targetIds, targetId.ID())
+		}
+
+		output = append(output, ChaosStudioExperimentSelectorModel{
+			Name:                 selector.Id,
+			ChaosStudioTargetIds: targetIds,
+		})
+	}
+
+	return output, nil
+}
+
+func flattenChaosStudioExperimentSteps(input []experiments.Step) ([]ChaosStudioExperimentStepModel, error) {
+	output := make([]ChaosStudioExperimentStepModel, 0)
+	for _, step := range input {
+		branches := make([]ChaosStudioExperimentBranchModel, 0)
+		for _, branch := range step.Branches {
+			actions := make([]ChaosStudioExperimentActionModel, 0)
+			for _, action := range branch.Actions {
+				v, err := flattenChaosStudioExperimentAction(action)
+				if err != nil {
+					return nil, err
+				}
+				actions = append(actions, *v)
+			}
+
+			branches = append(branches, ChaosStudioExperimentBranchModel{
+				Name:    branch.Name,
+				Actions: actions,
+			})
+		}
+
+		output = append(output, ChaosStudioExperimentStepModel{
+			Name:     step.Name,
+			Branches: branches,
+		})
+	}
+
+	return output, nil
+}
+
+func flattenChaosStudioExperimentAction(input experiments.Action) (*ChaosStudioExperimentActionModel, error) {
+	switch action := input.(type) {
+	case experiments.ContinuousAction:
+		return &ChaosStudioExperimentActionModel{
+			ActionType:   experimentActionTypeContinuous,
+			Duration:     action.Duration,
+			Parameters:   flattenChaosStudioExperimentActionParameters(action.Parameters),
+			SelectorName: action.SelectorId,
+			Urn:          action.Name,
+		}, nil
+
+	case experiments.DelayAction:
+		return &ChaosStudioExperimentActionModel{
+			ActionType: experimentActionTypeDelay,
+			Duration:   action.Duration,
+			Urn:        action.Name,
+		}, nil
+
+	case experiments.DiscreteAction:
+		return &ChaosStudioExperimentActionModel{
+			ActionType:   experimentActionTypeDiscrete,
+			Parameters:   flattenChaosStudioExperimentActionParameters(action.Parameters),
+			SelectorName: action.SelectorId,
+			Urn:          action.Name,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported Action type %T", input)
+}
+
+func flattenChaosStudioExperimentActionParameters(input []experiments.KeyValuePair) map[string]string {
+	output := make(map[string]string)
+	for _, v := range input {
+		output[v.Key] = v.Value
+	}
+
+	return output
+}
@@ -0,0 +1,253 @@
+package chaosstudio_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2022-10-01-preview/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ChaosStudioExperimentResource struct{}
+
+func TestAccChaosStudioExperiment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccChaosStudioExperiment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccChaosStudioExperiment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccChaosStudioExperiment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ChaosStudioExperimentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := experiments.ParseExperimentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ChaosStudio.ExperimentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ChaosStudioExperimentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_capability" "test" {
+  chaos_studio_target_id = azurerm_chaos_studio_target.test.id
+  capability_type        = "SecurityRule-1.0"
+}
+`, ChaosStudioTargetResource{}.basic(data))
+}
+
+func (r ChaosStudioExperimentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_experiment" "test" {
+  name                = "acctest-chaos-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  selector {
+    name                    = "Selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  step {
+    name = "Step1"
+    branch {
+      name = "Branch1"
+      action {
+        action_type = "delay"
+        urn         = "urn:csci:microsoft:chaosStudio:timedDelay/1.0"
+        duration    = "PT10M"
+      }
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ChaosStudioExperimentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_experiment" "import" {
+  name                = azurerm_chaos_studio_experiment.test.name
+  resource_group_name = azurerm_chaos_studio_experiment.test.resource_group_name
+  location            = azurerm_chaos_studio_experiment.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  selector {
+    name                    = "Selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  step {
+    name = "Step1"
+    branch {
+      name = "Branch1"
+      action {
+        action_type = "delay"
+        urn         = "urn:csci:microsoft:chaosStudio:timedDelay/1.0"
+        duration    = "PT10M"
+      }
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r ChaosStudioExperimentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_chaos_studio_experiment" "test" {
+  name                = "acctest-chaos-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  selector {
+    name                    = "Selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  step {
+    name = "Step1"
+    branch {
+      name = "Branch1"
+      action {
+        action_type   = "continuous"
+        urn           = azurerm_chaos_studio_capability.test.urn
+        selector_name = "Selector1"
+        duration      = "PT10M"
+        parameters = {
+          name                  = "acctest-deny-all"
+          protocol              = "Any"
+          sourceAddresses       = "[\"*\"]"
+          destinationAddresses  = "[\"*\"]"
+          action                = "Deny"
+          destinationPortRanges = "[\"*\"]"
+          sourcePortRanges      = "[\"*\"]"
+          priority              = "100"
+          direction             = "Inbound"
+        }
+      }
+    }
+  }
+
+  step {
+    name = "Step2"
+    branch {
+      name = "Branch1"
+      action {
+        action_type = "delay"
+        urn         = "urn:csci:microsoft:chaosStudio:timedDelay/1.0"
+        duration    = "PT5M"
+      }
+    }
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
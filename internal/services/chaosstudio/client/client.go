@@ -0,0 +1,31 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2022-10-01-preview/capabilities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2022-10-01-preview/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2022-10-01-preview/targets"
+)
+
+type Client struct {
+	CapabilitiesClient *capabilities.CapabilitiesClient
+	ExperimentsClient  *experiments.ExperimentsClient
+	TargetsClient      *targets.TargetsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	capabilitiesClient := capabilities.NewCapabilitiesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&capabilitiesClient.Client, o.ResourceManagerAuthorizer)
+
+	experimentsClient := experiments.NewExperimentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&experimentsClient.Client, o.ResourceManagerAuthorizer)
+
+	targetsClient := targets.NewTargetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&targetsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		CapabilitiesClient: &capabilitiesClient,
+		ExperimentsClient:  &experimentsClient,
+		TargetsClient:      &targetsClient,
+	}
+}
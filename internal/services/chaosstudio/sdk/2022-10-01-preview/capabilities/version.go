@@ -0,0 +1,9 @@
+package capabilities
+
+import "fmt"
+
+const defaultApiVersion = "2022-10-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/capabilities/%s", defaultApiVersion)
+}
@@ -0,0 +1,53 @@
+package experiments
+
+import "strings"
+
+type SelectorType string
+
+const (
+	SelectorTypeList SelectorType = "List"
+)
+
+func PossibleValuesForSelectorType() []string {
+	return []string{
+		string(SelectorTypeList),
+	}
+}
+
+func parseSelectorType(input string) (*SelectorType, error) {
+	vals := map[string]SelectorType{
+		"list": SelectorTypeList,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SelectorType(input)
+	return &out, nil
+}
+
+type TargetReferenceType string
+
+const (
+	TargetReferenceTypeChaosTarget TargetReferenceType = "ChaosTarget"
+)
+
+func PossibleValuesForTargetReferenceType() []string {
+	return []string{
+		string(TargetReferenceTypeChaosTarget),
+	}
+}
+
+func parseTargetReferenceType(input string) (*TargetReferenceType, error) {
+	vals := map[string]TargetReferenceType{
+		"chaostarget": TargetReferenceTypeChaosTarget,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TargetReferenceType(input)
+	return &out, nil
+}
@@ -0,0 +1,38 @@
+package experiments
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ Action = DiscreteAction{}
+
+type DiscreteAction struct {
+	Parameters []KeyValuePair `json:"parameters"`
+	SelectorId string         `json:"selectorId"`
+	Name       string         `json:"name"`
+}
+
+var _ json.Marshaler = DiscreteAction{}
+
+func (s DiscreteAction) MarshalJSON() ([]byte, error) {
+	type wrapper DiscreteAction
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling DiscreteAction: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling DiscreteAction: %+v", err)
+	}
+	decoded["type"] = "discrete"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling DiscreteAction: %+v", err)
+	}
+
+	return encoded, nil
+}
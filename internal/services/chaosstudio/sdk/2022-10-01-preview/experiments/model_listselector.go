@@ -0,0 +1,37 @@
+package experiments
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ Selector = ListSelector{}
+
+type ListSelector struct {
+	Targets []TargetReference `json:"targets"`
+	Id      string            `json:"id"`
+}
+
+var _ json.Marshaler = ListSelector{}
+
+func (s ListSelector) MarshalJSON() ([]byte, error) {
+	type wrapper ListSelector
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling ListSelector: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling ListSelector: %+v", err)
+	}
+	decoded["type"] = "List"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling ListSelector: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,45 @@
+package experiments
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type Selector interface {
+}
+
+func unmarshalSelectorImplementation(input []byte) (Selector, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling Selector into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["type"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "List") {
+		var out ListSelector
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ListSelector: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawSelectorImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawSelectorImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+
+}
@@ -0,0 +1,6 @@
+package experiments
+
+type TargetReference struct {
+	Id   string              `json:"id"`
+	Type TargetReferenceType `json:"type"`
+}
@@ -0,0 +1,110 @@
+package targets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ScopedTargetId{}
+
+// ScopedTargetId is a struct representing the Resource ID for a Scoped Target
+type ScopedTargetId struct {
+	ResourceId string
+	TargetName string
+}
+
+// NewScopedTargetID returns a new ScopedTargetId struct
+func NewScopedTargetID(resourceId string, targetName string) ScopedTargetId {
+	return ScopedTargetId{
+		ResourceId: resourceId,
+		TargetName: targetName,
+	}
+}
+
+// ParseScopedTargetID parses 'input' into a ScopedTargetId
+func ParseScopedTargetID(input string) (*ScopedTargetId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScopedTargetId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScopedTargetId{}
+
+	if id.ResourceId, ok = parsed.Parsed["resourceId"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceId' was not found in the resource id %q", input)
+	}
+
+	if id.TargetName, ok = parsed.Parsed["targetName"]; !ok {
+		return nil, fmt.Errorf("the segment 'targetName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseScopedTargetIDInsensitively parses 'input' case-insensitively into a ScopedTargetId
+// note: this method should only be used for API response data and not user input
+func ParseScopedTargetIDInsensitively(input string) (*ScopedTargetId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScopedTargetId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScopedTargetId{}
+
+	if id.ResourceId, ok = parsed.Parsed["resourceId"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceId' was not found in the resource id %q", input)
+	}
+
+	if id.TargetName, ok = parsed.Parsed["targetName"]; !ok {
+		return nil, fmt.Errorf("the segment 'targetName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateScopedTargetID checks that 'input' can be parsed as a Scoped Target ID
+func ValidateScopedTargetID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseScopedTargetID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Scoped Target ID
+func (id ScopedTargetId) ID() string {
+	fmtString := "/%s/providers/Microsoft.Chaos/targets/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.ResourceId, "/"), id.TargetName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Scoped Target ID
+func (id ScopedTargetId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("resourceId", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftChaos", "Microsoft.Chaos", "Microsoft.Chaos"),
+		resourceids.StaticSegment("staticTargets", "targets", "targets"),
+		resourceids.UserSpecifiedSegment("targetName", "targetValue"),
+	}
+}
+
+// String returns a human-readable description of this Scoped Target ID
+func (id ScopedTargetId) String() string {
+	components := []string{
+		fmt.Sprintf("Resource Id: %q", id.ResourceId),
+		fmt.Sprintf("Target Name: %q", id.TargetName),
+	}
+	return fmt.Sprintf("Scoped Target (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,10 @@
+package targets
+
+type Target struct {
+	Id         *string                `json:"id,omitempty"`
+	Location   *string                `json:"location,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties map[string]interface{} `json:"properties"`
+	SystemData *SystemData            `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
@@ -0,0 +1,63 @@
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2021-04-30/cognitiveservicesaccounts"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.UntypedServiceRegistrationWithSweepers = Registration{}
+
+// Sweepers returns the Sweepers for the leaked resources within this Service
+func (r Registration) Sweepers() []sdk.Sweeper {
+	return []sdk.Sweeper{
+		{
+			Name: "azurerm_cognitive_account",
+			// Cognitive Accounts are soft-deleted when the Resource Group containing them is deleted
+			Dependencies: []string{"azurerm_resource_group"},
+			Func:         sweepSoftDeletedCognitiveAccounts,
+		},
+	}
+}
+
+// sweepSoftDeletedCognitiveAccounts purges the soft-deleted Cognitive Accounts created by the Acceptance
+// Tests within the specified Location, since the names of these can't be reused until purged
+func sweepSoftDeletedCognitiveAccounts(ctx context.Context, client *clients.Client, locationName string) error {
+	accountsClient := client.Cognitive.AccountsClient
+
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+	result, err := accountsClient.DeletedAccountsListComplete(ctx, subscriptionId)
+	if err != nil {
+		return fmt.Errorf("listing soft-deleted Cognitive Accounts: %+v", err)
+	}
+
+	var errs *multierror.Error
+	for _, account := range result.Items {
+		if account.Id == nil || account.Name == nil || !sdk.IsSweepable(*account.Name) {
+			continue
+		}
+		if account.Location == nil || location.Normalize(*account.Location) != locationName {
+			continue
+		}
+
+		id, err := cognitiveservicesaccounts.ParseDeletedAccountIDInsensitively(*account.Id)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+			continue
+		}
+
+		log.Printf("[DEBUG] Purging %s..", *id)
+		if err := accountsClient.DeletedAccountsPurgeThenPoll(ctx, *id); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("purging %s: %+v", *id, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -21,6 +21,8 @@ import (
 
 type GalleryApplicationResource struct{}
 
+var _ sdk.ResourceWithImportByName = GalleryApplicationResource{}
+
 var (
 	_ sdk.ResourceWithUpdate        = GalleryApplicationResource{}
 	_ sdk.ResourceWithCustomizeDiff = GalleryApplicationResource{}
@@ -117,6 +119,16 @@ func (r GalleryApplicationResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return validate.GalleryApplicationID
 }
 
+func (r GalleryApplicationResource) IDFromNames() pluginsdk.IDFromNamesFunc {
+	return func(subscriptionId, input string) (string, error) {
+		id, err := parse.GalleryApplicationIDFromNames(subscriptionId, input)
+		if err != nil {
+			return "", err
+		}
+		return id.ID(), nil
+	}
+}
+
 func (r GalleryApplicationResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -21,6 +21,8 @@ import (
 
 type GalleryApplicationVersionResource struct{}
 
+var _ sdk.ResourceWithImportByName = GalleryApplicationVersionResource{}
+
 var (
 	_ sdk.ResourceWithUpdate        = GalleryApplicationVersionResource{}
 	_ sdk.ResourceWithCustomizeDiff = GalleryApplicationVersionResource{}
@@ -200,6 +202,16 @@ func (r GalleryApplicationVersionResource) IDValidationFunc() pluginsdk.SchemaVa
 	return validate.GalleryApplicationVersionID
 }
 
+func (r GalleryApplicationVersionResource) IDFromNames() pluginsdk.IDFromNamesFunc {
+	return func(subscriptionId, input string) (string, error) {
+		id, err := parse.GalleryApplicationVersionIDFromNames(subscriptionId, input)
+		if err != nil {
+			return "", err
+		}
+		return id.ID(), nil
+	}
+}
+
 func (r GalleryApplicationVersionResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
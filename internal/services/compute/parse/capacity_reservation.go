@@ -73,3 +73,23 @@ func CapacityReservationID(input string) (*CapacityReservationId, error) {
 
 	return &resourceId, nil
 }
+
+// CapacityReservationIDFromNames builds an CapacityReservationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{capacityReservationGroupName}/{name}", using the specified Subscription ID when this is omitted
+func CapacityReservationIDFromNames(subscriptionId, input string) (*CapacityReservationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{capacityReservationGroupName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{capacityReservationGroupName}/{name}")
+		}
+	}
+
+	resourceId := NewCapacityReservationID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func DataDiskID(input string) (*DataDiskId, error) {
 
 	return &resourceId, nil
 }
+
+// DataDiskIDFromNames builds an DataDiskId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualMachineName}/{name}", using the specified Subscription ID when this is omitted
+func DataDiskIDFromNames(subscriptionId, input string) (*DataDiskId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualMachineName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualMachineName}/{name}")
+		}
+	}
+
+	resourceId := NewDataDiskID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
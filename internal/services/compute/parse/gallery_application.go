@@ -73,3 +73,23 @@ func GalleryApplicationID(input string) (*GalleryApplicationId, error) {
 
 	return &resourceId, nil
 }
+
+// GalleryApplicationIDFromNames builds an GalleryApplicationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{galleryName}/{applicationName}", using the specified Subscription ID when this is omitted
+func GalleryApplicationIDFromNames(subscriptionId, input string) (*GalleryApplicationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{galleryName}/{applicationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{galleryName}/{applicationName}")
+		}
+	}
+
+	resourceId := NewGalleryApplicationID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
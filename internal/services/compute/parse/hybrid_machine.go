@@ -67,3 +67,23 @@ func HybridMachineID(input string) (*HybridMachineId, error) {
 
 	return &resourceId, nil
 }
+
+// HybridMachineIDFromNames builds an HybridMachineId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{machineName}", using the specified Subscription ID when this is omitted
+func HybridMachineIDFromNames(subscriptionId, input string) (*HybridMachineId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{machineName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{machineName}")
+		}
+	}
+
+	resourceId := NewHybridMachineID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
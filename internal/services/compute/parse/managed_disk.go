@@ -67,3 +67,23 @@ func ManagedDiskID(input string) (*ManagedDiskId, error) {
 
 	return &resourceId, nil
 }
+
+// ManagedDiskIDFromNames builds an ManagedDiskId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{diskName}", using the specified Subscription ID when this is omitted
+func ManagedDiskIDFromNames(subscriptionId, input string) (*ManagedDiskId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{diskName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{diskName}")
+		}
+	}
+
+	resourceId := NewManagedDiskID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -71,3 +71,23 @@ func PlanID(input string) (*PlanId, error) {
 
 	return &resourceId, nil
 }
+
+// PlanIDFromNames builds an PlanId from the names of each segment in the format
+// "[{subscriptionId}/]{agreementName}/{offerName}/{name}", using the specified Subscription ID when this is omitted
+func PlanIDFromNames(subscriptionId, input string) (*PlanId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{agreementName}/{offerName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{agreementName}/{offerName}/{name}")
+		}
+	}
+
+	resourceId := NewPlanID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -126,3 +126,87 @@ func TestPlanID(t *testing.T) {
 		}
 	}
 }
+
+func TestPlanIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *PlanId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "agreement1/offer1",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "12345678-1234-9876-4563-123456789012/agreement1/offer1/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "agreement1/offer1/hourly",
+			Expected: &PlanId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				AgreementName:  "agreement1",
+				OfferName:      "offer1",
+				Name:           "hourly",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "12345678-1234-9876-4563-123456789012/agreement1/offer1/hourly",
+			Expected: &PlanId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				AgreementName:  "agreement1",
+				OfferName:      "offer1",
+				Name:           "hourly",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := PlanIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.AgreementName != v.Expected.AgreementName {
+			t.Fatalf("Expected %q but got %q for AgreementName", v.Expected.AgreementName, actual.AgreementName)
+		}
+		if actual.OfferName != v.Expected.OfferName {
+			t.Fatalf("Expected %q but got %q for OfferName", v.Expected.OfferName, actual.OfferName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
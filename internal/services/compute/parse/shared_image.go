@@ -73,3 +73,23 @@ func SharedImageID(input string) (*SharedImageId, error) {
 
 	return &resourceId, nil
 }
+
+// SharedImageIDFromNames builds an SharedImageId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{galleryName}/{imageName}", using the specified Subscription ID when this is omitted
+func SharedImageIDFromNames(subscriptionId, input string) (*SharedImageId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{galleryName}/{imageName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{galleryName}/{imageName}")
+		}
+	}
+
+	resourceId := NewSharedImageID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
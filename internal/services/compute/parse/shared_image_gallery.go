@@ -67,3 +67,23 @@ func SharedImageGalleryID(input string) (*SharedImageGalleryId, error) {
 
 	return &resourceId, nil
 }
+
+// SharedImageGalleryIDFromNames builds an SharedImageGalleryId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{galleryName}", using the specified Subscription ID when this is omitted
+func SharedImageGalleryIDFromNames(subscriptionId, input string) (*SharedImageGalleryId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{galleryName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{galleryName}")
+		}
+	}
+
+	resourceId := NewSharedImageGalleryID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func SharedImageVersionID(input string) (*SharedImageVersionId, error) {
 
 	return &resourceId, nil
 }
+
+// SharedImageVersionIDFromNames builds an SharedImageVersionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{galleryName}/{imageName}/{versionName}", using the specified Subscription ID when this is omitted
+func SharedImageVersionIDFromNames(subscriptionId, input string) (*SharedImageVersionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{galleryName}/{imageName}/{versionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{galleryName}/{imageName}/{versionName}")
+		}
+	}
+
+	resourceId := NewSharedImageVersionID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -67,3 +67,23 @@ func SnapshotID(input string) (*SnapshotId, error) {
 
 	return &resourceId, nil
 }
+
+// SnapshotIDFromNames builds an SnapshotId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{name}", using the specified Subscription ID when this is omitted
+func SnapshotIDFromNames(subscriptionId, input string) (*SnapshotId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{name}")
+		}
+	}
+
+	resourceId := NewSnapshotID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
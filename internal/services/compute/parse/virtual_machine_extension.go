@@ -73,3 +73,23 @@ func VirtualMachineExtensionID(input string) (*VirtualMachineExtensionId, error)
 
 	return &resourceId, nil
 }
+
+// VirtualMachineExtensionIDFromNames builds an VirtualMachineExtensionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualMachineName}/{extensionName}", using the specified Subscription ID when this is omitted
+func VirtualMachineExtensionIDFromNames(subscriptionId, input string) (*VirtualMachineExtensionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualMachineName}/{extensionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualMachineName}/{extensionName}")
+		}
+	}
+
+	resourceId := NewVirtualMachineExtensionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
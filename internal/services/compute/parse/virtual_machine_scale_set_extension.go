@@ -73,3 +73,23 @@ func VirtualMachineScaleSetExtensionID(input string) (*VirtualMachineScaleSetExt
 
 	return &resourceId, nil
 }
+
+// VirtualMachineScaleSetExtensionIDFromNames builds an VirtualMachineScaleSetExtensionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualMachineScaleSetName}/{extensionName}", using the specified Subscription ID when this is omitted
+func VirtualMachineScaleSetExtensionIDFromNames(subscriptionId, input string) (*VirtualMachineScaleSetExtensionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualMachineScaleSetName}/{extensionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualMachineScaleSetName}/{extensionName}")
+		}
+	}
+
+	resourceId := NewVirtualMachineScaleSetExtensionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
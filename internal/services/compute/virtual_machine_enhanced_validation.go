@@ -0,0 +1,192 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// virtualMachineSkus caches the Virtual Machine SKUs available for each Subscription/Location
+var virtualMachineSkus = &sdk.CapabilityCache{}
+
+// virtualMachineSkuAvailability describes where a Virtual Machine SKU can be provisioned within a Location
+type virtualMachineSkuAvailability struct {
+	// restricted specifies whether this SKU is unavailable in this Location for this Subscription
+	restricted bool
+
+	// zones is the list of Availability Zones which this SKU can be provisioned within
+	zones []string
+}
+
+func (r Registration) EnhancedValidations() []sdk.EnhancedValidation {
+	return []sdk.EnhancedValidation{
+		virtualMachineSizeEnhancedValidation("azurerm_linux_virtual_machine"),
+		virtualMachineSizeEnhancedValidation("azurerm_windows_virtual_machine"),
+		virtualMachineScaleSetSkuEnhancedValidation("azurerm_linux_virtual_machine_scale_set"),
+		virtualMachineScaleSetSkuEnhancedValidation("azurerm_windows_virtual_machine_scale_set"),
+	}
+}
+
+func virtualMachineSizeEnhancedValidation(resourceType string) sdk.EnhancedValidation {
+	return sdk.EnhancedValidation{
+		ResourceType: resourceType,
+		Description:  fmt.Sprintf("the `size` of the %s is available in the Location/Zone", resourceType),
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			d := metadata.ResourceDiff
+			if d.Id() != "" && !d.HasChanges("location", "size", "zone") {
+				return nil
+			}
+			if !d.NewValueKnown("location") || !d.NewValueKnown("size") || !d.NewValueKnown("zone") {
+				return nil
+			}
+
+			zones := make([]string, 0)
+			if v := d.Get("zone").(string); v != "" {
+				zones = append(zones, v)
+			}
+
+			return validateVirtualMachineSkuAvailability(ctx, metadata, "size", d.Get("size").(string), d.Get("location").(string), zones)
+		},
+	}
+}
+
+func virtualMachineScaleSetSkuEnhancedValidation(resourceType string) sdk.EnhancedValidation {
+	return sdk.EnhancedValidation{
+		ResourceType: resourceType,
+		Description:  fmt.Sprintf("the `sku` of the %s is available in the Location/Zones", resourceType),
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			d := metadata.ResourceDiff
+			if d.Id() != "" && !d.HasChanges("location", "sku", "zones") {
+				return nil
+			}
+			if !d.NewValueKnown("location") || !d.NewValueKnown("sku") || !d.NewValueKnown("zones") {
+				return nil
+			}
+
+			zones := make([]string, 0)
+			for _, v := range d.Get("zones").(*pluginsdk.Set).List() {
+				zones = append(zones, v.(string))
+			}
+
+			return validateVirtualMachineSkuAvailability(ctx, metadata, "sku", d.Get("sku").(string), d.Get("location").(string), zones)
+		},
+	}
+}
+
+func validateVirtualMachineSkuAvailability(ctx context.Context, metadata sdk.ResourceMetaData, fieldName, size, locationName string, zones []string) error {
+	if size == "" || locationName == "" {
+		return nil
+	}
+
+	locationName = location.Normalize(locationName)
+	key := fmt.Sprintf("%s/%s", metadata.Client.Account.SubscriptionId, locationName)
+	raw, err := virtualMachineSkus.Get(key, func() (interface{}, error) {
+		return retrieveVirtualMachineSkus(ctx, metadata.Client.Compute.ResourceSkusClient, locationName)
+	})
+	if err != nil {
+		metadata.Logger.Warnf("%+v - skipping Enhanced Validation of the Virtual Machine SKU", err)
+		return nil
+	}
+
+	return checkVirtualMachineSkuAvailability(raw.(map[string]virtualMachineSkuAvailability), fieldName, size, locationName, zones)
+}
+
+func checkVirtualMachineSkuAvailability(skus map[string]virtualMachineSkuAvailability, fieldName, size, locationName string, zones []string) error {
+	sku, ok := skus[strings.ToLower(size)]
+	if !ok {
+		return fmt.Errorf("the %s %q is not available in the Location %q - the available sizes can be found by running `az vm list-skus --location %s`", fieldName, size, locationName, locationName)
+	}
+
+	if sku.restricted {
+		return fmt.Errorf("the %s %q is not available for this Subscription in the Location %q", fieldName, size, locationName)
+	}
+
+	for _, zone := range zones {
+		found := false
+		for _, v := range sku.zones {
+			if v == zone {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			if len(sku.zones) == 0 {
+				return fmt.Errorf("the %s %q is not available in any Availability Zones within the Location %q", fieldName, size, locationName)
+			}
+
+			return fmt.Errorf("the %s %q is not available in Availability Zone %q within the Location %q - it's available in the Zones: %s", fieldName, size, zone, locationName, strings.Join(sku.zones, ", "))
+		}
+	}
+
+	return nil
+}
+
+func retrieveVirtualMachineSkus(ctx context.Context, client *compute.ResourceSkusClient, locationName string) (map[string]virtualMachineSkuAvailability, error) {
+	iterator, err := client.ListComplete(ctx, fmt.Sprintf("location eq '%s'", locationName), "")
+	if err != nil {
+		return nil, fmt.Errorf("listing Resource SKUs: %+v", err)
+	}
+
+	output := make(map[string]virtualMachineSkuAvailability)
+	for iterator.NotDone() {
+		sku := iterator.Value()
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Resource SKUs: %+v", err)
+		}
+
+		if sku.ResourceType == nil || !strings.EqualFold(*sku.ResourceType, "virtualMachines") || sku.Name == nil {
+			continue
+		}
+
+		output[strings.ToLower(*sku.Name)] = flattenVirtualMachineSkuAvailability(sku, locationName)
+	}
+
+	return output, nil
+}
+
+func flattenVirtualMachineSkuAvailability(sku compute.ResourceSku, locationName string) virtualMachineSkuAvailability {
+	output := virtualMachineSkuAvailability{
+		zones: make([]string, 0),
+	}
+
+	restrictedZones := make(map[string]struct{})
+	if sku.Restrictions != nil {
+		for _, restriction := range *sku.Restrictions {
+			switch restriction.Type {
+			case compute.ResourceSkuRestrictionsTypeLocation:
+				output.restricted = true
+
+			case compute.ResourceSkuRestrictionsTypeZone:
+				if restriction.RestrictionInfo != nil && restriction.RestrictionInfo.Zones != nil {
+					for _, zone := range *restriction.RestrictionInfo.Zones {
+						restrictedZones[zone] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+
+	if sku.LocationInfo != nil {
+		for _, info := range *sku.LocationInfo {
+			if info.Location == nil || location.Normalize(*info.Location) != locationName || info.Zones == nil {
+				continue
+			}
+
+			for _, zone := range *info.Zones {
+				if _, restricted := restrictedZones[zone]; !restricted {
+					output.zones = append(output.zones, zone)
+				}
+			}
+		}
+	}
+	sort.Strings(output.zones)
+
+	return output
+}
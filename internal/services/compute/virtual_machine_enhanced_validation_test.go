@@ -0,0 +1,141 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-11-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenVirtualMachineSkuAvailability(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    compute.ResourceSku
+		expected virtualMachineSkuAvailability
+	}{
+		{
+			name: "no zones",
+			input: compute.ResourceSku{
+				Name: utils.String("Standard_B1s"),
+				LocationInfo: &[]compute.ResourceSkuLocationInfo{
+					{
+						Location: utils.String("WestEurope"),
+					},
+				},
+			},
+			expected: virtualMachineSkuAvailability{
+				zones: []string{},
+			},
+		},
+		{
+			name: "zones with a zonal restriction",
+			input: compute.ResourceSku{
+				Name: utils.String("Standard_D2s_v3"),
+				LocationInfo: &[]compute.ResourceSkuLocationInfo{
+					{
+						Location: utils.String("WestEurope"),
+						Zones:    &[]string{"3", "1", "2"},
+					},
+				},
+				Restrictions: &[]compute.ResourceSkuRestrictions{
+					{
+						Type: compute.ResourceSkuRestrictionsTypeZone,
+						RestrictionInfo: &compute.ResourceSkuRestrictionInfo{
+							Zones: &[]string{"2"},
+						},
+					},
+				},
+			},
+			expected: virtualMachineSkuAvailability{
+				zones: []string{"1", "3"},
+			},
+		},
+		{
+			name: "restricted in the location",
+			input: compute.ResourceSku{
+				Name: utils.String("Standard_M416ms_v2"),
+				Restrictions: &[]compute.ResourceSkuRestrictions{
+					{
+						Type:       compute.ResourceSkuRestrictionsTypeLocation,
+						ReasonCode: compute.ResourceSkuRestrictionsReasonCodeNotAvailableForSubscription,
+					},
+				},
+			},
+			expected: virtualMachineSkuAvailability{
+				restricted: true,
+				zones:      []string{},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := flattenVirtualMachineSkuAvailability(v.input, "westeurope")
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestCheckVirtualMachineSkuAvailability(t *testing.T) {
+	skus := map[string]virtualMachineSkuAvailability{
+		"standard_b1s": {
+			zones: []string{},
+		},
+		"standard_d2s_v3": {
+			zones: []string{"1", "3"},
+		},
+		"standard_m416ms_v2": {
+			restricted: true,
+			zones:      []string{"1", "2", "3"},
+		},
+	}
+
+	testData := []struct {
+		size  string
+		zones []string
+		valid bool
+	}{
+		{
+			size:  "Standard_B1s",
+			valid: true,
+		},
+		{
+			size:  "Standard_B1s",
+			zones: []string{"1"},
+			valid: false,
+		},
+		{
+			size:  "Standard_D2s_v3",
+			zones: []string{"1", "3"},
+			valid: true,
+		},
+		{
+			size:  "Standard_D2s_v3",
+			zones: []string{"2"},
+			valid: false,
+		},
+		{
+			size:  "Standard_M416ms_v2",
+			valid: false,
+		},
+		{
+			size:  "Standard_Z99",
+			valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q in Zones %+v", v.size, v.zones)
+
+		err := checkVirtualMachineSkuAvailability(skus, "size", v.size, "westeurope", v.zones)
+		if v.valid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.size, err)
+		}
+		if !v.valid && err == nil {
+			t.Fatalf("expected %q to be invalid but it was valid", v.size)
+		}
+	}
+}
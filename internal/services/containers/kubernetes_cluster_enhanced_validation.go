@@ -0,0 +1,114 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	legacy "github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-08-01/containerservice"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// kubernetesVersions caches the Kubernetes Versions supported by AKS within each Subscription/Location
+var kubernetesVersions = &sdk.CapabilityCache{}
+
+func (r Registration) EnhancedValidations() []sdk.EnhancedValidation {
+	return []sdk.EnhancedValidation{
+		{
+			ResourceType: "azurerm_kubernetes_cluster",
+			Description:  "the Kubernetes Versions used by the Kubernetes Cluster are supported in the Location",
+			Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+				d := metadata.ResourceDiff
+				if d.Id() != "" && !d.HasChanges("location", "kubernetes_version", "default_node_pool.0.orchestrator_version") {
+					return nil
+				}
+				if !d.NewValueKnown("location") {
+					return nil
+				}
+
+				versions := make(map[string]string)
+				for _, field := range []string{"kubernetes_version", "default_node_pool.0.orchestrator_version"} {
+					if d.NewValueKnown(field) {
+						versions[field] = d.Get(field).(string)
+					}
+				}
+
+				return validateKubernetesVersionsAreSupported(ctx, metadata, d.Get("location").(string), versions)
+			},
+		},
+	}
+}
+
+func validateKubernetesVersionsAreSupported(ctx context.Context, metadata sdk.ResourceMetaData, locationName string, fields map[string]string) error {
+	if locationName == "" {
+		return nil
+	}
+
+	locationName = location.Normalize(locationName)
+	key := fmt.Sprintf("%s/%s", metadata.Client.Account.SubscriptionId, locationName)
+	raw, err := kubernetesVersions.Get(key, func() (interface{}, error) {
+		return retrieveKubernetesVersions(ctx, metadata.Client.Containers.ServicesClient, locationName)
+	})
+	if err != nil {
+		metadata.Logger.Warnf("%+v - skipping Enhanced Validation of the Kubernetes Version", err)
+		return nil
+	}
+
+	return checkKubernetesVersionsAreSupported(raw.([]string), locationName, fields)
+}
+
+// checkKubernetesVersionsAreSupported checks that the Kubernetes Version specified for each field (keyed by the
+// field name) is within the versions supported in the Location
+func checkKubernetesVersionsAreSupported(supported []string, locationName string, fields map[string]string) error {
+	// sorted for consistent output
+	names := make([]string, 0)
+	for k := range fields {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := fields[name]
+		if value == "" || kubernetesVersionIsSupported(supported, value) {
+			continue
+		}
+
+		return fmt.Errorf("the Kubernetes Version %q specified for `%s` is not supported in the Location %q - the supported versions are: %s", value, name, locationName, strings.Join(supported, ", "))
+	}
+
+	return nil
+}
+
+// kubernetesVersionIsSupported returns whether the specified version is supported - which can either be
+// a patch version (e.g. `1.24.6`) or an alias for the latest patch version of a minor version (e.g. `1.24`)
+func kubernetesVersionIsSupported(supported []string, input string) bool {
+	for _, v := range supported {
+		if v == input || strings.HasPrefix(v, input+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func retrieveKubernetesVersions(ctx context.Context, client *legacy.ContainerServicesClient, locationName string) ([]string, error) {
+	resp, err := client.ListOrchestrators(ctx, locationName, "managedClusters")
+	if err != nil {
+		return nil, fmt.Errorf("listing Kubernetes Versions: %+v", err)
+	}
+
+	versions := make([]string, 0)
+	if props := resp.OrchestratorVersionProfileProperties; props != nil && props.Orchestrators != nil {
+		for _, v := range *props.Orchestrators {
+			if v.OrchestratorType == nil || !strings.EqualFold(*v.OrchestratorType, "Kubernetes") || v.OrchestratorVersion == nil {
+				continue
+			}
+
+			versions = append(versions, *v.OrchestratorVersion)
+		}
+	}
+
+	return versions, nil
+}
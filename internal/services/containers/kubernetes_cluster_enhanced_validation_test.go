@@ -0,0 +1,116 @@
+package containers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKubernetesVersionIsSupported(t *testing.T) {
+	supported := []string{"1.23.12", "1.24.6", "1.24.9", "1.25.5"}
+
+	testData := []struct {
+		version string
+		valid   bool
+	}{
+		{
+			version: "1.24.6",
+			valid:   true,
+		},
+		{
+			version: "1.24",
+			valid:   true,
+		},
+		{
+			version: "1.24.7",
+			valid:   false,
+		},
+		{
+			version: "1.2",
+			valid:   false,
+		},
+		{
+			version: "1.22",
+			valid:   false,
+		},
+		{
+			version: "1.25.5.1",
+			valid:   false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.version)
+
+		if actual := kubernetesVersionIsSupported(supported, v.version); actual != v.valid {
+			t.Fatalf("expected %q to be supported: %t but got %t", v.version, v.valid, actual)
+		}
+	}
+}
+
+func TestCheckKubernetesVersionsAreSupported(t *testing.T) {
+	supported := []string{"1.24.6", "1.24.9", "1.25.5"}
+
+	testData := []struct {
+		name          string
+		fields        map[string]string
+		expectedField string
+	}{
+		{
+			name: "none specified",
+			fields: map[string]string{
+				"kubernetes_version":                       "",
+				"default_node_pool.0.orchestrator_version": "",
+			},
+		},
+		{
+			name: "supported",
+			fields: map[string]string{
+				"kubernetes_version":                       "1.25",
+				"default_node_pool.0.orchestrator_version": "1.24.9",
+			},
+		},
+		{
+			name: "unsupported control plane version",
+			fields: map[string]string{
+				"kubernetes_version":                       "1.23",
+				"default_node_pool.0.orchestrator_version": "1.24.9",
+			},
+			expectedField: "kubernetes_version",
+		},
+		{
+			name: "unsupported node pool version",
+			fields: map[string]string{
+				"kubernetes_version":                       "1.25.5",
+				"default_node_pool.0.orchestrator_version": "1.24.7",
+			},
+			expectedField: "default_node_pool.0.orchestrator_version",
+		},
+		{
+			name: "both unsupported",
+			fields: map[string]string{
+				"kubernetes_version":                       "1.22",
+				"default_node_pool.0.orchestrator_version": "1.22",
+			},
+			expectedField: "default_node_pool.0.orchestrator_version",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := checkKubernetesVersionsAreSupported(supported, "westeurope", v.fields)
+		if v.expectedField == "" {
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Fatalf("expected an error for `%s` but didn't get one", v.expectedField)
+		}
+		if expected := "`" + v.expectedField + "`"; !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected the error to reference %s but got: %+v", expected, err)
+		}
+	}
+}
@@ -67,3 +67,23 @@ func ClusterID(input string) (*ClusterId, error) {
 
 	return &resourceId, nil
 }
+
+// ClusterIDFromNames builds an ClusterId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{managedClusterName}", using the specified Subscription ID when this is omitted
+func ClusterIDFromNames(subscriptionId, input string) (*ClusterId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{managedClusterName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{managedClusterName}")
+		}
+	}
+
+	resourceId := NewClusterID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func ContainerConnectedRegistryID(input string) (*ContainerConnectedRegistryId,
 
 	return &resourceId, nil
 }
+
+// ContainerConnectedRegistryIDFromNames builds an ContainerConnectedRegistryId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{registryName}/{connectedRegistryName}", using the specified Subscription ID when this is omitted
+func ContainerConnectedRegistryIDFromNames(subscriptionId, input string) (*ContainerConnectedRegistryId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{registryName}/{connectedRegistryName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{registryName}/{connectedRegistryName}")
+		}
+	}
+
+	resourceId := NewContainerConnectedRegistryID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
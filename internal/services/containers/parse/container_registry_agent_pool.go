@@ -73,3 +73,23 @@ func ContainerRegistryAgentPoolID(input string) (*ContainerRegistryAgentPoolId,
 
 	return &resourceId, nil
 }
+
+// ContainerRegistryAgentPoolIDFromNames builds an ContainerRegistryAgentPoolId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{registryName}/{agentPoolName}", using the specified Subscription ID when this is omitted
+func ContainerRegistryAgentPoolIDFromNames(subscriptionId, input string) (*ContainerRegistryAgentPoolId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{registryName}/{agentPoolName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{registryName}/{agentPoolName}")
+		}
+	}
+
+	resourceId := NewContainerRegistryAgentPoolID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
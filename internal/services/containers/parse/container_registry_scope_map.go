@@ -73,3 +73,23 @@ func ContainerRegistryScopeMapID(input string) (*ContainerRegistryScopeMapId, er
 
 	return &resourceId, nil
 }
+
+// ContainerRegistryScopeMapIDFromNames builds an ContainerRegistryScopeMapId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{registryName}/{scopeMapName}", using the specified Subscription ID when this is omitted
+func ContainerRegistryScopeMapIDFromNames(subscriptionId, input string) (*ContainerRegistryScopeMapId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{registryName}/{scopeMapName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{registryName}/{scopeMapName}")
+		}
+	}
+
+	resourceId := NewContainerRegistryScopeMapID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func ContainerRegistryTaskID(input string) (*ContainerRegistryTaskId, error) {
 
 	return &resourceId, nil
 }
+
+// ContainerRegistryTaskIDFromNames builds an ContainerRegistryTaskId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{registryName}/{taskName}", using the specified Subscription ID when this is omitted
+func ContainerRegistryTaskIDFromNames(subscriptionId, input string) (*ContainerRegistryTaskId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{registryName}/{taskName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{registryName}/{taskName}")
+		}
+	}
+
+	resourceId := NewContainerRegistryTaskID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func ContainerRegistryTaskScheduleID(input string) (*ContainerRegistryTaskSchedu
 
 	return &resourceId, nil
 }
+
+// ContainerRegistryTaskScheduleIDFromNames builds an ContainerRegistryTaskScheduleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{registryName}/{taskName}/{scheduleName}", using the specified Subscription ID when this is omitted
+func ContainerRegistryTaskScheduleIDFromNames(subscriptionId, input string) (*ContainerRegistryTaskScheduleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{registryName}/{taskName}/{scheduleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{registryName}/{taskName}/{scheduleName}")
+		}
+	}
+
+	resourceId := NewContainerRegistryTaskScheduleID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func ContainerRegistryTokenID(input string) (*ContainerRegistryTokenId, error) {
 
 	return &resourceId, nil
 }
+
+// ContainerRegistryTokenIDFromNames builds an ContainerRegistryTokenId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{registryName}/{tokenName}", using the specified Subscription ID when this is omitted
+func ContainerRegistryTokenIDFromNames(subscriptionId, input string) (*ContainerRegistryTokenId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{registryName}/{tokenName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{registryName}/{tokenName}")
+		}
+	}
+
+	resourceId := NewContainerRegistryTokenID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
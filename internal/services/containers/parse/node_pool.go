@@ -73,3 +73,23 @@ func NodePoolID(input string) (*NodePoolId, error) {
 
 	return &resourceId, nil
 }
+
+// NodePoolIDFromNames builds an NodePoolId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{managedClusterName}/{agentPoolName}", using the specified Subscription ID when this is omitted
+func NodePoolIDFromNames(subscriptionId, input string) (*NodePoolId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{managedClusterName}/{agentPoolName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{managedClusterName}/{agentPoolName}")
+		}
+	}
+
+	resourceId := NewNodePoolID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
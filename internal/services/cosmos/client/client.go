@@ -2,6 +2,7 @@ package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	documentdbPreview "github.com/Azure/azure-sdk-for-go/services/preview/cosmos-db/mgmt/2021-11-15-preview/documentdb"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-05-15/sqldedicatedgateway"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -13,12 +14,15 @@ type Client struct {
 	DatabaseClient                   *documentdb.DatabaseAccountsClient
 	GremlinClient                    *documentdb.GremlinResourcesClient
 	MongoDbClient                    *documentdb.MongoDBResourcesClient
+	MongoRBACClient                  *documentdbPreview.MongoDBResourcesClient
 	NotebookWorkspaceClient          *documentdb.NotebookWorkspacesClient
 	RestorableDatabaseAccountsClient *documentdb.RestorableDatabaseAccountsClient
 	SqlDedicatedGatewayClient        *sqldedicatedgateway.SqlDedicatedGatewayClient
 	SqlClient                        *documentdb.SQLResourcesClient
 	SqlResourceClient                *documentdb.SQLResourcesClient
 	TableClient                      *documentdb.TableResourcesClient
+
+	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -40,6 +44,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	mongoDbClient := documentdb.NewMongoDBResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&mongoDbClient.Client, o.ResourceManagerAuthorizer)
 
+	mongoRBACClient := documentdbPreview.NewMongoDBResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&mongoRBACClient.Client, o.ResourceManagerAuthorizer)
+
 	notebookWorkspaceClient := documentdb.NewNotebookWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&notebookWorkspaceClient.Client, o.ResourceManagerAuthorizer)
 
@@ -65,11 +72,27 @@ func NewClient(o *common.ClientOptions) *Client {
 		DatabaseClient:                   &databaseClient,
 		GremlinClient:                    &gremlinClient,
 		MongoDbClient:                    &mongoDbClient,
+		MongoRBACClient:                  &mongoRBACClient,
 		NotebookWorkspaceClient:          &notebookWorkspaceClient,
 		RestorableDatabaseAccountsClient: &restorableDatabaseAccountsClient,
 		SqlDedicatedGatewayClient:        &sqlDedicatedGatewayClient,
 		SqlClient:                        &sqlClient,
 		SqlResourceClient:                &sqlResourceClient,
 		TableClient:                      &tableClient,
+
+		options: o,
 	}
 }
+
+// SqlResourceClientForSubscription returns a SQLResourcesClient for the specified Subscription, which uses the
+// same credentials and configuration as the Provider
+func (c Client) SqlResourceClientForSubscription(subscriptionId string) *documentdb.SQLResourcesClient {
+	if subscriptionId == c.SqlResourceClient.SubscriptionID {
+		return c.SqlResourceClient
+	}
+
+	o := c.options.ForSubscription(subscriptionId)
+	sqlResourceClient := documentdb.NewSQLResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&sqlResourceClient.Client, o.ResourceManagerAuthorizer)
+	return &sqlResourceClient
+}
@@ -330,6 +330,7 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 								"EnableTable",
 								"EnableServerless",
 								"EnableMongo",
+								"EnableMongoRoleBasedAccessControl",
 								"MongoDBv3.4",
 								"mongoEnableDocLevelTTL",
 								"DisableRateLimitingResponses",
@@ -0,0 +1,380 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/cosmos-db/mgmt/2021-11-15-preview/documentdb"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CosmosDbMongoRoleDefinitionModel struct {
+	CosmosMongoDatabaseId string                        `tfschema:"cosmos_mongo_database_id"`
+	RoleName              string                        `tfschema:"role_name"`
+	InheritedRoleNames    []string                      `tfschema:"inherited_role_names"`
+	Privileges            []CosmosDbMongoPrivilegeModel `tfschema:"privilege"`
+}
+
+type CosmosDbMongoPrivilegeModel struct {
+	Actions  []string                              `tfschema:"actions"`
+	Resource []CosmosDbMongoPrivilegeResourceModel `tfschema:"resource"`
+}
+
+type CosmosDbMongoPrivilegeResourceModel struct {
+	CollectionName string `tfschema:"collection_name"`
+	DbName         string `tfschema:"db_name"`
+}
+
+type CosmosDbMongoRoleDefinitionResource struct{}
+
+var _ sdk.ResourceWithUpdate = CosmosDbMongoRoleDefinitionResource{}
+
+func (r CosmosDbMongoRoleDefinitionResource) ResourceType() string {
+	return "azurerm_cosmosdb_mongo_role_definition"
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) ModelObject() interface{} {
+	return &CosmosDbMongoRoleDefinitionModel{}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.MongodbRoleDefinitionID
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cosmos_mongo_database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.MongodbDatabaseID,
+		},
+
+		"role_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"inherited_role_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"privilege": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"actions": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"resource": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"collection_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"db_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			var model CosmosDbMongoRoleDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := parse.MongodbDatabaseID(model.CosmosMongoDatabaseId)
+			if err != nil {
+				return err
+			}
+
+			// the Role Definition is named `{databaseName}.{roleName}` within the Database Account
+			id := parse.NewMongodbRoleDefinitionID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.DatabaseAccountName, fmt.Sprintf("%s.%s", databaseId.Name, model.RoleName))
+
+			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+
+			existing, err := client.GetMongoRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := documentdb.MongoRoleDefinitionCreateUpdateParameters{
+				MongoRoleDefinitionResource: &documentdb.MongoRoleDefinitionResource{
+					RoleName:     utils.String(model.RoleName),
+					Type:         documentdb.MongoRoleDefinitionTypeCustomRole,
+					DatabaseName: utils.String(databaseId.Name),
+					Privileges:   expandCosmosDbMongoPrivileges(model.Privileges),
+					Roles:        expandCosmosDbMongoRoles(databaseId.Name, model.InheritedRoleNames),
+				},
+			}
+
+			future, err := client.CreateUpdateMongoRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName, parameters)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := parse.MongodbRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetMongoRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CosmosDbMongoRoleDefinitionModel{}
+			if props := resp.MongoRoleDefinitionResource; props != nil {
+				databaseName := ""
+				if props.DatabaseName != nil {
+					databaseName = *props.DatabaseName
+				}
+
+				state.CosmosMongoDatabaseId = parse.NewMongodbDatabaseID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, databaseName).ID()
+				state.RoleName = utils.NormalizeNilableString(props.RoleName)
+				state.InheritedRoleNames = flattenCosmosDbMongoRoles(props.Roles)
+				state.Privileges = flattenCosmosDbMongoPrivileges(props.Privileges)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := parse.MongodbRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CosmosDbMongoRoleDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := parse.MongodbDatabaseID(model.CosmosMongoDatabaseId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+
+			existing, err := client.GetMongoRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.MongoRoleDefinitionResource == nil {
+				return fmt.Errorf("retrieving %s: properties was nil", *id)
+			}
+
+			props := existing.MongoRoleDefinitionResource
+			if metadata.ResourceData.HasChange("inherited_role_names") {
+				props.Roles = expandCosmosDbMongoRoles(databaseId.Name, model.InheritedRoleNames)
+			}
+
+			if metadata.ResourceData.HasChange("privilege") {
+				props.Privileges = expandCosmosDbMongoPrivileges(model.Privileges)
+			}
+
+			parameters := documentdb.MongoRoleDefinitionCreateUpdateParameters{
+				MongoRoleDefinitionResource: props,
+			}
+
+			future, err := client.CreateUpdateMongoRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName, parameters)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := parse.MongodbRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+
+			future, err := client.DeleteMongoRoleDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCosmosDbMongoRoles(databaseName string, input []string) *[]documentdb.Role {
+	results := make([]documentdb.Role, 0)
+
+	for _, item := range input {
+		results = append(results, documentdb.Role{
+			Db:   utils.String(databaseName),
+			Role: utils.String(item),
+		})
+	}
+
+	return &results
+}
+
+func flattenCosmosDbMongoRoles(input *[]documentdb.Role) []string {
+	results := make([]string, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.Role != nil {
+			results = append(results, *item.Role)
+		}
+	}
+
+	return results
+}
+
+func expandCosmosDbMongoPrivileges(input []CosmosDbMongoPrivilegeModel) *[]documentdb.Privilege {
+	results := make([]documentdb.Privilege, 0)
+
+	for _, item := range input {
+		actions := item.Actions
+		privilege := documentdb.Privilege{
+			Actions: &actions,
+		}
+
+		if len(item.Resource) > 0 {
+			resource := item.Resource[0]
+			privilege.Resource = &documentdb.PrivilegeResource{}
+
+			if resource.CollectionName != "" {
+				privilege.Resource.Collection = utils.String(resource.CollectionName)
+			}
+
+			if resource.DbName != "" {
+				privilege.Resource.Db = utils.String(resource.DbName)
+			}
+		}
+
+		results = append(results, privilege)
+	}
+
+	return &results
+}
+
+func flattenCosmosDbMongoPrivileges(input *[]documentdb.Privilege) []CosmosDbMongoPrivilegeModel {
+	results := make([]CosmosDbMongoPrivilegeModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		privilege := CosmosDbMongoPrivilegeModel{
+			Actions: make([]string, 0),
+		}
+
+		if item.Actions != nil {
+			privilege.Actions = *item.Actions
+		}
+
+		if resource := item.Resource; resource != nil {
+			privilege.Resource = []CosmosDbMongoPrivilegeResourceModel{
+				{
+					CollectionName: utils.NormalizeNilableString(resource.Collection),
+					DbName:         utils.NormalizeNilableString(resource.Db),
+				},
+			}
+		}
+
+		results = append(results, privilege)
+	}
+
+	return results
+}
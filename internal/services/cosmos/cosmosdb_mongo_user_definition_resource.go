@@ -0,0 +1,257 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/cosmos-db/mgmt/2021-11-15-preview/documentdb"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CosmosDbMongoUserDefinitionModel struct {
+	CosmosMongoDatabaseId string   `tfschema:"cosmos_mongo_database_id"`
+	Username              string   `tfschema:"username"`
+	Password              string   `tfschema:"password"`
+	InheritedRoleNames    []string `tfschema:"inherited_role_names"`
+}
+
+type CosmosDbMongoUserDefinitionResource struct{}
+
+var _ sdk.ResourceWithUpdate = CosmosDbMongoUserDefinitionResource{}
+
+func (r CosmosDbMongoUserDefinitionResource) ResourceType() string {
+	return "azurerm_cosmosdb_mongo_user_definition"
+}
+
+func (r CosmosDbMongoUserDefinitionResource) ModelObject() interface{} {
+	return &CosmosDbMongoUserDefinitionModel{}
+}
+
+func (r CosmosDbMongoUserDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.MongodbUserDefinitionID
+}
+
+func (r CosmosDbMongoUserDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cosmos_mongo_database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.MongodbDatabaseID,
+		},
+
+		"username": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"password": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"inherited_role_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r CosmosDbMongoUserDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CosmosDbMongoUserDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			var model CosmosDbMongoUserDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := parse.MongodbDatabaseID(model.CosmosMongoDatabaseId)
+			if err != nil {
+				return err
+			}
+
+			// the User Definition is named `{databaseName}.{username}` within the Database Account
+			id := parse.NewMongodbUserDefinitionID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.DatabaseAccountName, fmt.Sprintf("%s.%s", databaseId.Name, model.Username))
+
+			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+
+			existing, err := client.GetMongoUserDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := documentdb.MongoUserDefinitionCreateUpdateParameters{
+				MongoUserDefinitionResource: &documentdb.MongoUserDefinitionResource{
+					UserName:     utils.String(model.Username),
+					Password:     utils.String(model.Password),
+					DatabaseName: utils.String(databaseId.Name),
+					CustomData:   utils.String(""),
+					Mechanisms:   utils.String("SCRAM-SHA-256"),
+					Roles:        expandCosmosDbMongoRoles(databaseId.Name, model.InheritedRoleNames),
+				},
+			}
+
+			future, err := client.CreateUpdateMongoUserDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName, parameters)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbMongoUserDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := parse.MongodbUserDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetMongoUserDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CosmosDbMongoUserDefinitionModel{
+				// the password isn't returned by the API
+				Password: metadata.ResourceData.Get("password").(string),
+			}
+
+			if props := resp.MongoUserDefinitionResource; props != nil {
+				databaseName := ""
+				if props.DatabaseName != nil {
+					databaseName = *props.DatabaseName
+				}
+
+				state.CosmosMongoDatabaseId = parse.NewMongodbDatabaseID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, databaseName).ID()
+				state.Username = utils.NormalizeNilableString(props.UserName)
+				state.InheritedRoleNames = flattenCosmosDbMongoRoles(props.Roles)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbMongoUserDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := parse.MongodbUserDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CosmosDbMongoUserDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := parse.MongodbDatabaseID(model.CosmosMongoDatabaseId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+
+			existing, err := client.GetMongoUserDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.MongoUserDefinitionResource == nil {
+				return fmt.Errorf("retrieving %s: properties was nil", *id)
+			}
+
+			props := existing.MongoUserDefinitionResource
+
+			// the password is always required since it's not returned by the API
+			props.Password = utils.String(model.Password)
+
+			if metadata.ResourceData.HasChange("inherited_role_names") {
+				props.Roles = expandCosmosDbMongoRoles(databaseId.Name, model.InheritedRoleNames)
+			}
+
+			parameters := documentdb.MongoUserDefinitionCreateUpdateParameters{
+				MongoUserDefinitionResource: props,
+			}
+
+			future, err := client.CreateUpdateMongoUserDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName, parameters)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbMongoUserDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := parse.MongodbUserDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+
+			future, err := client.DeleteMongoUserDefinition(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
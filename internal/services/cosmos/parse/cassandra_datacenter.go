@@ -73,3 +73,23 @@ func CassandraDatacenterID(input string) (*CassandraDatacenterId, error) {
 
 	return &resourceId, nil
 }
+
+// CassandraDatacenterIDFromNames builds an CassandraDatacenterId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{cassandraClusterName}/{dataCenterName}", using the specified Subscription ID when this is omitted
+func CassandraDatacenterIDFromNames(subscriptionId, input string) (*CassandraDatacenterId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{cassandraClusterName}/{dataCenterName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{cassandraClusterName}/{dataCenterName}")
+		}
+	}
+
+	resourceId := NewCassandraDatacenterID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func CassandraKeyspaceID(input string) (*CassandraKeyspaceId, error) {
 
 	return &resourceId, nil
 }
+
+// CassandraKeyspaceIDFromNames builds an CassandraKeyspaceId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{name}", using the specified Subscription ID when this is omitted
+func CassandraKeyspaceIDFromNames(subscriptionId, input string) (*CassandraKeyspaceId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{name}")
+		}
+	}
+
+	resourceId := NewCassandraKeyspaceID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func CassandraTableID(input string) (*CassandraTableId, error) {
 
 	return &resourceId, nil
 }
+
+// CassandraTableIDFromNames builds an CassandraTableId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{cassandraKeyspaceName}/{tableName}", using the specified Subscription ID when this is omitted
+func CassandraTableIDFromNames(subscriptionId, input string) (*CassandraTableId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{cassandraKeyspaceName}/{tableName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{cassandraKeyspaceName}/{tableName}")
+		}
+	}
+
+	resourceId := NewCassandraTableID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
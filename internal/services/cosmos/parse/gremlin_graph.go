@@ -79,3 +79,23 @@ func GremlinGraphID(input string) (*GremlinGraphId, error) {
 
 	return &resourceId, nil
 }
+
+// GremlinGraphIDFromNames builds an GremlinGraphId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{gremlinDatabaseName}/{graphName}", using the specified Subscription ID when this is omitted
+func GremlinGraphIDFromNames(subscriptionId, input string) (*GremlinGraphId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{gremlinDatabaseName}/{graphName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{gremlinDatabaseName}/{graphName}")
+		}
+	}
+
+	resourceId := NewGremlinGraphID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
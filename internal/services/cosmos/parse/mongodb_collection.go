@@ -79,3 +79,23 @@ func MongodbCollectionID(input string) (*MongodbCollectionId, error) {
 
 	return &resourceId, nil
 }
+
+// MongodbCollectionIDFromNames builds an MongodbCollectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{mongodbDatabaseName}/{collectionName}", using the specified Subscription ID when this is omitted
+func MongodbCollectionIDFromNames(subscriptionId, input string) (*MongodbCollectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{mongodbDatabaseName}/{collectionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{mongodbDatabaseName}/{collectionName}")
+		}
+	}
+
+	resourceId := NewMongodbCollectionID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
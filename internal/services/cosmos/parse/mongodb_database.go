@@ -73,3 +73,23 @@ func MongodbDatabaseID(input string) (*MongodbDatabaseId, error) {
 
 	return &resourceId, nil
 }
+
+// MongodbDatabaseIDFromNames builds an MongodbDatabaseId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{name}", using the specified Subscription ID when this is omitted
+func MongodbDatabaseIDFromNames(subscriptionId, input string) (*MongodbDatabaseId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{name}")
+		}
+	}
+
+	resourceId := NewMongodbDatabaseID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -65,3 +65,23 @@ func RestorableDatabaseAccountID(input string) (*RestorableDatabaseAccountId, er
 
 	return &resourceId, nil
 }
+
+// RestorableDatabaseAccountIDFromNames builds an RestorableDatabaseAccountId from the names of each segment in the format
+// "[{subscriptionId}/]{locationName}/{name}", using the specified Subscription ID when this is omitted
+func RestorableDatabaseAccountIDFromNames(subscriptionId, input string) (*RestorableDatabaseAccountId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{locationName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{locationName}/{name}")
+		}
+	}
+
+	resourceId := NewRestorableDatabaseAccountID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
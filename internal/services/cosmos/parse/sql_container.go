@@ -79,3 +79,23 @@ func SqlContainerID(input string) (*SqlContainerId, error) {
 
 	return &resourceId, nil
 }
+
+// SqlContainerIDFromNames builds an SqlContainerId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{sqlDatabaseName}/{containerName}", using the specified Subscription ID when this is omitted
+func SqlContainerIDFromNames(subscriptionId, input string) (*SqlContainerId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{sqlDatabaseName}/{containerName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{sqlDatabaseName}/{containerName}")
+		}
+	}
+
+	resourceId := NewSqlContainerID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -85,3 +85,23 @@ func SqlFunctionID(input string) (*SqlFunctionId, error) {
 
 	return &resourceId, nil
 }
+
+// SqlFunctionIDFromNames builds an SqlFunctionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{sqlDatabaseName}/{containerName}/{userDefinedFunctionName}", using the specified Subscription ID when this is omitted
+func SqlFunctionIDFromNames(subscriptionId, input string) (*SqlFunctionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 5 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 6 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{sqlDatabaseName}/{containerName}/{userDefinedFunctionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{sqlDatabaseName}/{containerName}/{userDefinedFunctionName}")
+		}
+	}
+
+	resourceId := NewSqlFunctionID(names[0], names[1], names[2], names[3], names[4], names[5])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func SqlRoleDefinitionID(input string) (*SqlRoleDefinitionId, error) {
 
 	return &resourceId, nil
 }
+
+// SqlRoleDefinitionIDFromNames builds an SqlRoleDefinitionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{name}", using the specified Subscription ID when this is omitted
+func SqlRoleDefinitionIDFromNames(subscriptionId, input string) (*SqlRoleDefinitionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{name}")
+		}
+	}
+
+	resourceId := NewSqlRoleDefinitionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
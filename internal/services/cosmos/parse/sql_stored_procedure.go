@@ -85,3 +85,23 @@ func SqlStoredProcedureID(input string) (*SqlStoredProcedureId, error) {
 
 	return &resourceId, nil
 }
+
+// SqlStoredProcedureIDFromNames builds an SqlStoredProcedureId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{sqlDatabaseName}/{containerName}/{storedProcedureName}", using the specified Subscription ID when this is omitted
+func SqlStoredProcedureIDFromNames(subscriptionId, input string) (*SqlStoredProcedureId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 5 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 6 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{sqlDatabaseName}/{containerName}/{storedProcedureName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{sqlDatabaseName}/{containerName}/{storedProcedureName}")
+		}
+	}
+
+	resourceId := NewSqlStoredProcedureID(names[0], names[1], names[2], names[3], names[4], names[5])
+	return &resourceId, nil
+}
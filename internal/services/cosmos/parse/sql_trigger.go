@@ -85,3 +85,23 @@ func SqlTriggerID(input string) (*SqlTriggerId, error) {
 
 	return &resourceId, nil
 }
+
+// SqlTriggerIDFromNames builds an SqlTriggerId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{sqlDatabaseName}/{containerName}/{triggerName}", using the specified Subscription ID when this is omitted
+func SqlTriggerIDFromNames(subscriptionId, input string) (*SqlTriggerId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 5 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 6 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{sqlDatabaseName}/{containerName}/{triggerName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{databaseAccountName}/{sqlDatabaseName}/{containerName}/{triggerName}")
+		}
+	}
+
+	resourceId := NewSqlTriggerID(names[0], names[1], names[2], names[3], names[4], names[5])
+	return &resourceId, nil
+}
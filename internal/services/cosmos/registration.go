@@ -22,6 +22,8 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		CosmosDbMongoRoleDefinitionResource{},
+		CosmosDbMongoUserDefinitionResource{},
 		CosmosDbSqlDedicatedGatewayResource{},
 	}
 }
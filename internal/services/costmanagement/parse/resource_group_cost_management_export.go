@@ -67,3 +67,23 @@ func ResourceGroupCostManagementExportID(input string) (*ResourceGroupCostManage
 
 	return &resourceId, nil
 }
+
+// ResourceGroupCostManagementExportIDFromNames builds an ResourceGroupCostManagementExportId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{exportName}", using the specified Subscription ID when this is omitted
+func ResourceGroupCostManagementExportIDFromNames(subscriptionId, input string) (*ResourceGroupCostManagementExportId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{exportName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{exportName}")
+		}
+	}
+
+	resourceId := NewResourceGroupCostManagementExportID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
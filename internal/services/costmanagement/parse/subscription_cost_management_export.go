@@ -59,3 +59,23 @@ func SubscriptionCostManagementExportID(input string) (*SubscriptionCostManageme
 
 	return &resourceId, nil
 }
+
+// SubscriptionCostManagementExportIDFromNames builds an SubscriptionCostManagementExportId from the names of each segment in the format
+// "[{subscriptionId}/]{exportName}", using the specified Subscription ID when this is omitted
+func SubscriptionCostManagementExportIDFromNames(subscriptionId, input string) (*SubscriptionCostManagementExportId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 1 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{exportName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{exportName}")
+		}
+	}
+
+	resourceId := NewSubscriptionCostManagementExportID(names[0], names[1])
+	return &resourceId, nil
+}
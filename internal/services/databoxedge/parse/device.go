@@ -67,3 +67,23 @@ func DeviceID(input string) (*DeviceId, error) {
 
 	return &resourceId, nil
 }
+
+// DeviceIDFromNames builds an DeviceId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{dataBoxEdgeDeviceName}", using the specified Subscription ID when this is omitted
+func DeviceIDFromNames(subscriptionId, input string) (*DeviceId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{dataBoxEdgeDeviceName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{dataBoxEdgeDeviceName}")
+		}
+	}
+
+	resourceId := NewDeviceID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
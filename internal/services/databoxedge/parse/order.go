@@ -73,3 +73,23 @@ func OrderID(input string) (*OrderId, error) {
 
 	return &resourceId, nil
 }
+
+// OrderIDFromNames builds an OrderId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{dataBoxEdgeDeviceName}/{name}", using the specified Subscription ID when this is omitted
+func OrderIDFromNames(subscriptionId, input string) (*OrderId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{dataBoxEdgeDeviceName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{dataBoxEdgeDeviceName}/{name}")
+		}
+	}
+
+	resourceId := NewOrderID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -67,3 +67,23 @@ func DatadogMonitorID(input string) (*DatadogMonitorId, error) {
 
 	return &resourceId, nil
 }
+
+// DatadogMonitorIDFromNames builds an DatadogMonitorId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{monitorName}", using the specified Subscription ID when this is omitted
+func DatadogMonitorIDFromNames(subscriptionId, input string) (*DatadogMonitorId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{monitorName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{monitorName}")
+		}
+	}
+
+	resourceId := NewDatadogMonitorID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
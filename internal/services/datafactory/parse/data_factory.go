@@ -67,3 +67,23 @@ func DataFactoryID(input string) (*DataFactoryId, error) {
 
 	return &resourceId, nil
 }
+
+// DataFactoryIDFromNames builds an DataFactoryId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{factoryName}", using the specified Subscription ID when this is omitted
+func DataFactoryIDFromNames(subscriptionId, input string) (*DataFactoryId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{factoryName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{factoryName}")
+		}
+	}
+
+	resourceId := NewDataFactoryID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
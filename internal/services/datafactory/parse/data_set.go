@@ -73,3 +73,23 @@ func DataSetID(input string) (*DataSetId, error) {
 
 	return &resourceId, nil
 }
+
+// DataSetIDFromNames builds an DataSetId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{factoryName}/{name}", using the specified Subscription ID when this is omitted
+func DataSetIDFromNames(subscriptionId, input string) (*DataSetId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{factoryName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{factoryName}/{name}")
+		}
+	}
+
+	resourceId := NewDataSetID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
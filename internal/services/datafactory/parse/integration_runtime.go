@@ -73,3 +73,23 @@ func IntegrationRuntimeID(input string) (*IntegrationRuntimeId, error) {
 
 	return &resourceId, nil
 }
+
+// IntegrationRuntimeIDFromNames builds an IntegrationRuntimeId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{factoryName}/{name}", using the specified Subscription ID when this is omitted
+func IntegrationRuntimeIDFromNames(subscriptionId, input string) (*IntegrationRuntimeId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{factoryName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{factoryName}/{name}")
+		}
+	}
+
+	resourceId := NewIntegrationRuntimeID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
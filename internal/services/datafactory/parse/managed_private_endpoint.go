@@ -79,3 +79,23 @@ func ManagedPrivateEndpointID(input string) (*ManagedPrivateEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// ManagedPrivateEndpointIDFromNames builds an ManagedPrivateEndpointId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{factoryName}/{managedVirtualNetworkName}/{name}", using the specified Subscription ID when this is omitted
+func ManagedPrivateEndpointIDFromNames(subscriptionId, input string) (*ManagedPrivateEndpointId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{factoryName}/{managedVirtualNetworkName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{factoryName}/{managedVirtualNetworkName}/{name}")
+		}
+	}
+
+	resourceId := NewManagedPrivateEndpointID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func DataSetID(input string) (*DataSetId, error) {
 
 	return &resourceId, nil
 }
+
+// DataSetIDFromNames builds an DataSetId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{accountName}/{shareName}/{name}", using the specified Subscription ID when this is omitted
+func DataSetIDFromNames(subscriptionId, input string) (*DataSetId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{accountName}/{shareName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{accountName}/{shareName}/{name}")
+		}
+	}
+
+	resourceId := NewDataSetID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
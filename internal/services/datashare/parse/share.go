@@ -73,3 +73,23 @@ func ShareID(input string) (*ShareId, error) {
 
 	return &resourceId, nil
 }
+
+// ShareIDFromNames builds an ShareId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{accountName}/{name}", using the specified Subscription ID when this is omitted
+func ShareIDFromNames(subscriptionId, input string) (*ShareId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{accountName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{accountName}/{name}")
+		}
+	}
+
+	resourceId := NewShareID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
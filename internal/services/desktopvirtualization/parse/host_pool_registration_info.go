@@ -129,3 +129,23 @@ func HostPoolRegistrationInfoIDInsensitively(input string) (*HostPoolRegistratio
 
 	return &resourceId, nil
 }
+
+// HostPoolRegistrationInfoIDFromNames builds an HostPoolRegistrationInfoId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{hostPoolName}/{registrationInfoName}", using the specified Subscription ID when this is omitted
+func HostPoolRegistrationInfoIDFromNames(subscriptionId, input string) (*HostPoolRegistrationInfoId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{hostPoolName}/{registrationInfoName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{hostPoolName}/{registrationInfoName}")
+		}
+	}
+
+	resourceId := NewHostPoolRegistrationInfoID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
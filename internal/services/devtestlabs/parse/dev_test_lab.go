@@ -111,3 +111,23 @@ func DevTestLabIDInsensitively(input string) (*DevTestLabId, error) {
 
 	return &resourceId, nil
 }
+
+// DevTestLabIDFromNames builds an DevTestLabId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{labName}", using the specified Subscription ID when this is omitted
+func DevTestLabIDFromNames(subscriptionId, input string) (*DevTestLabId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{labName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{labName}")
+		}
+	}
+
+	resourceId := NewDevTestLabID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
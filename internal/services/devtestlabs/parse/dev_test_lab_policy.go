@@ -147,3 +147,23 @@ func DevTestLabPolicyIDInsensitively(input string) (*DevTestLabPolicyId, error)
 
 	return &resourceId, nil
 }
+
+// DevTestLabPolicyIDFromNames builds an DevTestLabPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{labName}/{policySetName}/{policyName}", using the specified Subscription ID when this is omitted
+func DevTestLabPolicyIDFromNames(subscriptionId, input string) (*DevTestLabPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{labName}/{policySetName}/{policyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{labName}/{policySetName}/{policyName}")
+		}
+	}
+
+	resourceId := NewDevTestLabPolicyID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func DevTestLabScheduleIDInsensitively(input string) (*DevTestLabScheduleId, err
 
 	return &resourceId, nil
 }
+
+// DevTestLabScheduleIDFromNames builds an DevTestLabScheduleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{labName}/{scheduleName}", using the specified Subscription ID when this is omitted
+func DevTestLabScheduleIDFromNames(subscriptionId, input string) (*DevTestLabScheduleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{labName}/{scheduleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{labName}/{scheduleName}")
+		}
+	}
+
+	resourceId := NewDevTestLabScheduleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func DevTestVirtualMachineIDInsensitively(input string) (*DevTestVirtualMachineI
 
 	return &resourceId, nil
 }
+
+// DevTestVirtualMachineIDFromNames builds an DevTestVirtualMachineId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{labName}/{virtualMachineName}", using the specified Subscription ID when this is omitted
+func DevTestVirtualMachineIDFromNames(subscriptionId, input string) (*DevTestVirtualMachineId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{labName}/{virtualMachineName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{labName}/{virtualMachineName}")
+		}
+	}
+
+	resourceId := NewDevTestVirtualMachineID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func DevTestVirtualNetworkIDInsensitively(input string) (*DevTestVirtualNetworkI
 
 	return &resourceId, nil
 }
+
+// DevTestVirtualNetworkIDFromNames builds an DevTestVirtualNetworkId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{labName}/{virtualNetworkName}", using the specified Subscription ID when this is omitted
+func DevTestVirtualNetworkIDFromNames(subscriptionId, input string) (*DevTestVirtualNetworkId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{labName}/{virtualNetworkName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{labName}/{virtualNetworkName}")
+		}
+	}
+
+	resourceId := NewDevTestVirtualNetworkID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func DigitalTwinsEndpointID(input string) (*DigitalTwinsEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// DigitalTwinsEndpointIDFromNames builds an DigitalTwinsEndpointId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{digitalTwinsInstanceName}/{endpointName}", using the specified Subscription ID when this is omitted
+func DigitalTwinsEndpointIDFromNames(subscriptionId, input string) (*DigitalTwinsEndpointId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{digitalTwinsInstanceName}/{endpointName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{digitalTwinsInstanceName}/{endpointName}")
+		}
+	}
+
+	resourceId := NewDigitalTwinsEndpointID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
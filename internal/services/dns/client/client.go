@@ -4,11 +4,32 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	dns_v2018_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdk/2023-07-01-preview/dnssecconfigs"
+	previewRecordSets "github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdk/2023-07-01-preview/recordsets"
 )
 
-func NewClient(o *common.ClientOptions) *dns_v2018_05_01.Client {
+type Client struct {
+	*dns_v2018_05_01.Client
+
+	// DNSSEC and DS Records are only available in the newer (preview) API version
+	DnssecConfigsClient *dnssecconfigs.DnssecConfigsClient
+	DsRecordSetsClient  *previewRecordSets.RecordSetsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
 	client := dns_v2018_05_01.NewClientWithBaseURI(o.ResourceManagerEndpoint, func(c *autorest.Client) {
 		c.Authorizer = o.ResourceManagerAuthorizer
 	})
-	return &client
+
+	dnssecConfigsClient := dnssecconfigs.NewDnssecConfigsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dnssecConfigsClient.Client, o.ResourceManagerAuthorizer)
+
+	dsRecordSetsClient := previewRecordSets.NewRecordSetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dsRecordSetsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		Client:              &client,
+		DnssecConfigsClient: &dnssecConfigsClient,
+		DsRecordSetsClient:  &dsRecordSetsClient,
+	}
 }
@@ -0,0 +1,264 @@
+package dns
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdk/2023-07-01-preview/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDnsDsRecord() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDnsDsRecordCreateUpdate,
+		Read:   resourceDnsDsRecordRead,
+		Update: resourceDnsDsRecordCreateUpdate,
+		Delete: resourceDnsDsRecordDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			parsed, err := recordsets.ParseRecordTypeID(id)
+			if err != nil {
+				return err
+			}
+			if parsed.RecordType != recordsets.RecordTypeDS {
+				return fmt.Errorf("this resource only supports 'DS' records")
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"zone_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"record": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"algorithm": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 255),
+						},
+
+						"key_tag": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+
+						"digest_type": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 255),
+						},
+
+						"digest_value": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+				Set: resourceDnsDsRecordHash,
+			},
+
+			"ttl": {
+				Type:     pluginsdk.TypeInt,
+				Required: true,
+			},
+
+			"fqdn": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourceDnsDsRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.DsRecordSetsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+
+	id := recordsets.NewRecordTypeID(subscriptionId, resGroup, zoneName, recordsets.RecordTypeDS, name)
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_dns_ds_record", id.ID())
+		}
+	}
+
+	ttl := int64(d.Get("ttl").(int))
+	t := d.Get("tags").(map[string]interface{})
+
+	parameters := recordsets.RecordSet{
+		Name: &name,
+		Properties: &recordsets.RecordSetProperties{
+			Metadata:  tags.Expand(t),
+			TTL:       &ttl,
+			DSRecords: expandAzureRmDnsDsRecords(d),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters, recordsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDnsDsRecordRead(d, meta)
+}
+
+func resourceDnsDsRecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.DsRecordSetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := recordsets.ParseRecordTypeIDInsensitively(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RelativeRecordSetName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("zone_name", id.ZoneName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("ttl", props.TTL)
+			d.Set("fqdn", props.Fqdn)
+
+			if err := d.Set("record", flattenAzureRmDnsDsRecords(props.DSRecords)); err != nil {
+				return fmt.Errorf("setting `record`: %+v", err)
+			}
+			if err := tags.FlattenAndSet(d, props.Metadata); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceDnsDsRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.DsRecordSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := recordsets.ParseRecordTypeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id, recordsets.DefaultDeleteOperationOptions()); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func flattenAzureRmDnsDsRecords(records *[]recordsets.DsRecord) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0)
+
+	if records != nil {
+		for _, record := range *records {
+			digestType := int64(0)
+			digestValue := ""
+			if record.Digest != nil {
+				digestType = utils.NormaliseNilableInt64(record.Digest.AlgorithmType)
+				digestValue = utils.NormalizeNilableString(record.Digest.Value)
+			}
+
+			results = append(results, map[string]interface{}{
+				"algorithm":    int(utils.NormaliseNilableInt64(record.Algorithm)),
+				"key_tag":      int(utils.NormaliseNilableInt64(record.KeyTag)),
+				"digest_type":  int(digestType),
+				"digest_value": digestValue,
+			})
+		}
+	}
+
+	return results
+}
+
+func expandAzureRmDnsDsRecords(d *pluginsdk.ResourceData) *[]recordsets.DsRecord {
+	recordsRaw := d.Get("record").(*pluginsdk.Set).List()
+	records := make([]recordsets.DsRecord, 0)
+
+	for _, v := range recordsRaw {
+		record := v.(map[string]interface{})
+
+		records = append(records, recordsets.DsRecord{
+			Algorithm: utils.Int64(int64(record["algorithm"].(int))),
+			KeyTag:    utils.Int64(int64(record["key_tag"].(int))),
+			Digest: &recordsets.Digest{
+				AlgorithmType: utils.Int64(int64(record["digest_type"].(int))),
+				Value:         utils.String(record["digest_value"].(string)),
+			},
+		})
+	}
+
+	return &records
+}
+
+func resourceDnsDsRecordHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%d-", m["algorithm"].(int)))
+		buf.WriteString(fmt.Sprintf("%d-", m["key_tag"].(int)))
+		buf.WriteString(fmt.Sprintf("%d-", m["digest_type"].(int)))
+		buf.WriteString(fmt.Sprintf("%s-", m["digest_value"].(string)))
+	}
+
+	return pluginsdk.HashString(buf.String())
+}
@@ -0,0 +1,221 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdk/2023-07-01-preview/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DnsDsRecordResource struct{}
+
+func TestAccDnsDsRecord_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_ds_record", "test")
+	r := DnsDsRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsDsRecord_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_ds_record", "test")
+	r := DnsDsRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_dns_ds_record"),
+		},
+	})
+}
+
+func TestAccDnsDsRecord_updateRecords(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_ds_record", "test")
+	r := DnsDsRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.updateRecords(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsDsRecord_withTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_ds_record", "test")
+	r := DnsDsRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withTags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DnsDsRecordResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := recordsets.ParseRecordTypeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Dns.DsRecordSetsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (DnsDsRecordResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_ds_record" "test" {
+  name                = "mydsrecord%d"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    algorithm    = 13
+    key_tag      = 28237
+    digest_type  = 2
+    digest_value = "40F5A4B7F5A4E9B6A1A0D8C5E2F3B7C9D0E1F2A3B4C5D6E7F8091A2B3C4D5E6F"
+  }
+}
+`, DnsDsRecordResource{}.template(data), data.RandomInteger)
+}
+
+func (r DnsDsRecordResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_ds_record" "import" {
+  name                = azurerm_dns_ds_record.test.name
+  resource_group_name = azurerm_dns_ds_record.test.resource_group_name
+  zone_name           = azurerm_dns_ds_record.test.zone_name
+  ttl                 = 300
+
+  record {
+    algorithm    = 13
+    key_tag      = 28237
+    digest_type  = 2
+    digest_value = "40F5A4B7F5A4E9B6A1A0D8C5E2F3B7C9D0E1F2A3B4C5D6E7F8091A2B3C4D5E6F"
+  }
+}
+`, r.basic(data))
+}
+
+func (DnsDsRecordResource) updateRecords(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_ds_record" "test" {
+  name                = "mydsrecord%d"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    algorithm    = 13
+    key_tag      = 28237
+    digest_type  = 2
+    digest_value = "40F5A4B7F5A4E9B6A1A0D8C5E2F3B7C9D0E1F2A3B4C5D6E7F8091A2B3C4D5E6F"
+  }
+
+  record {
+    algorithm    = 8
+    key_tag      = 12345
+    digest_type  = 2
+    digest_value = "2BB183AF5F22588179A53B0A98631FAD1A292118CE2E49DE5CF6C2F7F3E8D2A1"
+  }
+}
+`, DnsDsRecordResource{}.template(data), data.RandomInteger)
+}
+
+func (DnsDsRecordResource) withTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_ds_record" "test" {
+  name                = "mydsrecord%d"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    algorithm    = 13
+    key_tag      = 28237
+    digest_type  = 2
+    digest_value = "40F5A4B7F5A4E9B6A1A0D8C5E2F3B7C9D0E1F2A3B4C5D6E7F8091A2B3C4D5E6F"
+  }
+
+  tags = {
+    environment = "Production"
+    cost_center = "MSFT"
+  }
+}
+`, DnsDsRecordResource{}.template(data), data.RandomInteger)
+}
+
+func (DnsDsRecordResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,217 @@
+package dns
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdk/2023-07-01-preview/dnssecconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// a DNS Zone has a single DNSSEC Config, which is always named `default`
+const dnsZoneDnssecConfigName = "default"
+
+func resourceDnsZoneDnssecConfig() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDnsZoneDnssecConfigCreate,
+		Read:   resourceDnsZoneDnssecConfigRead,
+		Delete: resourceDnsZoneDnssecConfigDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DnsZoneDnssecConfigID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: zones.ValidateDnsZoneID,
+			},
+
+			"signing_key": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"delegation_signer_info": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"digest_algorithm_type": {
+										Type:     pluginsdk.TypeInt,
+										Computed: true,
+									},
+
+									"digest_value": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"record": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"flags": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"key_tag": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"protocol": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"public_key": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"security_algorithm_type": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDnsZoneDnssecConfigCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.DnssecConfigsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zoneId, err := zones.ParseDnsZoneID(d.Get("dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewDnsZoneDnssecConfigID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.ZoneName, dnsZoneDnssecConfigName)
+	dnssecZoneId := dnssecconfigs.NewDnsZoneID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.ZoneName)
+
+	existing, err := client.Get(ctx, dnssecZoneId)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_dns_zone_dnssec_config", id.ID())
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, dnssecZoneId); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceDnsZoneDnssecConfigRead(d, meta)
+}
+
+func resourceDnsZoneDnssecConfigRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.DnssecConfigsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsZoneDnssecConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, dnssecconfigs.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnsZoneName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("dns_zone_id", zones.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnsZoneName).ID())
+
+	signingKeys := make([]interface{}, 0)
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			signingKeys = flattenDnsZoneDnssecConfigSigningKeys(props.SigningKeys)
+		}
+	}
+	if err := d.Set("signing_key", signingKeys); err != nil {
+		return fmt.Errorf("setting `signing_key`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceDnsZoneDnssecConfigDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.DnssecConfigsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DnsZoneDnssecConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, dnssecconfigs.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnsZoneName)); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func flattenDnsZoneDnssecConfigSigningKeys(input *[]dnssecconfigs.SigningKey) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, key := range *input {
+		delegationSignerInfo := make([]interface{}, 0)
+		if key.DelegationSignerInfo != nil {
+			for _, info := range *key.DelegationSignerInfo {
+				delegationSignerInfo = append(delegationSignerInfo, map[string]interface{}{
+					"digest_algorithm_type": int(utils.NormaliseNilableInt64(info.DigestAlgorithmType)),
+					"digest_value":          utils.NormalizeNilableString(info.DigestValue),
+					"record":                utils.NormalizeNilableString(info.Record),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"delegation_signer_info":  delegationSignerInfo,
+			"flags":                   int(utils.NormaliseNilableInt64(key.Flags)),
+			"key_tag":                 int(utils.NormaliseNilableInt64(key.KeyTag)),
+			"protocol":                int(utils.NormaliseNilableInt64(key.Protocol)),
+			"public_key":              utils.NormalizeNilableString(key.PublicKey),
+			"security_algorithm_type": int(utils.NormaliseNilableInt64(key.SecurityAlgorithmType)),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,170 @@
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdk/2023-07-01-preview/dnssecconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DnsZoneDnssecConfigResource struct{}
+
+func TestAccDnsZoneDnssecConfig_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_dnssec_config", "test")
+	r := DnsZoneDnssecConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("signing_key.#").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsZoneDnssecConfig_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_dnssec_config", "test")
+	r := DnsZoneDnssecConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_dns_zone_dnssec_config"),
+		},
+	})
+}
+
+func TestAccDnsZoneDnssecConfig_childZoneDelegation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_dnssec_config", "test")
+	r := DnsZoneDnssecConfigResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.childZoneDelegation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_dns_ds_record.test").Key("fqdn").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DnsZoneDnssecConfigResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DnsZoneDnssecConfigID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Dns.DnssecConfigsClient.Get(ctx, dnssecconfigs.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.DnsZoneName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (DnsZoneDnssecConfigResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_zone_dnssec_config" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DnsZoneDnssecConfigResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_dnssec_config" "import" {
+  dns_zone_id = azurerm_dns_zone_dnssec_config.test.dns_zone_id
+}
+`, r.basic(data))
+}
+
+func (DnsZoneDnssecConfigResource) childZoneDelegation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dns_zone" "parent" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "child.acctestzone%[1]d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_zone_dnssec_config" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+}
+
+resource "azurerm_dns_ns_record" "test" {
+  name                = "child"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.parent.name
+  ttl                 = 300
+  records             = azurerm_dns_zone.test.name_servers
+}
+
+resource "azurerm_dns_ds_record" "test" {
+  name                = "child"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.parent.name
+  ttl                 = 300
+
+  # only the Key Signing Keys have Delegation Signer information
+  dynamic "record" {
+    for_each = [for key in azurerm_dns_zone_dnssec_config.test.signing_key : key if length(key.delegation_signer_info) > 0]
+    content {
+      algorithm    = record.value.security_algorithm_type
+      key_tag      = record.value.key_tag
+      digest_type  = record.value.delegation_signer_info.0.digest_algorithm_type
+      digest_value = record.value.delegation_signer_info.0.digest_value
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
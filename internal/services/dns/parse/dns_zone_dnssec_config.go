@@ -0,0 +1,151 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DnsZoneDnssecConfigId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	DnsZoneName      string
+	DnssecConfigName string
+}
+
+func NewDnsZoneDnssecConfigID(subscriptionId, resourceGroup, dnsZoneName, dnssecConfigName string) DnsZoneDnssecConfigId {
+	return DnsZoneDnssecConfigId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		DnsZoneName:      dnsZoneName,
+		DnssecConfigName: dnssecConfigName,
+	}
+}
+
+func (id DnsZoneDnssecConfigId) String() string {
+	segments := []string{
+		fmt.Sprintf("Dnssec Config Name %q", id.DnssecConfigName),
+		fmt.Sprintf("Dns Zone Name %q", id.DnsZoneName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Dns Zone Dnssec Config", segmentsStr)
+}
+
+func (id DnsZoneDnssecConfigId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsZones/%s/dnssecConfigs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnsZoneName, id.DnssecConfigName)
+}
+
+// DnsZoneDnssecConfigID parses a DnsZoneDnssecConfig ID into an DnsZoneDnssecConfigId struct
+func DnsZoneDnssecConfigID(input string) (*DnsZoneDnssecConfigId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsZoneDnssecConfigId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnsZoneName, err = id.PopSegment("dnsZones"); err != nil {
+		return nil, err
+	}
+	if resourceId.DnssecConfigName, err = id.PopSegment("dnssecConfigs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// DnsZoneDnssecConfigIDInsensitively parses an DnsZoneDnssecConfig ID into an DnsZoneDnssecConfigId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DnsZoneDnssecConfigID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DnsZoneDnssecConfigIDInsensitively(input string) (*DnsZoneDnssecConfigId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DnsZoneDnssecConfigId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'dnsZones' segment
+	dnsZonesKey := "dnsZones"
+	for key := range id.Path {
+		if strings.EqualFold(key, dnsZonesKey) {
+			dnsZonesKey = key
+			break
+		}
+	}
+	if resourceId.DnsZoneName, err = id.PopSegment(dnsZonesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'dnssecConfigs' segment
+	dnssecConfigsKey := "dnssecConfigs"
+	for key := range id.Path {
+		if strings.EqualFold(key, dnssecConfigsKey) {
+			dnssecConfigsKey = key
+			break
+		}
+	}
+	if resourceId.DnssecConfigName, err = id.PopSegment(dnssecConfigsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// DnsZoneDnssecConfigIDFromNames builds an DnsZoneDnssecConfigId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{dnsZoneName}/{dnssecConfigName}", using the specified Subscription ID when this is omitted
+func DnsZoneDnssecConfigIDFromNames(subscriptionId, input string) (*DnsZoneDnssecConfigId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{dnsZoneName}/{dnssecConfigName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{dnsZoneName}/{dnssecConfigName}")
+		}
+	}
+
+	resourceId := NewDnsZoneDnssecConfigID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
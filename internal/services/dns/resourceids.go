@@ -0,0 +1,3 @@
+package dns
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=DnsZoneDnssecConfig -rewrite=true -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnsZones/zone1/dnssecConfigs/default
@@ -0,0 +1,6 @@
+package dnssecconfigs
+
+type DnssecProperties struct {
+	ProvisioningState *string       `json:"provisioningState,omitempty"`
+	SigningKeys       *[]SigningKey `json:"signingKeys,omitempty"`
+}
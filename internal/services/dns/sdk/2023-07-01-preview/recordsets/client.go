@@ -0,0 +1,15 @@
+package recordsets
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RecordSetsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRecordSetsClientWithBaseURI(endpoint string) RecordSetsClient {
+	return RecordSetsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,64 @@
+package recordsets
+
+import "strings"
+
+type RecordType string
+
+const (
+	RecordTypeA     RecordType = "A"
+	RecordTypeAAAA  RecordType = "AAAA"
+	RecordTypeCAA   RecordType = "CAA"
+	RecordTypeCNAME RecordType = "CNAME"
+	RecordTypeDS    RecordType = "DS"
+	RecordTypeMX    RecordType = "MX"
+	RecordTypeNAPTR RecordType = "NAPTR"
+	RecordTypeNS    RecordType = "NS"
+	RecordTypePTR   RecordType = "PTR"
+	RecordTypeSOA   RecordType = "SOA"
+	RecordTypeSRV   RecordType = "SRV"
+	RecordTypeTLSA  RecordType = "TLSA"
+	RecordTypeTXT   RecordType = "TXT"
+)
+
+func PossibleValuesForRecordType() []string {
+	return []string{
+		string(RecordTypeA),
+		string(RecordTypeAAAA),
+		string(RecordTypeCAA),
+		string(RecordTypeCNAME),
+		string(RecordTypeDS),
+		string(RecordTypeMX),
+		string(RecordTypeNAPTR),
+		string(RecordTypeNS),
+		string(RecordTypePTR),
+		string(RecordTypeSOA),
+		string(RecordTypeSRV),
+		string(RecordTypeTLSA),
+		string(RecordTypeTXT),
+	}
+}
+
+func parseRecordType(input string) (*RecordType, error) {
+	vals := map[string]RecordType{
+		"a":     RecordTypeA,
+		"aaaa":  RecordTypeAAAA,
+		"caa":   RecordTypeCAA,
+		"cname": RecordTypeCNAME,
+		"ds":    RecordTypeDS,
+		"mx":    RecordTypeMX,
+		"naptr": RecordTypeNAPTR,
+		"ns":    RecordTypeNS,
+		"ptr":   RecordTypePTR,
+		"soa":   RecordTypeSOA,
+		"srv":   RecordTypeSRV,
+		"tlsa":  RecordTypeTLSA,
+		"txt":   RecordTypeTXT,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RecordType(input)
+	return &out, nil
+}
@@ -0,0 +1,5 @@
+package recordsets
+
+type AaaaRecord struct {
+	IPv6Address *string `json:"ipv6Address,omitempty"`
+}
@@ -0,0 +1,5 @@
+package recordsets
+
+type ARecord struct {
+	IPv4Address *string `json:"ipv4Address,omitempty"`
+}
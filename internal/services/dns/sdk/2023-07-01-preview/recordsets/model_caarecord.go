@@ -0,0 +1,7 @@
+package recordsets
+
+type CaaRecord struct {
+	Flags *int64  `json:"flags,omitempty"`
+	Tag   *string `json:"tag,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
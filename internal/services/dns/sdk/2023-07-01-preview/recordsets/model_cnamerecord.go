@@ -0,0 +1,5 @@
+package recordsets
+
+type CnameRecord struct {
+	Cname *string `json:"cname,omitempty"`
+}
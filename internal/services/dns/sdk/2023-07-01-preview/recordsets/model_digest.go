@@ -0,0 +1,6 @@
+package recordsets
+
+type Digest struct {
+	AlgorithmType *int64  `json:"algorithmType,omitempty"`
+	Value         *string `json:"value,omitempty"`
+}
@@ -0,0 +1,7 @@
+package recordsets
+
+type DsRecord struct {
+	Algorithm *int64  `json:"algorithm,omitempty"`
+	Digest    *Digest `json:"digest,omitempty"`
+	KeyTag    *int64  `json:"keyTag,omitempty"`
+}
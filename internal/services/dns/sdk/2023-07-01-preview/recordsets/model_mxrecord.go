@@ -0,0 +1,6 @@
+package recordsets
+
+type MxRecord struct {
+	Exchange   *string `json:"exchange,omitempty"`
+	Preference *int64  `json:"preference,omitempty"`
+}
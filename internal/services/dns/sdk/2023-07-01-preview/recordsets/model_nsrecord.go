@@ -0,0 +1,5 @@
+package recordsets
+
+type NsRecord struct {
+	Nsdname *string `json:"nsdname,omitempty"`
+}
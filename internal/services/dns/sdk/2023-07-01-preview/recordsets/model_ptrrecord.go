@@ -0,0 +1,5 @@
+package recordsets
+
+type PtrRecord struct {
+	Ptrdname *string `json:"ptrdname,omitempty"`
+}
@@ -0,0 +1,20 @@
+package recordsets
+
+type RecordSetProperties struct {
+	AAAARecords       *[]AaaaRecord      `json:"AAAARecords,omitempty"`
+	ARecords          *[]ARecord         `json:"ARecords,omitempty"`
+	CNAMERecord       *CnameRecord       `json:"CNAMERecord,omitempty"`
+	CaaRecords        *[]CaaRecord       `json:"caaRecords,omitempty"`
+	DSRecords         *[]DsRecord        `json:"DSRecords,omitempty"`
+	Fqdn              *string            `json:"fqdn,omitempty"`
+	MXRecords         *[]MxRecord        `json:"MXRecords,omitempty"`
+	Metadata          *map[string]string `json:"metadata,omitempty"`
+	NSRecords         *[]NsRecord        `json:"NSRecords,omitempty"`
+	PTRRecords        *[]PtrRecord       `json:"PTRRecords,omitempty"`
+	ProvisioningState *string            `json:"provisioningState,omitempty"`
+	SOARecord         *SoaRecord         `json:"SOARecord,omitempty"`
+	SRVRecords        *[]SrvRecord       `json:"SRVRecords,omitempty"`
+	TTL               *int64             `json:"TTL,omitempty"`
+	TXTRecords        *[]TxtRecord       `json:"TXTRecords,omitempty"`
+	TargetResource    *SubResource       `json:"targetResource,omitempty"`
+}
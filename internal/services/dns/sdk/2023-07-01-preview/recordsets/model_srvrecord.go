@@ -0,0 +1,8 @@
+package recordsets
+
+type SrvRecord struct {
+	Port     *int64  `json:"port,omitempty"`
+	Priority *int64  `json:"priority,omitempty"`
+	Target   *string `json:"target,omitempty"`
+	Weight   *int64  `json:"weight,omitempty"`
+}
@@ -0,0 +1,5 @@
+package recordsets
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
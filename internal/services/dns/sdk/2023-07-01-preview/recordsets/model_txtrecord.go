@@ -0,0 +1,5 @@
+package recordsets
+
+type TxtRecord struct {
+	Value *[]string `json:"value,omitempty"`
+}
@@ -73,3 +73,23 @@ func DomainServiceID(input string) (*DomainServiceId, error) {
 
 	return &resourceId, nil
 }
+
+// DomainServiceIDFromNames builds an DomainServiceId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{name}/{initialReplicaSetIdName}", using the specified Subscription ID when this is omitted
+func DomainServiceIDFromNames(subscriptionId, input string) (*DomainServiceId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{name}/{initialReplicaSetIdName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{name}/{initialReplicaSetIdName}")
+		}
+	}
+
+	resourceId := NewDomainServiceID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func DomainServiceReplicaSetID(input string) (*DomainServiceReplicaSetId, error)
 
 	return &resourceId, nil
 }
+
+// DomainServiceReplicaSetIDFromNames builds an DomainServiceReplicaSetId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{domainServiceName}/{replicaSetName}", using the specified Subscription ID when this is omitted
+func DomainServiceReplicaSetIDFromNames(subscriptionId, input string) (*DomainServiceReplicaSetId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{domainServiceName}/{replicaSetName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{domainServiceName}/{replicaSetName}")
+		}
+	}
+
+	resourceId := NewDomainServiceReplicaSetID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
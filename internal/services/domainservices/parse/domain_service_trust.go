@@ -73,3 +73,23 @@ func DomainServiceTrustID(input string) (*DomainServiceTrustId, error) {
 
 	return &resourceId, nil
 }
+
+// DomainServiceTrustIDFromNames builds an DomainServiceTrustId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{domainServiceName}/{trustName}", using the specified Subscription ID when this is omitted
+func DomainServiceTrustIDFromNames(subscriptionId, input string) (*DomainServiceTrustId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{domainServiceName}/{trustName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{domainServiceName}/{trustName}")
+		}
+	}
+
+	resourceId := NewDomainServiceTrustID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
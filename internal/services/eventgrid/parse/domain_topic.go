@@ -73,3 +73,23 @@ func DomainTopicID(input string) (*DomainTopicId, error) {
 
 	return &resourceId, nil
 }
+
+// DomainTopicIDFromNames builds an DomainTopicId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{domainName}/{topicName}", using the specified Subscription ID when this is omitted
+func DomainTopicIDFromNames(subscriptionId, input string) (*DomainTopicId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{domainName}/{topicName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{domainName}/{topicName}")
+		}
+	}
+
+	resourceId := NewDomainTopicID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func SystemTopicEventSubscriptionID(input string) (*SystemTopicEventSubscription
 
 	return &resourceId, nil
 }
+
+// SystemTopicEventSubscriptionIDFromNames builds an SystemTopicEventSubscriptionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{systemTopicName}/{eventSubscriptionName}", using the specified Subscription ID when this is omitted
+func SystemTopicEventSubscriptionIDFromNames(subscriptionId, input string) (*SystemTopicEventSubscriptionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{systemTopicName}/{eventSubscriptionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{systemTopicName}/{eventSubscriptionName}")
+		}
+	}
+
+	resourceId := NewSystemTopicEventSubscriptionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -67,3 +67,23 @@ func FirewallID(input string) (*FirewallId, error) {
 
 	return &resourceId, nil
 }
+
+// FirewallIDFromNames builds an FirewallId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{azureFirewallName}", using the specified Subscription ID when this is omitted
+func FirewallIDFromNames(subscriptionId, input string) (*FirewallId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{azureFirewallName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{azureFirewallName}")
+		}
+	}
+
+	resourceId := NewFirewallID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func FirewallApplicationRuleCollectionID(input string) (*FirewallApplicationRule
 
 	return &resourceId, nil
 }
+
+// FirewallApplicationRuleCollectionIDFromNames builds an FirewallApplicationRuleCollectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{azureFirewallName}/{applicationRuleCollectionName}", using the specified Subscription ID when this is omitted
+func FirewallApplicationRuleCollectionIDFromNames(subscriptionId, input string) (*FirewallApplicationRuleCollectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{azureFirewallName}/{applicationRuleCollectionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{azureFirewallName}/{applicationRuleCollectionName}")
+		}
+	}
+
+	resourceId := NewFirewallApplicationRuleCollectionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -126,3 +126,87 @@ func TestFirewallApplicationRuleCollectionID(t *testing.T) {
 		}
 	}
 }
+
+func TestFirewallApplicationRuleCollectionIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *FirewallApplicationRuleCollectionId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "mygroup1/myfirewall",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "00000000-0000-0000-0000-000000000000/mygroup1/myfirewall/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "mygroup1/myfirewall/applicationRuleCollection1",
+			Expected: &FirewallApplicationRuleCollectionId{
+				SubscriptionId:                "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:                 "mygroup1",
+				AzureFirewallName:             "myfirewall",
+				ApplicationRuleCollectionName: "applicationRuleCollection1",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "00000000-0000-0000-0000-000000000000/mygroup1/myfirewall/applicationRuleCollection1",
+			Expected: &FirewallApplicationRuleCollectionId{
+				SubscriptionId:                "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:                 "mygroup1",
+				AzureFirewallName:             "myfirewall",
+				ApplicationRuleCollectionName: "applicationRuleCollection1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := FirewallApplicationRuleCollectionIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.AzureFirewallName != v.Expected.AzureFirewallName {
+			t.Fatalf("Expected %q but got %q for AzureFirewallName", v.Expected.AzureFirewallName, actual.AzureFirewallName)
+		}
+		if actual.ApplicationRuleCollectionName != v.Expected.ApplicationRuleCollectionName {
+			t.Fatalf("Expected %q but got %q for ApplicationRuleCollectionName", v.Expected.ApplicationRuleCollectionName, actual.ApplicationRuleCollectionName)
+		}
+	}
+}
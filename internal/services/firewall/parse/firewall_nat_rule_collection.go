@@ -73,3 +73,23 @@ func FirewallNatRuleCollectionID(input string) (*FirewallNatRuleCollectionId, er
 
 	return &resourceId, nil
 }
+
+// FirewallNatRuleCollectionIDFromNames builds an FirewallNatRuleCollectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{azureFirewallName}/{natRuleCollectionName}", using the specified Subscription ID when this is omitted
+func FirewallNatRuleCollectionIDFromNames(subscriptionId, input string) (*FirewallNatRuleCollectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{azureFirewallName}/{natRuleCollectionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{azureFirewallName}/{natRuleCollectionName}")
+		}
+	}
+
+	resourceId := NewFirewallNatRuleCollectionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func FirewallNetworkRuleCollectionID(input string) (*FirewallNetworkRuleCollecti
 
 	return &resourceId, nil
 }
+
+// FirewallNetworkRuleCollectionIDFromNames builds an FirewallNetworkRuleCollectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{azureFirewallName}/{networkRuleCollectionName}", using the specified Subscription ID when this is omitted
+func FirewallNetworkRuleCollectionIDFromNames(subscriptionId, input string) (*FirewallNetworkRuleCollectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{azureFirewallName}/{networkRuleCollectionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{azureFirewallName}/{networkRuleCollectionName}")
+		}
+	}
+
+	resourceId := NewFirewallNetworkRuleCollectionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -126,3 +126,87 @@ func TestFirewallNetworkRuleCollectionID(t *testing.T) {
 		}
 	}
 }
+
+func TestFirewallNetworkRuleCollectionIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *FirewallNetworkRuleCollectionId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "mygroup1/myfirewall",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "00000000-0000-0000-0000-000000000000/mygroup1/myfirewall/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "mygroup1/myfirewall/networkRuleCollection1",
+			Expected: &FirewallNetworkRuleCollectionId{
+				SubscriptionId:            "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:             "mygroup1",
+				AzureFirewallName:         "myfirewall",
+				NetworkRuleCollectionName: "networkRuleCollection1",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "00000000-0000-0000-0000-000000000000/mygroup1/myfirewall/networkRuleCollection1",
+			Expected: &FirewallNetworkRuleCollectionId{
+				SubscriptionId:            "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:             "mygroup1",
+				AzureFirewallName:         "myfirewall",
+				NetworkRuleCollectionName: "networkRuleCollection1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := FirewallNetworkRuleCollectionIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.AzureFirewallName != v.Expected.AzureFirewallName {
+			t.Fatalf("Expected %q but got %q for AzureFirewallName", v.Expected.AzureFirewallName, actual.AzureFirewallName)
+		}
+		if actual.NetworkRuleCollectionName != v.Expected.NetworkRuleCollectionName {
+			t.Fatalf("Expected %q but got %q for NetworkRuleCollectionName", v.Expected.NetworkRuleCollectionName, actual.NetworkRuleCollectionName)
+		}
+	}
+}
@@ -73,3 +73,23 @@ func FirewallPolicyRuleCollectionGroupID(input string) (*FirewallPolicyRuleColle
 
 	return &resourceId, nil
 }
+
+// FirewallPolicyRuleCollectionGroupIDFromNames builds an FirewallPolicyRuleCollectionGroupId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{firewallPolicyName}/{ruleCollectionGroupName}", using the specified Subscription ID when this is omitted
+func FirewallPolicyRuleCollectionGroupIDFromNames(subscriptionId, input string) (*FirewallPolicyRuleCollectionGroupId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{firewallPolicyName}/{ruleCollectionGroupName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{firewallPolicyName}/{ruleCollectionGroupName}")
+		}
+	}
+
+	resourceId := NewFirewallPolicyRuleCollectionGroupID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
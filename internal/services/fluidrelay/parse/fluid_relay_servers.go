@@ -67,3 +67,23 @@ func FluidRelayServersID(input string) (*FluidRelayServersId, error) {
 
 	return &resourceId, nil
 }
+
+// FluidRelayServersIDFromNames builds an FluidRelayServersId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{fluidRelayServerName}", using the specified Subscription ID when this is omitted
+func FluidRelayServersIDFromNames(subscriptionId, input string) (*FluidRelayServersId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{fluidRelayServerName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{fluidRelayServerName}")
+		}
+	}
+
+	resourceId := NewFluidRelayServersID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -110,3 +110,82 @@ func TestFluidRelayServersID(t *testing.T) {
 		}
 	}
 }
+
+func TestFluidRelayServersIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *FluidRelayServersId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "rg1",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "00000000-0000-0000-0000-000000000000/rg1/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "rg1/server1",
+			Expected: &FluidRelayServersId{
+				SubscriptionId:       "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:        "rg1",
+				FluidRelayServerName: "server1",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "00000000-0000-0000-0000-000000000000/rg1/server1",
+			Expected: &FluidRelayServersId{
+				SubscriptionId:       "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:        "rg1",
+				FluidRelayServerName: "server1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := FluidRelayServersIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.FluidRelayServerName != v.Expected.FluidRelayServerName {
+			t.Fatalf("Expected %q but got %q for FluidRelayServerName", v.Expected.FluidRelayServerName, actual.FluidRelayServerName)
+		}
+	}
+}
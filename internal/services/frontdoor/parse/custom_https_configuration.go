@@ -129,3 +129,23 @@ func CustomHttpsConfigurationIDInsensitively(input string) (*CustomHttpsConfigur
 
 	return &resourceId, nil
 }
+
+// CustomHttpsConfigurationIDFromNames builds an CustomHttpsConfigurationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{frontDoorName}/{customHttpsConfigurationName}", using the specified Subscription ID when this is omitted
+func CustomHttpsConfigurationIDFromNames(subscriptionId, input string) (*CustomHttpsConfigurationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{frontDoorName}/{customHttpsConfigurationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{frontDoorName}/{customHttpsConfigurationName}")
+		}
+	}
+
+	resourceId := NewCustomHttpsConfigurationID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
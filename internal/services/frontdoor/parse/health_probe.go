@@ -129,3 +129,23 @@ func HealthProbeIDInsensitively(input string) (*HealthProbeId, error) {
 
 	return &resourceId, nil
 }
+
+// HealthProbeIDFromNames builds an HealthProbeId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{frontDoorName}/{healthProbeSettingName}", using the specified Subscription ID when this is omitted
+func HealthProbeIDFromNames(subscriptionId, input string) (*HealthProbeId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{frontDoorName}/{healthProbeSettingName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{frontDoorName}/{healthProbeSettingName}")
+		}
+	}
+
+	resourceId := NewHealthProbeID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
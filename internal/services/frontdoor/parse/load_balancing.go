@@ -129,3 +129,23 @@ func LoadBalancingIDInsensitively(input string) (*LoadBalancingId, error) {
 
 	return &resourceId, nil
 }
+
+// LoadBalancingIDFromNames builds an LoadBalancingId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{frontDoorName}/{loadBalancingSettingName}", using the specified Subscription ID when this is omitted
+func LoadBalancingIDFromNames(subscriptionId, input string) (*LoadBalancingId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{frontDoorName}/{loadBalancingSettingName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{frontDoorName}/{loadBalancingSettingName}")
+		}
+	}
+
+	resourceId := NewLoadBalancingID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func LoadBalancingRuleID(input string) (*LoadBalancingRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// LoadBalancingRuleIDFromNames builds an LoadBalancingRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{name}", using the specified Subscription ID when this is omitted
+func LoadBalancingRuleIDFromNames(subscriptionId, input string) (*LoadBalancingRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{name}")
+		}
+	}
+
+	resourceId := NewLoadBalancingRuleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func RoutingRuleIDInsensitively(input string) (*RoutingRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// RoutingRuleIDFromNames builds an RoutingRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{frontDoorName}/{name}", using the specified Subscription ID when this is omitted
+func RoutingRuleIDFromNames(subscriptionId, input string) (*RoutingRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{frontDoorName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{frontDoorName}/{name}")
+		}
+	}
+
+	resourceId := NewRoutingRuleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func RulesEngineIDInsensitively(input string) (*RulesEngineId, error) {
 
 	return &resourceId, nil
 }
+
+// RulesEngineIDFromNames builds an RulesEngineId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{frontdoorName}/{name}", using the specified Subscription ID when this is omitted
+func RulesEngineIDFromNames(subscriptionId, input string) (*RulesEngineId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{frontdoorName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{frontdoorName}/{name}")
+		}
+	}
+
+	resourceId := NewRulesEngineID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func MedTechServiceID(input string) (*MedTechServiceId, error) {
 
 	return &resourceId, nil
 }
+
+// MedTechServiceIDFromNames builds an MedTechServiceId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{iotconnectorName}", using the specified Subscription ID when this is omitted
+func MedTechServiceIDFromNames(subscriptionId, input string) (*MedTechServiceId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{iotconnectorName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{iotconnectorName}")
+		}
+	}
+
+	resourceId := NewMedTechServiceID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func MedTechServiceFhirDestinationID(input string) (*MedTechServiceFhirDestinati
 
 	return &resourceId, nil
 }
+
+// MedTechServiceFhirDestinationIDFromNames builds an MedTechServiceFhirDestinationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{iotconnectorName}/{fhirdestinationName}", using the specified Subscription ID when this is omitted
+func MedTechServiceFhirDestinationIDFromNames(subscriptionId, input string) (*MedTechServiceFhirDestinationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{iotconnectorName}/{fhirdestinationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{iotconnectorName}/{fhirdestinationName}")
+		}
+	}
+
+	resourceId := NewMedTechServiceFhirDestinationID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func CacheAccessPolicyID(input string) (*CacheAccessPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// CacheAccessPolicyIDFromNames builds an CacheAccessPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{cacheName}/{name}", using the specified Subscription ID when this is omitted
+func CacheAccessPolicyIDFromNames(subscriptionId, input string) (*CacheAccessPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{cacheName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{cacheName}/{name}")
+		}
+	}
+
+	resourceId := NewCacheAccessPolicyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
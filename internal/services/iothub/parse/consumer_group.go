@@ -79,3 +79,23 @@ func ConsumerGroupID(input string) (*ConsumerGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// ConsumerGroupIDFromNames builds an ConsumerGroupId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{eventHubEndpointName}/{name}", using the specified Subscription ID when this is omitted
+func ConsumerGroupIDFromNames(subscriptionId, input string) (*ConsumerGroupId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{eventHubEndpointName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{eventHubEndpointName}/{name}")
+		}
+	}
+
+	resourceId := NewConsumerGroupID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func DpsCertificateID(input string) (*DpsCertificateId, error) {
 
 	return &resourceId, nil
 }
+
+// DpsCertificateIDFromNames builds an DpsCertificateId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{provisioningServiceName}/{certificateName}", using the specified Subscription ID when this is omitted
+func DpsCertificateIDFromNames(subscriptionId, input string) (*DpsCertificateId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{provisioningServiceName}/{certificateName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{provisioningServiceName}/{certificateName}")
+		}
+	}
+
+	resourceId := NewDpsCertificateID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func DpsSharedAccessPolicyID(input string) (*DpsSharedAccessPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// DpsSharedAccessPolicyIDFromNames builds an DpsSharedAccessPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{provisioningServiceName}/{keyName}", using the specified Subscription ID when this is omitted
+func DpsSharedAccessPolicyIDFromNames(subscriptionId, input string) (*DpsSharedAccessPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{provisioningServiceName}/{keyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{provisioningServiceName}/{keyName}")
+		}
+	}
+
+	resourceId := NewDpsSharedAccessPolicyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func EndpointServiceBusQueueID(input string) (*EndpointServiceBusQueueId, error)
 
 	return &resourceId, nil
 }
+
+// EndpointServiceBusQueueIDFromNames builds an EndpointServiceBusQueueId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{endpointName}", using the specified Subscription ID when this is omitted
+func EndpointServiceBusQueueIDFromNames(subscriptionId, input string) (*EndpointServiceBusQueueId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{endpointName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{endpointName}")
+		}
+	}
+
+	resourceId := NewEndpointServiceBusQueueID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
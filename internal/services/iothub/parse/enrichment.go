@@ -73,3 +73,23 @@ func EnrichmentID(input string) (*EnrichmentId, error) {
 
 	return &resourceId, nil
 }
+
+// EnrichmentIDFromNames builds an EnrichmentId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{name}", using the specified Subscription ID when this is omitted
+func EnrichmentIDFromNames(subscriptionId, input string) (*EnrichmentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{name}")
+		}
+	}
+
+	resourceId := NewEnrichmentID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
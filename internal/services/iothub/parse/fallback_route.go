@@ -73,3 +73,23 @@ func FallbackRouteID(input string) (*FallbackRouteId, error) {
 
 	return &resourceId, nil
 }
+
+// FallbackRouteIDFromNames builds an FallbackRouteId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{fallbackRouteName}", using the specified Subscription ID when this is omitted
+func FallbackRouteIDFromNames(subscriptionId, input string) (*FallbackRouteId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{fallbackRouteName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{fallbackRouteName}")
+		}
+	}
+
+	resourceId := NewFallbackRouteID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func IotHubCertificateID(input string) (*IotHubCertificateId, error) {
 
 	return &resourceId, nil
 }
+
+// IotHubCertificateIDFromNames builds an IotHubCertificateId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{certificateName}", using the specified Subscription ID when this is omitted
+func IotHubCertificateIDFromNames(subscriptionId, input string) (*IotHubCertificateId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{certificateName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{certificateName}")
+		}
+	}
+
+	resourceId := NewIotHubCertificateID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -67,3 +67,23 @@ func IotHubDpsID(input string) (*IotHubDpsId, error) {
 
 	return &resourceId, nil
 }
+
+// IotHubDpsIDFromNames builds an IotHubDpsId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{provisioningServiceName}", using the specified Subscription ID when this is omitted
+func IotHubDpsIDFromNames(subscriptionId, input string) (*IotHubDpsId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{provisioningServiceName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{provisioningServiceName}")
+		}
+	}
+
+	resourceId := NewIotHubDpsID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
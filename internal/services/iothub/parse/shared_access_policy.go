@@ -73,3 +73,23 @@ func SharedAccessPolicyID(input string) (*SharedAccessPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// SharedAccessPolicyIDFromNames builds an SharedAccessPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{iotHubKeyName}", using the specified Subscription ID when this is omitted
+func SharedAccessPolicyIDFromNames(subscriptionId, input string) (*SharedAccessPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{iotHubKeyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{iotHubName}/{iotHubKeyName}")
+		}
+	}
+
+	resourceId := NewSharedAccessPolicyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func AccessPolicyID(input string) (*AccessPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// AccessPolicyIDFromNames builds an AccessPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{environmentName}/{name}", using the specified Subscription ID when this is omitted
+func AccessPolicyIDFromNames(subscriptionId, input string) (*AccessPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{environmentName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{environmentName}/{name}")
+		}
+	}
+
+	resourceId := NewAccessPolicyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
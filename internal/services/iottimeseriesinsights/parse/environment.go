@@ -67,3 +67,23 @@ func EnvironmentID(input string) (*EnvironmentId, error) {
 
 	return &resourceId, nil
 }
+
+// EnvironmentIDFromNames builds an EnvironmentId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{name}", using the specified Subscription ID when this is omitted
+func EnvironmentIDFromNames(subscriptionId, input string) (*EnvironmentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{name}")
+		}
+	}
+
+	resourceId := NewEnvironmentID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func EventSourceID(input string) (*EventSourceId, error) {
 
 	return &resourceId, nil
 }
+
+// EventSourceIDFromNames builds an EventSourceId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{environmentName}/{name}", using the specified Subscription ID when this is omitted
+func EventSourceIDFromNames(subscriptionId, input string) (*EventSourceId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{environmentName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{environmentName}/{name}")
+		}
+	}
+
+	resourceId := NewEventSourceID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func ReferenceDataSetID(input string) (*ReferenceDataSetId, error) {
 
 	return &resourceId, nil
 }
+
+// ReferenceDataSetIDFromNames builds an ReferenceDataSetId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{environmentName}/{name}", using the specified Subscription ID when this is omitted
+func ReferenceDataSetIDFromNames(subscriptionId, input string) (*ReferenceDataSetId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{environmentName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{environmentName}/{name}")
+		}
+	}
+
+	resourceId := NewReferenceDataSetID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -31,8 +31,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	}
 }
 
+// KeyVaultClientForSubscription returns a VaultsClient for the specified Subscription, which uses the same
+// credentials and configuration as the Provider
 func (client Client) KeyVaultClientForSubscription(subscriptionId string) *keyvault.VaultsClient {
-	vaultsClient := keyvault.NewVaultsClientWithBaseURI(client.options.ResourceManagerEndpoint, subscriptionId)
-	client.options.ConfigureClient(&vaultsClient.Client, client.options.ResourceManagerAuthorizer)
+	if subscriptionId == client.VaultsClient.SubscriptionID {
+		return client.VaultsClient
+	}
+
+	o := client.options.ForSubscription(subscriptionId)
+	vaultsClient := keyvault.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
 	return &vaultsClient
 }
@@ -0,0 +1,69 @@
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.UntypedServiceRegistrationWithSweepers = Registration{}
+
+// Sweepers returns the Sweepers for the leaked resources within this Service
+func (r Registration) Sweepers() []sdk.Sweeper {
+	return []sdk.Sweeper{
+		{
+			Name: "azurerm_key_vault",
+			// Key Vaults are soft-deleted when the Resource Group containing them is deleted
+			Dependencies: []string{"azurerm_resource_group"},
+			Func:         sweepSoftDeletedKeyVaults,
+		},
+	}
+}
+
+// sweepSoftDeletedKeyVaults purges the soft-deleted Key Vaults created by the Acceptance Tests within the
+// specified Location, which otherwise count towards the quota until the retention period has elapsed
+func sweepSoftDeletedKeyVaults(ctx context.Context, client *clients.Client, locationName string) error {
+	vaultsClient := client.KeyVault.VaultsClient
+
+	iterator, err := vaultsClient.ListDeletedComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("listing soft-deleted Key Vaults: %+v", err)
+	}
+
+	var errs *multierror.Error
+	for iterator.NotDone() {
+		vault := iterator.Value()
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing soft-deleted Key Vaults: %+v", err)
+		}
+
+		if vault.Name == nil || !sdk.IsSweepable(*vault.Name) {
+			continue
+		}
+		props := vault.Properties
+		if props == nil || props.Location == nil || location.Normalize(*props.Location) != locationName {
+			continue
+		}
+		if props.PurgeProtectionEnabled != nil && *props.PurgeProtectionEnabled {
+			log.Printf("[DEBUG] soft-deleted Key Vault %q has Purge Protection enabled - skipping", *vault.Name)
+			continue
+		}
+
+		log.Printf("[DEBUG] Purging soft-deleted Key Vault %q..", *vault.Name)
+		future, err := vaultsClient.PurgeDeleted(ctx, *vault.Name, *props.Location)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("purging soft-deleted Key Vault %q: %+v", *vault.Name, err))
+			continue
+		}
+		if err := future.WaitForCompletionRef(ctx, vaultsClient.Client); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("waiting for purge of soft-deleted Key Vault %q: %+v", *vault.Name, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -79,3 +79,23 @@ func AccessPolicyApplicationID(input string) (*AccessPolicyApplicationId, error)
 
 	return &resourceId, nil
 }
+
+// AccessPolicyApplicationIDFromNames builds an AccessPolicyApplicationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vaultName}/{objectIdName}/{applicationIdName}", using the specified Subscription ID when this is omitted
+func AccessPolicyApplicationIDFromNames(subscriptionId, input string) (*AccessPolicyApplicationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{objectIdName}/{applicationIdName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{objectIdName}/{applicationIdName}")
+		}
+	}
+
+	resourceId := NewAccessPolicyApplicationID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
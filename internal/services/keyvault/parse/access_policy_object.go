@@ -73,3 +73,23 @@ func AccessPolicyObjectID(input string) (*AccessPolicyObjectId, error) {
 
 	return &resourceId, nil
 }
+
+// AccessPolicyObjectIDFromNames builds an AccessPolicyObjectId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vaultName}/{objectIdName}", using the specified Subscription ID when this is omitted
+func AccessPolicyObjectIDFromNames(subscriptionId, input string) (*AccessPolicyObjectId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{objectIdName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{objectIdName}")
+		}
+	}
+
+	resourceId := NewAccessPolicyObjectID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func KeyVersionlessID(input string) (*KeyVersionlessId, error) {
 
 	return &resourceId, nil
 }
+
+// KeyVersionlessIDFromNames builds an KeyVersionlessId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vaultName}/{keyName}", using the specified Subscription ID when this is omitted
+func KeyVersionlessIDFromNames(subscriptionId, input string) (*KeyVersionlessId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{keyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{keyName}")
+		}
+	}
+
+	resourceId := NewKeyVersionlessID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
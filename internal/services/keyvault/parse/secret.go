@@ -79,3 +79,23 @@ func SecretID(input string) (*SecretId, error) {
 
 	return &resourceId, nil
 }
+
+// SecretIDFromNames builds an SecretId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vaultName}/{name}/{versionName}", using the specified Subscription ID when this is omitted
+func SecretIDFromNames(subscriptionId, input string) (*SecretId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{name}/{versionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{name}/{versionName}")
+		}
+	}
+
+	resourceId := NewSecretID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func SecretVersionlessID(input string) (*SecretVersionlessId, error) {
 
 	return &resourceId, nil
 }
+
+// SecretVersionlessIDFromNames builds an SecretVersionlessId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vaultName}/{secretName}", using the specified Subscription ID when this is omitted
+func SecretVersionlessIDFromNames(subscriptionId, input string) (*SecretVersionlessId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{secretName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{secretName}")
+		}
+	}
+
+	resourceId := NewSecretVersionlessID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
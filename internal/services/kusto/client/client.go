@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/kusto/mgmt/2022-02-01/kusto"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/2022-07-07/dataconnections"
 )
 
 type Client struct {
@@ -10,6 +11,7 @@ type Client struct {
 	ClustersClient                       *kusto.ClustersClient
 	ClusterManagedPrivateEndpointClient  *kusto.ManagedPrivateEndpointsClient
 	ClusterPrincipalAssignmentsClient    *kusto.ClusterPrincipalAssignmentsClient
+	CosmosDBDataConnectionsClient        *dataconnections.DataConnectionsClient
 	DatabasesClient                      *kusto.DatabasesClient
 	DataConnectionsClient                *kusto.DataConnectionsClient
 	DatabasePrincipalAssignmentsClient   *kusto.DatabasePrincipalAssignmentsClient
@@ -26,6 +28,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	ClusterPrincipalAssignmentsClient := kusto.NewClusterPrincipalAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ClusterPrincipalAssignmentsClient.Client, o.ResourceManagerAuthorizer)
 
+	CosmosDBDataConnectionsClient := dataconnections.NewDataConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&CosmosDBDataConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
 	DatabasesClient := kusto.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&DatabasesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -45,6 +50,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ClustersClient:                       &ClustersClient,
 		ClusterManagedPrivateEndpointClient:  &ClusterManagedPrivateEndpointClient,
 		ClusterPrincipalAssignmentsClient:    &ClusterPrincipalAssignmentsClient,
+		CosmosDBDataConnectionsClient:        &CosmosDBDataConnectionsClient,
 		DatabasesClient:                      &DatabasesClient,
 		DataConnectionsClient:                &DataConnectionsClient,
 		DatabasePrincipalAssignmentsClient:   &DatabasePrincipalAssignmentsClient,
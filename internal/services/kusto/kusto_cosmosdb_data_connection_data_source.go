@@ -0,0 +1,130 @@
+package kusto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/2022-07-07/dataconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceKustoCosmosDBDataConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceKustoCosmosDBDataConnectionRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.DataConnectionName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"cluster_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ClusterName,
+			},
+
+			"database_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.DatabaseName,
+			},
+
+			"location": commonschema.LocationComputed(),
+
+			"cosmosdb_container_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"identity_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"identity_object_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"table_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"mapping_rule_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"retrieval_start_date": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provisioning_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKustoCosmosDBDataConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Kusto.CosmosDBDataConnectionsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDataConnectionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("cluster_name").(string), d.Get("database_name").(string), d.Get("name").(string))
+
+	resp, err := client.Get(ctx, dataconnections.NewDataConnectionID(id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s does not exist", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if resp.Model == nil {
+		return fmt.Errorf("retrieving %s: model was nil", id)
+	}
+
+	dataConnection, ok := (*resp.Model).(dataconnections.CosmosDbDataConnection)
+	if !ok {
+		return fmt.Errorf("%s was not a Cosmos DB Data Connection", id)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("cluster_name", id.ClusterName)
+	d.Set("database_name", id.DatabaseName)
+
+	if err := flattenKustoCosmosDBDataConnection(d, dataConnection); err != nil {
+		return err
+	}
+
+	provisioningState := ""
+	if props := dataConnection.Properties; props != nil && props.ProvisioningState != nil {
+		provisioningState = string(*props.ProvisioningState)
+	}
+	d.Set("provisioning_state", provisioningState)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package kusto_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+func TestAccKustoCosmosDBDataConnectionDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kusto_cosmosdb_data_connection", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: testAccDataSourceKustoCosmosDBDataConnection_basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(KustoCosmosDBDataConnectionResource{}),
+				check.That(data.ResourceName).Key("cosmosdb_container_id").Exists(),
+				check.That(data.ResourceName).Key("table_name").HasValue("TestTable"),
+				check.That(data.ResourceName).Key("identity_object_id").Exists(),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
+			),
+		},
+	})
+}
+
+func testAccDataSourceKustoCosmosDBDataConnection_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_kusto_cosmosdb_data_connection" "test" {
+  name                = azurerm_kusto_cosmosdb_data_connection.test.name
+  resource_group_name = azurerm_kusto_cosmosdb_data_connection.test.resource_group_name
+  cluster_name        = azurerm_kusto_cosmosdb_data_connection.test.cluster_name
+  database_name       = azurerm_kusto_cosmosdb_data_connection.test.database_name
+}
+`, KustoCosmosDBDataConnectionResource{}.basic(data))
+}
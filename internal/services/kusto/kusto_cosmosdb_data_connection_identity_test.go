@@ -0,0 +1,210 @@
+package kusto
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+)
+
+func TestIsCosmosDBDataReaderRoleDefinition(t *testing.T) {
+	accountId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DocumentDB/databaseAccounts/account1"
+
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			// Cosmos DB Built-in Data Reader
+			input:    accountId + "/sqlRoleDefinitions/00000000-0000-0000-0000-000000000001",
+			expected: true,
+		},
+		{
+			// Cosmos DB Built-in Data Contributor
+			input:    accountId + "/sqlRoleDefinitions/00000000-0000-0000-0000-000000000002",
+			expected: true,
+		},
+		{
+			input:    accountId + "/SQLROLEDEFINITIONS/00000000-0000-0000-0000-000000000001",
+			expected: true,
+		},
+		{
+			// a custom Role Definition
+			input:    accountId + "/sqlRoleDefinitions/11111111-1111-1111-1111-111111111111",
+			expected: false,
+		},
+		{
+			input:    accountId + "/sqlRoleDefinitions/00000000-0000-0000-0000-0000000000011",
+			expected: false,
+		},
+		{
+			input:    "00000000-0000-0000-0000-000000000001",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		if actual := isCosmosDBDataReaderRoleDefinition(v.input); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestCosmosDBRoleAssignmentScopeIncludes(t *testing.T) {
+	accountId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.DocumentDB/databaseAccounts/account1"
+	containerScope := accountId + "/dbs/database1/colls/container1"
+
+	testData := []struct {
+		name     string
+		scope    string
+		expected bool
+	}{
+		{
+			name:     "empty",
+			scope:    "",
+			expected: false,
+		},
+		{
+			name:     "account",
+			scope:    accountId,
+			expected: true,
+		},
+		{
+			name:     "account with a trailing slash",
+			scope:    accountId + "/",
+			expected: true,
+		},
+		{
+			name:     "database",
+			scope:    accountId + "/dbs/database1",
+			expected: true,
+		},
+		{
+			name:     "container",
+			scope:    containerScope,
+			expected: true,
+		},
+		{
+			name:     "container with different casing",
+			scope:    accountId + "/DBS/Database1/COLLS/Container1",
+			expected: true,
+		},
+		{
+			name:     "another database",
+			scope:    accountId + "/dbs/database2",
+			expected: false,
+		},
+		{
+			name:     "another database sharing a prefix",
+			scope:    accountId + "/dbs/database",
+			expected: false,
+		},
+		{
+			name:     "another container",
+			scope:    accountId + "/dbs/database1/colls/container2",
+			expected: false,
+		},
+		{
+			name:     "another account sharing a prefix",
+			scope:    accountId[:len(accountId)-1],
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := cosmosDBRoleAssignmentScopeIncludes(v.scope, containerScope); actual != v.expected {
+			t.Fatalf("expected %t for the scope %q but got %t", v.expected, v.scope, actual)
+		}
+	}
+}
+
+func TestCosmosDBPermissionsAllowDataActions(t *testing.T) {
+	testData := []struct {
+		name        string
+		permissions []documentdb.Permission
+		expected    bool
+	}{
+		{
+			name:        "none",
+			permissions: []documentdb.Permission{},
+			expected:    false,
+		},
+		{
+			name: "explicit data actions",
+			permissions: []documentdb.Permission{
+				{
+					DataActions: &[]string{
+						"Microsoft.DocumentDB/databaseAccounts/readMetadata",
+						"Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/readChangeFeed",
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "data actions with different casing across permissions",
+			permissions: []documentdb.Permission{
+				{
+					DataActions: &[]string{"microsoft.documentdb/databaseaccounts/readmetadata"},
+				},
+				{
+					DataActions: &[]string{"Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/readChangeFeed"},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "wildcard",
+			permissions: []documentdb.Permission{
+				{
+					DataActions: &[]string{
+						"Microsoft.DocumentDB/databaseAccounts/readMetadata",
+						"Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/*",
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "missing the change feed",
+			permissions: []documentdb.Permission{
+				{
+					DataActions: &[]string{
+						"Microsoft.DocumentDB/databaseAccounts/readMetadata",
+						"Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/items/read",
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "change feed denied",
+			permissions: []documentdb.Permission{
+				{
+					DataActions: &[]string{
+						"Microsoft.DocumentDB/databaseAccounts/*",
+					},
+					NotDataActions: &[]string{
+						"Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/readChangeFeed",
+					},
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := cosmosDBPermissionsAllowDataActions(v.permissions, cosmosDBChangeFeedDataActions); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
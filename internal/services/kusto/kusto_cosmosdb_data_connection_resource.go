@@ -0,0 +1,454 @@
+package kusto
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	cosmosParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	cosmosValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/2022-07-07/dataconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the built-in Cosmos DB Data Reader & Data Contributor SQL Role Definitions, either of which allows the
+// Kusto Cluster to read the change feed of the Cosmos DB Container
+var cosmosDBDataReaderRoleDefinitionNames = []string{
+	"00000000-0000-0000-0000-000000000001",
+	"00000000-0000-0000-0000-000000000002",
+}
+
+// the Data Actions which a (custom) SQL Role Definition must allow for the Kusto Cluster to read the change
+// feed of the Cosmos DB Container
+var cosmosDBChangeFeedDataActions = []string{
+	"Microsoft.DocumentDB/databaseAccounts/readMetadata",
+	"Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/readChangeFeed",
+}
+
+func resourceKustoCosmosDBDataConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKustoCosmosDBDataConnectionCreateUpdate,
+		Read:   resourceKustoCosmosDBDataConnectionRead,
+		Update: resourceKustoCosmosDBDataConnectionCreateUpdate,
+		Delete: resourceKustoCosmosDBDataConnectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := parse.DataConnectionID(id)
+			return err
+		}, importKustoCosmosDBDataConnection),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			if diff.Id() != "" && !diff.HasChanges("cosmosdb_container_id", "identity_id") {
+				return nil
+			}
+			for _, field := range []string{"name", "resource_group_name", "cluster_name", "database_name", "cosmosdb_container_id", "identity_id"} {
+				if !diff.NewValueKnown(field) {
+					return nil
+				}
+			}
+
+			id := parse.NewDataConnectionID(v.(*clients.Client).Account.SubscriptionId, diff.Get("resource_group_name").(string), diff.Get("cluster_name").(string), diff.Get("database_name").(string), diff.Get("name").(string))
+			containerId, err := cosmosParse.SqlContainerID(diff.Get("cosmosdb_container_id").(string))
+			if err != nil {
+				return err
+			}
+
+			// the identity may be assigned to the Kusto Cluster (or granted the SQL Role) within the same apply,
+			// so this is surfaced as a warning rather than failing the plan
+			if err := validateKustoCosmosDBDataConnectionIdentity(ctx, v, id, *containerId, diff.Get("identity_id").(string)); err != nil {
+				log.Printf("[WARN] %+v - the Kusto Cosmos DB Data Connection %q will not ingest any data until this is resolved", err, id.Name)
+			}
+
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataConnectionName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"cluster_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ClusterName,
+			},
+
+			"database_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DatabaseName,
+			},
+
+			"cosmosdb_container_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: cosmosValidate.SqlContainerID,
+			},
+
+			"identity_id": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.Any(
+					validate.ClusterID,
+					commonids.ValidateUserAssignedIdentityID,
+				),
+			},
+
+			"table_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.EntityName,
+			},
+
+			"mapping_rule_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.EntityName,
+			},
+
+			"retrieval_start_date": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+
+			"identity_object_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKustoCosmosDBDataConnectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Kusto.CosmosDBDataConnectionsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Azure Kusto Cosmos DB Data Connection creation.")
+
+	id := parse.NewDataConnectionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("cluster_name").(string), d.Get("database_name").(string), d.Get("name").(string))
+	sdkId := dataconnections.NewDataConnectionID(id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.Name)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, sdkId)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_kusto_cosmosdb_data_connection", id.ID())
+		}
+	}
+
+	containerId, err := cosmosParse.SqlContainerID(d.Get("cosmosdb_container_id").(string))
+	if err != nil {
+		return err
+	}
+
+	identityId := d.Get("identity_id").(string)
+	accountId := cosmosParse.NewDatabaseAccountID(containerId.SubscriptionId, containerId.ResourceGroup, containerId.DatabaseAccountName)
+	properties := &dataconnections.CosmosDbDataConnectionProperties{
+		CosmosDbAccountResourceId: accountId.ID(),
+		CosmosDbDatabase:          containerId.SqlDatabaseName,
+		CosmosDbContainer:         containerId.ContainerName,
+		ManagedIdentityResourceId: identityId,
+		TableName:                 d.Get("table_name").(string),
+	}
+
+	if mappingRuleName, ok := d.GetOk("mapping_rule_name"); ok {
+		properties.MappingRuleName = utils.String(mappingRuleName.(string))
+	}
+
+	if retrievalStartDate, ok := d.GetOk("retrieval_start_date"); ok {
+		startDate, _ := time.Parse(time.RFC3339, retrievalStartDate.(string))
+		properties.SetRetrievalStartDateAsTime(startDate)
+	}
+
+	dataConnection := dataconnections.CosmosDbDataConnection{
+		Name:       utils.String(id.Name),
+		Location:   utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Properties: properties,
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, sdkId, dataConnection); err != nil {
+		return fmt.Errorf("creating or updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKustoCosmosDBDataConnectionRead(d, meta)
+}
+
+func resourceKustoCosmosDBDataConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Kusto.CosmosDBDataConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, dataconnections.NewDataConnectionID(id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("cluster_name", id.ClusterName)
+	d.Set("database_name", id.DatabaseName)
+
+	if resp.Model != nil {
+		dataConnection, ok := (*resp.Model).(dataconnections.CosmosDbDataConnection)
+		if !ok {
+			return fmt.Errorf("%s was not a Cosmos DB Data Connection", *id)
+		}
+
+		if err := flattenKustoCosmosDBDataConnection(d, dataConnection); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceKustoCosmosDBDataConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Kusto.CosmosDBDataConnectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, dataconnections.NewDataConnectionID(id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.Name)); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func importKustoCosmosDBDataConnection(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	id, err := parse.DataConnectionID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+
+	client := meta.(*clients.Client).Kusto.CosmosDBDataConnectionsClient
+	resp, err := client.Get(ctx, dataconnections.NewDataConnectionID(id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.Name))
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Model != nil {
+		if _, ok := (*resp.Model).(dataconnections.CosmosDbDataConnection); !ok {
+			return nil, fmt.Errorf(`kusto data connection "kind" mismatch, expected "%s"`, dataconnections.DataConnectionKindCosmosDb)
+		}
+	}
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+func flattenKustoCosmosDBDataConnection(d *pluginsdk.ResourceData, input dataconnections.CosmosDbDataConnection) error {
+	if location := input.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := input.Properties; props != nil {
+		accountId, err := cosmosParse.DatabaseAccountID(props.CosmosDbAccountResourceId)
+		if err != nil {
+			return err
+		}
+		containerId := cosmosParse.NewSqlContainerID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, props.CosmosDbDatabase, props.CosmosDbContainer)
+		d.Set("cosmosdb_container_id", containerId.ID())
+
+		d.Set("identity_id", props.ManagedIdentityResourceId)
+		d.Set("identity_object_id", props.ManagedIdentityObjectId)
+		d.Set("table_name", props.TableName)
+		d.Set("mapping_rule_name", props.MappingRuleName)
+
+		retrievalStartDate := ""
+		if v, err := props.GetRetrievalStartDateAsTime(); err == nil && v != nil {
+			retrievalStartDate = v.Format(time.RFC3339)
+		}
+		d.Set("retrieval_start_date", retrievalStartDate)
+	}
+
+	return nil
+}
+
+// validateKustoCosmosDBDataConnectionIdentity checks that the identity used by the Data Connection is assigned to
+// the Kusto Cluster and has been granted a Cosmos DB SQL Role which allows it to read the change feed of the
+// Container - since otherwise the Data Connection is created successfully but silently fails to ingest any data
+func validateKustoCosmosDBDataConnectionIdentity(ctx context.Context, meta interface{}, id parse.DataConnectionId, containerId cosmosParse.SqlContainerId, identityId string) error {
+	clustersClient := meta.(*clients.Client).Kusto.ClustersClient
+
+	// the Cosmos DB Account can be in a different Subscription to the Kusto Cluster
+	sqlClient := meta.(*clients.Client).Cosmos.SqlResourceClientForSubscription(containerId.SubscriptionId)
+
+	cluster, err := clustersClient.Get(ctx, id.ResourceGroup, id.ClusterName)
+	if err != nil {
+		return fmt.Errorf("retrieving Kusto Cluster %q (Resource Group %q): %+v", id.ClusterName, id.ResourceGroup, err)
+	}
+
+	principalId := ""
+	if identity := cluster.Identity; identity != nil {
+		clusterId := parse.NewClusterID(id.SubscriptionId, id.ResourceGroup, id.ClusterName)
+		if strings.EqualFold(identityId, clusterId.ID()) {
+			principalId = utils.NormalizeNilableString(identity.PrincipalID)
+		}
+		for userAssignedId, v := range identity.UserAssignedIdentities {
+			if strings.EqualFold(identityId, userAssignedId) && v != nil {
+				principalId = utils.NormalizeNilableString(v.PrincipalID)
+			}
+		}
+	}
+	if principalId == "" {
+		return fmt.Errorf("the identity %q is not assigned to Kusto Cluster %q (Resource Group %q)", identityId, id.ClusterName, id.ResourceGroup)
+	}
+
+	assignments, err := sqlClient.ListSQLRoleAssignments(ctx, containerId.ResourceGroup, containerId.DatabaseAccountName)
+	if err != nil {
+		return fmt.Errorf("listing SQL Role Assignments for Cosmos DB Account %q (Resource Group %q): %+v", containerId.DatabaseAccountName, containerId.ResourceGroup, err)
+	}
+
+	accountId := cosmosParse.NewDatabaseAccountID(containerId.SubscriptionId, containerId.ResourceGroup, containerId.DatabaseAccountName)
+	containerScope := fmt.Sprintf("%s/dbs/%s/colls/%s", accountId.ID(), containerId.SqlDatabaseName, containerId.ContainerName)
+
+	if assignments.Value != nil {
+		for _, assignment := range *assignments.Value {
+			props := assignment.SQLRoleAssignmentResource
+			if props == nil || !strings.EqualFold(utils.NormalizeNilableString(props.PrincipalID), principalId) {
+				continue
+			}
+			if !cosmosDBRoleAssignmentScopeIncludes(utils.NormalizeNilableString(props.Scope), containerScope) {
+				continue
+			}
+
+			roleDefinitionId := utils.NormalizeNilableString(props.RoleDefinitionID)
+			if roleDefinitionId == "" {
+				continue
+			}
+			if isCosmosDBDataReaderRoleDefinition(roleDefinitionId) {
+				return nil
+			}
+
+			// otherwise this is a custom Role Definition, which needs to allow reading the change feed
+			roleDefinitionName := roleDefinitionId[strings.LastIndex(roleDefinitionId, "/")+1:]
+			roleDefinition, err := sqlClient.GetSQLRoleDefinition(ctx, roleDefinitionName, containerId.ResourceGroup, containerId.DatabaseAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving SQL Role Definition %q for Cosmos DB Account %q (Resource Group %q): %+v", roleDefinitionName, containerId.DatabaseAccountName, containerId.ResourceGroup, err)
+			}
+			if def := roleDefinition.SQLRoleDefinitionResource; def != nil && def.Permissions != nil && cosmosDBPermissionsAllowDataActions(*def.Permissions, cosmosDBChangeFeedDataActions) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("the identity %q (Principal ID %q) must be assigned a Cosmos DB SQL Role which allows the Data Actions %q (such as the Cosmos DB Built-in Data Reader role) on Cosmos DB Account %q (Resource Group %q)", identityId, principalId, strings.Join(cosmosDBChangeFeedDataActions, ", "), containerId.DatabaseAccountName, containerId.ResourceGroup)
+}
+
+func isCosmosDBDataReaderRoleDefinition(input string) bool {
+	for _, name := range cosmosDBDataReaderRoleDefinitionNames {
+		if strings.HasSuffix(strings.ToLower(input), "/sqlroledefinitions/"+name) {
+			return true
+		}
+	}
+	return false
+}
+
+// cosmosDBRoleAssignmentScopeIncludes returns whether a SQL Role Assignment at `scope` grants access to the
+// Container `containerScope` - which is the case for a Role Assignment at the scope of the Account, the
+// Database or the Container itself
+func cosmosDBRoleAssignmentScopeIncludes(scope, containerScope string) bool {
+	scope = strings.TrimSuffix(scope, "/")
+	if scope == "" {
+		return false
+	}
+
+	return strings.EqualFold(containerScope, scope) || strings.HasPrefix(strings.ToLower(containerScope), strings.ToLower(scope)+"/")
+}
+
+// cosmosDBPermissionsAllowDataActions returns whether each of the Data Actions is allowed by one of the
+// Permissions of a SQL Role Definition - that is, matched by its Data Actions and not by its Not Data Actions
+func cosmosDBPermissionsAllowDataActions(permissions []documentdb.Permission, dataActions []string) bool {
+	for _, dataAction := range dataActions {
+		allowed := false
+		for _, permission := range permissions {
+			if permission.DataActions == nil || !cosmosDBDataActionsMatch(*permission.DataActions, dataAction) {
+				continue
+			}
+			if permission.NotDataActions != nil && cosmosDBDataActionsMatch(*permission.NotDataActions, dataAction) {
+				continue
+			}
+
+			allowed = true
+			break
+		}
+
+		if !allowed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cosmosDBDataActionsMatch returns whether the Data Action is matched by any of the patterns, which can end
+// in a wildcard (e.g. `Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/*`)
+func cosmosDBDataActionsMatch(patterns []string, dataAction string) bool {
+	for _, pattern := range patterns {
+		if strings.EqualFold(pattern, dataAction) {
+			return true
+		}
+
+		if strings.HasSuffix(pattern, "*") && strings.HasPrefix(strings.ToLower(dataAction), strings.ToLower(strings.TrimSuffix(pattern, "*"))) {
+			return true
+		}
+	}
+
+	return false
+}
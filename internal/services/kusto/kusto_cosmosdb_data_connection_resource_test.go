@@ -0,0 +1,276 @@
+package kusto_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/2022-07-07/dataconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KustoCosmosDBDataConnectionResource struct{}
+
+func TestAccKustoCosmosDBDataConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_cosmosdb_data_connection", "test")
+	r := KustoCosmosDBDataConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity_object_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKustoCosmosDBDataConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_cosmosdb_data_connection", "test")
+	r := KustoCosmosDBDataConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKustoCosmosDBDataConnection_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_cosmosdb_data_connection", "test")
+	r := KustoCosmosDBDataConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKustoCosmosDBDataConnection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_cosmosdb_data_connection", "test")
+	r := KustoCosmosDBDataConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KustoCosmosDBDataConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DataConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Kusto.CosmosDBDataConnectionsClient.Get(ctx, dataconnections.NewDataConnectionID(id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.Name))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", id.String(), err)
+	}
+
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", id.String())
+	}
+
+	value, ok := (*resp.Model).(dataconnections.CosmosDbDataConnection)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a CosmosDbDataConnection", id.String())
+	}
+
+	return utils.Bool(value.Properties != nil), nil
+}
+
+func (r KustoCosmosDBDataConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_cosmosdb_data_connection" "test" {
+  name                  = "acctestkcdc-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  cluster_name          = azurerm_kusto_cluster.test.name
+  database_name         = azurerm_kusto_database.test.name
+  cosmosdb_container_id = azurerm_cosmosdb_sql_container.test.id
+  identity_id           = azurerm_kusto_cluster.test.id
+  table_name            = "TestTable"
+
+  depends_on = [azurerm_cosmosdb_sql_role_assignment.test, azurerm_kusto_script.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KustoCosmosDBDataConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_cosmosdb_data_connection" "import" {
+  name                  = azurerm_kusto_cosmosdb_data_connection.test.name
+  resource_group_name   = azurerm_kusto_cosmosdb_data_connection.test.resource_group_name
+  location              = azurerm_kusto_cosmosdb_data_connection.test.location
+  cluster_name          = azurerm_kusto_cosmosdb_data_connection.test.cluster_name
+  database_name         = azurerm_kusto_cosmosdb_data_connection.test.database_name
+  cosmosdb_container_id = azurerm_kusto_cosmosdb_data_connection.test.cosmosdb_container_id
+  identity_id           = azurerm_kusto_cosmosdb_data_connection.test.identity_id
+  table_name            = azurerm_kusto_cosmosdb_data_connection.test.table_name
+}
+`, r.basic(data))
+}
+
+func (r KustoCosmosDBDataConnectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_cosmosdb_data_connection" "test" {
+  name                  = "acctestkcdc-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  cluster_name          = azurerm_kusto_cluster.test.name
+  database_name         = azurerm_kusto_database.test.name
+  cosmosdb_container_id = azurerm_cosmosdb_sql_container.test.id
+  identity_id           = azurerm_kusto_cluster.test.id
+  table_name            = "TestTable"
+  mapping_rule_name     = "TestMapping"
+  retrieval_start_date  = "2023-06-26T12:00:00Z"
+
+  depends_on = [azurerm_cosmosdb_sql_role_assignment.test, azurerm_kusto_script.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r KustoCosmosDBDataConnectionResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_cosmosdb_data_connection" "test" {
+  name                  = "acctestkcdc-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  cluster_name          = azurerm_kusto_cluster.test.name
+  database_name         = azurerm_kusto_database.test.name
+  cosmosdb_container_id = azurerm_cosmosdb_sql_container.test.id
+  identity_id           = azurerm_kusto_cluster.test.id
+  table_name            = "TestTable"
+  mapping_rule_name     = "TestMapping"
+
+  depends_on = [azurerm_cosmosdb_sql_role_assignment.test, azurerm_kusto_script.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (KustoCosmosDBDataConnectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-ca-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "GlobalDocumentDB"
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+}
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-%[1]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-CSQLC-%[1]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_sql_database.test.name
+  partition_key_path  = "/definition/id"
+}
+
+resource "azurerm_kusto_cluster" "test" {
+  name                = "acctestkc%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_kusto_database" "test" {
+  name                = "acctestkd-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  cluster_name        = azurerm_kusto_cluster.test.name
+}
+
+resource "azurerm_kusto_script" "test" {
+  name        = "create-table-script"
+  database_id = azurerm_kusto_database.test.id
+
+  script_content = <<SCRIPT
+.create table TestTable(Id:string, Name:string, _ts:long, _timestamp:datetime)
+.create table TestTable ingestion json mapping "TestMapping"
+'['
+'    {"column":"Id","path":"$.id"},'
+'    {"column":"Name","path":"$.name"},'
+'    {"column":"_ts","path":"$._ts"},'
+'    {"column":"_timestamp","path":"$._ts", "transform":"DateTimeFromUnixSeconds"}'
+']'
+.alter table TestTable policy ingestionbatching "{'MaximumBatchingTimeSpan': '0:0:10', 'MaximumNumberOfItems': 10000}"
+SCRIPT
+}
+
+resource "azurerm_cosmosdb_sql_role_assignment" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_cosmosdb_account.test.name
+  role_definition_id  = "${azurerm_cosmosdb_account.test.id}/sqlRoleDefinitions/00000000-0000-0000-0000-000000000001"
+  principal_id        = azurerm_kusto_cluster.test.identity.0.principal_id
+  scope               = azurerm_cosmosdb_account.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
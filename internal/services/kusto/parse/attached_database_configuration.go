@@ -73,3 +73,23 @@ func AttachedDatabaseConfigurationID(input string) (*AttachedDatabaseConfigurati
 
 	return &resourceId, nil
 }
+
+// AttachedDatabaseConfigurationIDFromNames builds an AttachedDatabaseConfigurationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{clusterName}/{name}", using the specified Subscription ID when this is omitted
+func AttachedDatabaseConfigurationIDFromNames(subscriptionId, input string) (*AttachedDatabaseConfigurationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{clusterName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{clusterName}/{name}")
+		}
+	}
+
+	resourceId := NewAttachedDatabaseConfigurationID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func ClusterPrincipalAssignmentID(input string) (*ClusterPrincipalAssignmentId,
 
 	return &resourceId, nil
 }
+
+// ClusterPrincipalAssignmentIDFromNames builds an ClusterPrincipalAssignmentId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{clusterName}/{principalAssignmentName}", using the specified Subscription ID when this is omitted
+func ClusterPrincipalAssignmentIDFromNames(subscriptionId, input string) (*ClusterPrincipalAssignmentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{clusterName}/{principalAssignmentName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{clusterName}/{principalAssignmentName}")
+		}
+	}
+
+	resourceId := NewClusterPrincipalAssignmentID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
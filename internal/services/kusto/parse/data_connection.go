@@ -79,3 +79,23 @@ func DataConnectionID(input string) (*DataConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// DataConnectionIDFromNames builds an DataConnectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{clusterName}/{databaseName}/{name}", using the specified Subscription ID when this is omitted
+func DataConnectionIDFromNames(subscriptionId, input string) (*DataConnectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{clusterName}/{databaseName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{clusterName}/{databaseName}/{name}")
+		}
+	}
+
+	resourceId := NewDataConnectionID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
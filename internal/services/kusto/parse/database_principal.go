@@ -85,3 +85,23 @@ func DatabasePrincipalID(input string) (*DatabasePrincipalId, error) {
 
 	return &resourceId, nil
 }
+
+// DatabasePrincipalIDFromNames builds an DatabasePrincipalId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{clusterName}/{databaseName}/{roleName}/{fQNName}", using the specified Subscription ID when this is omitted
+func DatabasePrincipalIDFromNames(subscriptionId, input string) (*DatabasePrincipalId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 5 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 6 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{clusterName}/{databaseName}/{roleName}/{fQNName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{clusterName}/{databaseName}/{roleName}/{fQNName}")
+		}
+	}
+
+	resourceId := NewDatabasePrincipalID(names[0], names[1], names[2], names[3], names[4], names[5])
+	return &resourceId, nil
+}
@@ -158,3 +158,97 @@ func TestDatabasePrincipalID(t *testing.T) {
 		}
 	}
 }
+
+func TestDatabasePrincipalIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *DatabasePrincipalId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resGroup1/cluster1/database1/Viewer",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "12345678-1234-9876-4563-123456789012/resGroup1/cluster1/database1/Viewer/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resGroup1/cluster1/database1/Viewer/aaduser=11111111-1111-1111-1111-111111111111;22222222-2222-2222-2222-222222222222",
+			Expected: &DatabasePrincipalId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ClusterName:    "cluster1",
+				DatabaseName:   "database1",
+				RoleName:       "Viewer",
+				FQNName:        "aaduser=11111111-1111-1111-1111-111111111111;22222222-2222-2222-2222-222222222222",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "12345678-1234-9876-4563-123456789012/resGroup1/cluster1/database1/Viewer/aaduser=11111111-1111-1111-1111-111111111111;22222222-2222-2222-2222-222222222222",
+			Expected: &DatabasePrincipalId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ClusterName:    "cluster1",
+				DatabaseName:   "database1",
+				RoleName:       "Viewer",
+				FQNName:        "aaduser=11111111-1111-1111-1111-111111111111;22222222-2222-2222-2222-222222222222",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := DatabasePrincipalIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.ClusterName != v.Expected.ClusterName {
+			t.Fatalf("Expected %q but got %q for ClusterName", v.Expected.ClusterName, actual.ClusterName)
+		}
+		if actual.DatabaseName != v.Expected.DatabaseName {
+			t.Fatalf("Expected %q but got %q for DatabaseName", v.Expected.DatabaseName, actual.DatabaseName)
+		}
+		if actual.RoleName != v.Expected.RoleName {
+			t.Fatalf("Expected %q but got %q for RoleName", v.Expected.RoleName, actual.RoleName)
+		}
+		if actual.FQNName != v.Expected.FQNName {
+			t.Fatalf("Expected %q but got %q for FQNName", v.Expected.FQNName, actual.FQNName)
+		}
+	}
+}
@@ -73,3 +73,23 @@ func ManagedPrivateEndpointsID(input string) (*ManagedPrivateEndpointsId, error)
 
 	return &resourceId, nil
 }
+
+// ManagedPrivateEndpointsIDFromNames builds an ManagedPrivateEndpointsId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{clusterName}/{managedPrivateEndpointName}", using the specified Subscription ID when this is omitted
+func ManagedPrivateEndpointsIDFromNames(subscriptionId, input string) (*ManagedPrivateEndpointsId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{clusterName}/{managedPrivateEndpointName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{clusterName}/{managedPrivateEndpointName}")
+		}
+	}
+
+	resourceId := NewManagedPrivateEndpointsID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
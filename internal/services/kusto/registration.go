@@ -28,8 +28,9 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_kusto_cluster":  dataSourceKustoCluster(),
-		"azurerm_kusto_database": dataSourceKustoDatabase(),
+		"azurerm_kusto_cluster":                  dataSourceKustoCluster(),
+		"azurerm_kusto_cosmosdb_data_connection": dataSourceKustoCosmosDBDataConnection(),
+		"azurerm_kusto_database":                 dataSourceKustoDatabase(),
 	}
 }
 
@@ -40,6 +41,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_kusto_cluster_customer_managed_key":     resourceKustoClusterCustomerManagedKey(),
 		"azurerm_kusto_cluster_managed_private_endpoint": resourceKustoClusterManagedPrivateEndpoint(),
 		"azurerm_kusto_cluster_principal_assignment":     resourceKustoClusterPrincipalAssignment(),
+		"azurerm_kusto_cosmosdb_data_connection":         resourceKustoCosmosDBDataConnection(),
 		"azurerm_kusto_database":                         resourceKustoDatabase(),
 		"azurerm_kusto_database_principal_assignment":    resourceKustoDatabasePrincipalAssignment(),
 		"azurerm_kusto_eventgrid_data_connection":        resourceKustoEventGridDataConnection(),
@@ -0,0 +1,15 @@
+package dataconnections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DataConnectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDataConnectionsClientWithBaseURI(endpoint string) DataConnectionsClient {
+	return DataConnectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,399 @@
+package dataconnections
+
+import "strings"
+
+type BlobStorageEventType string
+
+const (
+	BlobStorageEventTypeMicrosoftPointStoragePointBlobCreated BlobStorageEventType = "Microsoft.Storage.BlobCreated"
+	BlobStorageEventTypeMicrosoftPointStoragePointBlobRenamed BlobStorageEventType = "Microsoft.Storage.BlobRenamed"
+)
+
+func PossibleValuesForBlobStorageEventType() []string {
+	return []string{
+		string(BlobStorageEventTypeMicrosoftPointStoragePointBlobCreated),
+		string(BlobStorageEventTypeMicrosoftPointStoragePointBlobRenamed),
+	}
+}
+
+func parseBlobStorageEventType(input string) (*BlobStorageEventType, error) {
+	vals := map[string]BlobStorageEventType{
+		"microsoft.storage.blobcreated": BlobStorageEventTypeMicrosoftPointStoragePointBlobCreated,
+		"microsoft.storage.blobrenamed": BlobStorageEventTypeMicrosoftPointStoragePointBlobRenamed,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := BlobStorageEventType(input)
+	return &out, nil
+}
+
+type Compression string
+
+const (
+	CompressionGZip Compression = "GZip"
+	CompressionNone Compression = "None"
+)
+
+func PossibleValuesForCompression() []string {
+	return []string{
+		string(CompressionGZip),
+		string(CompressionNone),
+	}
+}
+
+func parseCompression(input string) (*Compression, error) {
+	vals := map[string]Compression{
+		"gzip": CompressionGZip,
+		"none": CompressionNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Compression(input)
+	return &out, nil
+}
+
+type DataConnectionKind string
+
+const (
+	DataConnectionKindCosmosDb  DataConnectionKind = "CosmosDb"
+	DataConnectionKindEventGrid DataConnectionKind = "EventGrid"
+	DataConnectionKindEventHub  DataConnectionKind = "EventHub"
+	DataConnectionKindIotHub    DataConnectionKind = "IotHub"
+)
+
+func PossibleValuesForDataConnectionKind() []string {
+	return []string{
+		string(DataConnectionKindCosmosDb),
+		string(DataConnectionKindEventGrid),
+		string(DataConnectionKindEventHub),
+		string(DataConnectionKindIotHub),
+	}
+}
+
+func parseDataConnectionKind(input string) (*DataConnectionKind, error) {
+	vals := map[string]DataConnectionKind{
+		"cosmosdb":  DataConnectionKindCosmosDb,
+		"eventgrid": DataConnectionKindEventGrid,
+		"eventhub":  DataConnectionKindEventHub,
+		"iothub":    DataConnectionKindIotHub,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DataConnectionKind(input)
+	return &out, nil
+}
+
+type DataConnectionType string
+
+const (
+	DataConnectionTypeMicrosoftPointKustoClustersDatabasesDataConnections DataConnectionType = "Microsoft.Kusto/clusters/databases/dataConnections"
+)
+
+func PossibleValuesForDataConnectionType() []string {
+	return []string{
+		string(DataConnectionTypeMicrosoftPointKustoClustersDatabasesDataConnections),
+	}
+}
+
+func parseDataConnectionType(input string) (*DataConnectionType, error) {
+	vals := map[string]DataConnectionType{
+		"microsoft.kusto/clusters/databases/dataconnections": DataConnectionTypeMicrosoftPointKustoClustersDatabasesDataConnections,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DataConnectionType(input)
+	return &out, nil
+}
+
+type EventGridDataFormat string
+
+const (
+	EventGridDataFormatAPACHEAVRO     EventGridDataFormat = "APACHEAVRO"
+	EventGridDataFormatAVRO           EventGridDataFormat = "AVRO"
+	EventGridDataFormatCSV            EventGridDataFormat = "CSV"
+	EventGridDataFormatJSON           EventGridDataFormat = "JSON"
+	EventGridDataFormatMULTIJSON      EventGridDataFormat = "MULTIJSON"
+	EventGridDataFormatORC            EventGridDataFormat = "ORC"
+	EventGridDataFormatPARQUET        EventGridDataFormat = "PARQUET"
+	EventGridDataFormatPSV            EventGridDataFormat = "PSV"
+	EventGridDataFormatRAW            EventGridDataFormat = "RAW"
+	EventGridDataFormatSCSV           EventGridDataFormat = "SCSV"
+	EventGridDataFormatSINGLEJSON     EventGridDataFormat = "SINGLEJSON"
+	EventGridDataFormatSOHSV          EventGridDataFormat = "SOHSV"
+	EventGridDataFormatTSV            EventGridDataFormat = "TSV"
+	EventGridDataFormatTSVE           EventGridDataFormat = "TSVE"
+	EventGridDataFormatTXT            EventGridDataFormat = "TXT"
+	EventGridDataFormatWThreeCLOGFILE EventGridDataFormat = "W3CLOGFILE"
+)
+
+func PossibleValuesForEventGridDataFormat() []string {
+	return []string{
+		string(EventGridDataFormatAPACHEAVRO),
+		string(EventGridDataFormatAVRO),
+		string(EventGridDataFormatCSV),
+		string(EventGridDataFormatJSON),
+		string(EventGridDataFormatMULTIJSON),
+		string(EventGridDataFormatORC),
+		string(EventGridDataFormatPARQUET),
+		string(EventGridDataFormatPSV),
+		string(EventGridDataFormatRAW),
+		string(EventGridDataFormatSCSV),
+		string(EventGridDataFormatSINGLEJSON),
+		string(EventGridDataFormatSOHSV),
+		string(EventGridDataFormatTSV),
+		string(EventGridDataFormatTSVE),
+		string(EventGridDataFormatTXT),
+		string(EventGridDataFormatWThreeCLOGFILE),
+	}
+}
+
+func parseEventGridDataFormat(input string) (*EventGridDataFormat, error) {
+	vals := map[string]EventGridDataFormat{
+		"apacheavro": EventGridDataFormatAPACHEAVRO,
+		"avro":       EventGridDataFormatAVRO,
+		"csv":        EventGridDataFormatCSV,
+		"json":       EventGridDataFormatJSON,
+		"multijson":  EventGridDataFormatMULTIJSON,
+		"orc":        EventGridDataFormatORC,
+		"parquet":    EventGridDataFormatPARQUET,
+		"psv":        EventGridDataFormatPSV,
+		"raw":        EventGridDataFormatRAW,
+		"scsv":       EventGridDataFormatSCSV,
+		"singlejson": EventGridDataFormatSINGLEJSON,
+		"sohsv":      EventGridDataFormatSOHSV,
+		"tsv":        EventGridDataFormatTSV,
+		"tsve":       EventGridDataFormatTSVE,
+		"txt":        EventGridDataFormatTXT,
+		"w3clogfile": EventGridDataFormatWThreeCLOGFILE,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EventGridDataFormat(input)
+	return &out, nil
+}
+
+type EventHubDataFormat string
+
+const (
+	EventHubDataFormatAPACHEAVRO     EventHubDataFormat = "APACHEAVRO"
+	EventHubDataFormatAVRO           EventHubDataFormat = "AVRO"
+	EventHubDataFormatCSV            EventHubDataFormat = "CSV"
+	EventHubDataFormatJSON           EventHubDataFormat = "JSON"
+	EventHubDataFormatMULTIJSON      EventHubDataFormat = "MULTIJSON"
+	EventHubDataFormatORC            EventHubDataFormat = "ORC"
+	EventHubDataFormatPARQUET        EventHubDataFormat = "PARQUET"
+	EventHubDataFormatPSV            EventHubDataFormat = "PSV"
+	EventHubDataFormatRAW            EventHubDataFormat = "RAW"
+	EventHubDataFormatSCSV           EventHubDataFormat = "SCSV"
+	EventHubDataFormatSINGLEJSON     EventHubDataFormat = "SINGLEJSON"
+	EventHubDataFormatSOHSV          EventHubDataFormat = "SOHSV"
+	EventHubDataFormatTSV            EventHubDataFormat = "TSV"
+	EventHubDataFormatTSVE           EventHubDataFormat = "TSVE"
+	EventHubDataFormatTXT            EventHubDataFormat = "TXT"
+	EventHubDataFormatWThreeCLOGFILE EventHubDataFormat = "W3CLOGFILE"
+)
+
+func PossibleValuesForEventHubDataFormat() []string {
+	return []string{
+		string(EventHubDataFormatAPACHEAVRO),
+		string(EventHubDataFormatAVRO),
+		string(EventHubDataFormatCSV),
+		string(EventHubDataFormatJSON),
+		string(EventHubDataFormatMULTIJSON),
+		string(EventHubDataFormatORC),
+		string(EventHubDataFormatPARQUET),
+		string(EventHubDataFormatPSV),
+		string(EventHubDataFormatRAW),
+		string(EventHubDataFormatSCSV),
+		string(EventHubDataFormatSINGLEJSON),
+		string(EventHubDataFormatSOHSV),
+		string(EventHubDataFormatTSV),
+		string(EventHubDataFormatTSVE),
+		string(EventHubDataFormatTXT),
+		string(EventHubDataFormatWThreeCLOGFILE),
+	}
+}
+
+func parseEventHubDataFormat(input string) (*EventHubDataFormat, error) {
+	vals := map[string]EventHubDataFormat{
+		"apacheavro": EventHubDataFormatAPACHEAVRO,
+		"avro":       EventHubDataFormatAVRO,
+		"csv":        EventHubDataFormatCSV,
+		"json":       EventHubDataFormatJSON,
+		"multijson":  EventHubDataFormatMULTIJSON,
+		"orc":        EventHubDataFormatORC,
+		"parquet":    EventHubDataFormatPARQUET,
+		"psv":        EventHubDataFormatPSV,
+		"raw":        EventHubDataFormatRAW,
+		"scsv":       EventHubDataFormatSCSV,
+		"singlejson": EventHubDataFormatSINGLEJSON,
+		"sohsv":      EventHubDataFormatSOHSV,
+		"tsv":        EventHubDataFormatTSV,
+		"tsve":       EventHubDataFormatTSVE,
+		"txt":        EventHubDataFormatTXT,
+		"w3clogfile": EventHubDataFormatWThreeCLOGFILE,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EventHubDataFormat(input)
+	return &out, nil
+}
+
+type IotHubDataFormat string
+
+const (
+	IotHubDataFormatAPACHEAVRO     IotHubDataFormat = "APACHEAVRO"
+	IotHubDataFormatAVRO           IotHubDataFormat = "AVRO"
+	IotHubDataFormatCSV            IotHubDataFormat = "CSV"
+	IotHubDataFormatJSON           IotHubDataFormat = "JSON"
+	IotHubDataFormatMULTIJSON      IotHubDataFormat = "MULTIJSON"
+	IotHubDataFormatORC            IotHubDataFormat = "ORC"
+	IotHubDataFormatPARQUET        IotHubDataFormat = "PARQUET"
+	IotHubDataFormatPSV            IotHubDataFormat = "PSV"
+	IotHubDataFormatRAW            IotHubDataFormat = "RAW"
+	IotHubDataFormatSCSV           IotHubDataFormat = "SCSV"
+	IotHubDataFormatSINGLEJSON     IotHubDataFormat = "SINGLEJSON"
+	IotHubDataFormatSOHSV          IotHubDataFormat = "SOHSV"
+	IotHubDataFormatTSV            IotHubDataFormat = "TSV"
+	IotHubDataFormatTSVE           IotHubDataFormat = "TSVE"
+	IotHubDataFormatTXT            IotHubDataFormat = "TXT"
+	IotHubDataFormatWThreeCLOGFILE IotHubDataFormat = "W3CLOGFILE"
+)
+
+func PossibleValuesForIotHubDataFormat() []string {
+	return []string{
+		string(IotHubDataFormatAPACHEAVRO),
+		string(IotHubDataFormatAVRO),
+		string(IotHubDataFormatCSV),
+		string(IotHubDataFormatJSON),
+		string(IotHubDataFormatMULTIJSON),
+		string(IotHubDataFormatORC),
+		string(IotHubDataFormatPARQUET),
+		string(IotHubDataFormatPSV),
+		string(IotHubDataFormatRAW),
+		string(IotHubDataFormatSCSV),
+		string(IotHubDataFormatSINGLEJSON),
+		string(IotHubDataFormatSOHSV),
+		string(IotHubDataFormatTSV),
+		string(IotHubDataFormatTSVE),
+		string(IotHubDataFormatTXT),
+		string(IotHubDataFormatWThreeCLOGFILE),
+	}
+}
+
+func parseIotHubDataFormat(input string) (*IotHubDataFormat, error) {
+	vals := map[string]IotHubDataFormat{
+		"apacheavro": IotHubDataFormatAPACHEAVRO,
+		"avro":       IotHubDataFormatAVRO,
+		"csv":        IotHubDataFormatCSV,
+		"json":       IotHubDataFormatJSON,
+		"multijson":  IotHubDataFormatMULTIJSON,
+		"orc":        IotHubDataFormatORC,
+		"parquet":    IotHubDataFormatPARQUET,
+		"psv":        IotHubDataFormatPSV,
+		"raw":        IotHubDataFormatRAW,
+		"scsv":       IotHubDataFormatSCSV,
+		"singlejson": IotHubDataFormatSINGLEJSON,
+		"sohsv":      IotHubDataFormatSOHSV,
+		"tsv":        IotHubDataFormatTSV,
+		"tsve":       IotHubDataFormatTSVE,
+		"txt":        IotHubDataFormatTXT,
+		"w3clogfile": IotHubDataFormatWThreeCLOGFILE,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IotHubDataFormat(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateMoving    ProvisioningState = "Moving"
+	ProvisioningStateRunning   ProvisioningState = "Running"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateMoving),
+		string(ProvisioningStateRunning),
+		string(ProvisioningStateSucceeded),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"moving":    ProvisioningStateMoving,
+		"running":   ProvisioningStateRunning,
+		"succeeded": ProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type Reason string
+
+const (
+	ReasonAlreadyExists Reason = "AlreadyExists"
+	ReasonInvalid       Reason = "Invalid"
+)
+
+func PossibleValuesForReason() []string {
+	return []string{
+		string(ReasonAlreadyExists),
+		string(ReasonInvalid),
+	}
+}
+
+func parseReason(input string) (*Reason, error) {
+	vals := map[string]Reason{
+		"alreadyexists": ReasonAlreadyExists,
+		"invalid":       ReasonInvalid,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Reason(input)
+	return &out, nil
+}
@@ -0,0 +1,137 @@
+package dataconnections
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = DatabaseId{}
+
+// DatabaseId is a struct representing the Resource ID for a Database
+type DatabaseId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ClusterName       string
+	DatabaseName      string
+}
+
+// NewDatabaseID returns a new DatabaseId struct
+func NewDatabaseID(subscriptionId string, resourceGroupName string, clusterName string, databaseName string) DatabaseId {
+	return DatabaseId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		ClusterName:       clusterName,
+		DatabaseName:      databaseName,
+	}
+}
+
+// ParseDatabaseID parses 'input' into a DatabaseId
+func ParseDatabaseID(input string) (*DatabaseId, error) {
+	parser := resourceids.NewParserFromResourceIdType(DatabaseId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := DatabaseId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ClusterName, ok = parsed.Parsed["clusterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'clusterName' was not found in the resource id %q", input)
+	}
+
+	if id.DatabaseName, ok = parsed.Parsed["databaseName"]; !ok {
+		return nil, fmt.Errorf("the segment 'databaseName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseDatabaseIDInsensitively parses 'input' case-insensitively into a DatabaseId
+// note: this method should only be used for API response data and not user input
+func ParseDatabaseIDInsensitively(input string) (*DatabaseId, error) {
+	parser := resourceids.NewParserFromResourceIdType(DatabaseId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := DatabaseId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ClusterName, ok = parsed.Parsed["clusterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'clusterName' was not found in the resource id %q", input)
+	}
+
+	if id.DatabaseName, ok = parsed.Parsed["databaseName"]; !ok {
+		return nil, fmt.Errorf("the segment 'databaseName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateDatabaseID checks that 'input' can be parsed as a Database ID
+func ValidateDatabaseID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseDatabaseID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Database ID
+func (id DatabaseId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Kusto/clusters/%s/databases/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ClusterName, id.DatabaseName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Database ID
+func (id DatabaseId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftKusto", "Microsoft.Kusto", "Microsoft.Kusto"),
+		resourceids.StaticSegment("staticClusters", "clusters", "clusters"),
+		resourceids.UserSpecifiedSegment("clusterName", "clusterValue"),
+		resourceids.StaticSegment("staticDatabases", "databases", "databases"),
+		resourceids.UserSpecifiedSegment("databaseName", "databaseValue"),
+	}
+}
+
+// String returns a human-readable description of this Database ID
+func (id DatabaseId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Cluster Name: %q", id.ClusterName),
+		fmt.Sprintf("Database Name: %q", id.DatabaseName),
+	}
+	return fmt.Sprintf("Database (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,67 @@
+package dataconnections
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CheckNameAvailabilityOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *CheckNameResult
+}
+
+// CheckNameAvailability ...
+func (c DataConnectionsClient) CheckNameAvailability(ctx context.Context, id DatabaseId, input DataConnectionCheckNameRequest) (result CheckNameAvailabilityOperationResponse, err error) {
+	req, err := c.preparerForCheckNameAvailability(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataconnections.DataConnectionsClient", "CheckNameAvailability", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataconnections.DataConnectionsClient", "CheckNameAvailability", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCheckNameAvailability(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataconnections.DataConnectionsClient", "CheckNameAvailability", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCheckNameAvailability prepares the CheckNameAvailability request.
+func (c DataConnectionsClient) preparerForCheckNameAvailability(ctx context.Context, id DatabaseId, input DataConnectionCheckNameRequest) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/checkNameAvailability", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCheckNameAvailability handles the response to the CheckNameAvailability request. The method always
+// closes the http.Response Body.
+func (c DataConnectionsClient) responderForCheckNameAvailability(resp *http.Response) (result CheckNameAvailabilityOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
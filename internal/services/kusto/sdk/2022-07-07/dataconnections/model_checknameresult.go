@@ -0,0 +1,8 @@
+package dataconnections
+
+type CheckNameResult struct {
+	Message       *string `json:"message,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	NameAvailable *bool   `json:"nameAvailable,omitempty"`
+	Reason        *Reason `json:"reason,omitempty"`
+}
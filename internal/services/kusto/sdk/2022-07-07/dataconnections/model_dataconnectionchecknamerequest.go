@@ -0,0 +1,6 @@
+package dataconnections
+
+type DataConnectionCheckNameRequest struct {
+	Name string             `json:"name"`
+	Type DataConnectionType `json:"type"`
+}
@@ -0,0 +1,5 @@
+package dataconnections
+
+type DataConnectionValidationListResult struct {
+	Value *[]DataConnectionValidationResult `json:"value,omitempty"`
+}
@@ -0,0 +1,5 @@
+package dataconnections
+
+type DataConnectionValidationResult struct {
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+}
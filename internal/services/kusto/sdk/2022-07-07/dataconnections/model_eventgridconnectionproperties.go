@@ -0,0 +1,13 @@
+package dataconnections
+
+type EventGridConnectionProperties struct {
+	BlobStorageEventType     *BlobStorageEventType `json:"blobStorageEventType,omitempty"`
+	ConsumerGroup            string                `json:"consumerGroup"`
+	DataFormat               *EventGridDataFormat  `json:"dataFormat,omitempty"`
+	EventHubResourceId       string                `json:"eventHubResourceId"`
+	IgnoreFirstRecord        *bool                 `json:"ignoreFirstRecord,omitempty"`
+	MappingRuleName          *string               `json:"mappingRuleName,omitempty"`
+	ProvisioningState        *ProvisioningState    `json:"provisioningState,omitempty"`
+	StorageAccountResourceId string                `json:"storageAccountResourceId"`
+	TableName                *string               `json:"tableName,omitempty"`
+}
@@ -0,0 +1,12 @@
+package dataconnections
+
+type IotHubConnectionProperties struct {
+	ConsumerGroup          string             `json:"consumerGroup"`
+	DataFormat             *IotHubDataFormat  `json:"dataFormat,omitempty"`
+	EventSystemProperties  *[]string          `json:"eventSystemProperties,omitempty"`
+	IotHubResourceId       string             `json:"iotHubResourceId"`
+	MappingRuleName        *string            `json:"mappingRuleName,omitempty"`
+	ProvisioningState      *ProvisioningState `json:"provisioningState,omitempty"`
+	SharedAccessPolicyName string             `json:"sharedAccessPolicyName"`
+	TableName              *string            `json:"tableName,omitempty"`
+}
@@ -0,0 +1,9 @@
+package dataconnections
+
+import "fmt"
+
+const defaultApiVersion = "2022-07-07"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/dataconnections/%s", defaultApiVersion)
+}
@@ -79,3 +79,23 @@ func BackendAddressPoolAddressID(input string) (*BackendAddressPoolAddressId, er
 
 	return &resourceId, nil
 }
+
+// BackendAddressPoolAddressIDFromNames builds an BackendAddressPoolAddressId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{backendAddressPoolName}/{addressName}", using the specified Subscription ID when this is omitted
+func BackendAddressPoolAddressIDFromNames(subscriptionId, input string) (*BackendAddressPoolAddressId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{backendAddressPoolName}/{addressName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{backendAddressPoolName}/{addressName}")
+		}
+	}
+
+	resourceId := NewBackendAddressPoolAddressID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func LoadBalancerFrontendIpConfigurationID(input string) (*LoadBalancerFrontendI
 
 	return &resourceId, nil
 }
+
+// LoadBalancerFrontendIpConfigurationIDFromNames builds an LoadBalancerFrontendIpConfigurationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{frontendIPConfigurationName}", using the specified Subscription ID when this is omitted
+func LoadBalancerFrontendIpConfigurationIDFromNames(subscriptionId, input string) (*LoadBalancerFrontendIpConfigurationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{frontendIPConfigurationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{frontendIPConfigurationName}")
+		}
+	}
+
+	resourceId := NewLoadBalancerFrontendIpConfigurationID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func LoadBalancerInboundNatPoolID(input string) (*LoadBalancerInboundNatPoolId,
 
 	return &resourceId, nil
 }
+
+// LoadBalancerInboundNatPoolIDFromNames builds an LoadBalancerInboundNatPoolId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{inboundNatPoolName}", using the specified Subscription ID when this is omitted
+func LoadBalancerInboundNatPoolIDFromNames(subscriptionId, input string) (*LoadBalancerInboundNatPoolId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{inboundNatPoolName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{inboundNatPoolName}")
+		}
+	}
+
+	resourceId := NewLoadBalancerInboundNatPoolID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
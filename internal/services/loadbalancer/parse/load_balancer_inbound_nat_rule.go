@@ -73,3 +73,23 @@ func LoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleId,
 
 	return &resourceId, nil
 }
+
+// LoadBalancerInboundNatRuleIDFromNames builds an LoadBalancerInboundNatRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{inboundNatRuleName}", using the specified Subscription ID when this is omitted
+func LoadBalancerInboundNatRuleIDFromNames(subscriptionId, input string) (*LoadBalancerInboundNatRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{inboundNatRuleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{inboundNatRuleName}")
+		}
+	}
+
+	resourceId := NewLoadBalancerInboundNatRuleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
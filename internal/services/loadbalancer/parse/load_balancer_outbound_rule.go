@@ -73,3 +73,23 @@ func LoadBalancerOutboundRuleID(input string) (*LoadBalancerOutboundRuleId, erro
 
 	return &resourceId, nil
 }
+
+// LoadBalancerOutboundRuleIDFromNames builds an LoadBalancerOutboundRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{outboundRuleName}", using the specified Subscription ID when this is omitted
+func LoadBalancerOutboundRuleIDFromNames(subscriptionId, input string) (*LoadBalancerOutboundRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{outboundRuleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{outboundRuleName}")
+		}
+	}
+
+	resourceId := NewLoadBalancerOutboundRuleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func LoadBalancerProbeID(input string) (*LoadBalancerProbeId, error) {
 
 	return &resourceId, nil
 }
+
+// LoadBalancerProbeIDFromNames builds an LoadBalancerProbeId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{probeName}", using the specified Subscription ID when this is omitted
+func LoadBalancerProbeIDFromNames(subscriptionId, input string) (*LoadBalancerProbeId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{probeName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{probeName}")
+		}
+	}
+
+	resourceId := NewLoadBalancerProbeID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
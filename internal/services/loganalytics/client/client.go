@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationsmanagement/2015-11-01-preview/solution"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2022-10-01/tables"
 )
 
 type Client struct {
@@ -25,6 +26,7 @@ type Client struct {
 	SavedSearchesClient        *savedsearches.SavedSearchesClient
 	SolutionsClient            *solution.SolutionClient
 	StorageInsightsClient      *storageinsights.StorageInsightsClient
+	TablesClient               *tables.TablesClient
 	QueryPackQueriesClient     *querypackqueries.QueryPackQueriesClient
 	WorkspacesClient           *workspaces.WorkspacesClient
 }
@@ -51,6 +53,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	StorageInsightsClient := storageinsights.NewStorageInsightsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&StorageInsightsClient.Client, o.ResourceManagerAuthorizer)
 
+	TablesClient := tables.NewTablesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&TablesClient.Client, o.ResourceManagerAuthorizer)
+
 	LinkedServicesClient := linkedservices.NewLinkedServicesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&LinkedServicesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -74,6 +79,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		SavedSearchesClient:        &SavedSearchesClient,
 		SolutionsClient:            &SolutionsClient,
 		StorageInsightsClient:      &StorageInsightsClient,
+		TablesClient:               &TablesClient,
 		WorkspacesClient:           &WorkspacesClient,
 	}
 }
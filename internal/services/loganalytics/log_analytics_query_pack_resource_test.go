@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2019-09-01/querypacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -84,6 +85,31 @@ func TestAccLogAnalyticsQueryPack_update(t *testing.T) {
 	})
 }
 
+func TestLogAnalyticsQueryPack_fakeArm(t *testing.T) {
+	data := fakearm.BuildTestData(t, "azurerm_log_analytics_query_pack", "test")
+	r := LogAnalyticsQueryPackResource{}
+	server := fakearm.NewServer(t)
+	server.LongRunning("Microsoft.Resources/resourceGroups")
+
+	server.ResourceTest(t, data, r, []acceptance.TestStep{
+		{
+			Config: r.update(data, "Test1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				server.ExistsInAzure(t, r, data.ResourceName),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data, "Test2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				server.ExistsInAzure(t, r, data.ResourceName),
+				acceptance.TestCheckResourceAttr(data.ResourceName, "tags.ENV", "Test2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r LogAnalyticsQueryPackResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
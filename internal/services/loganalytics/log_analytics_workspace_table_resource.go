@@ -0,0 +1,440 @@
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// customTableSuffix is the suffix which Log Analytics requires for the name of a Custom Log table
+const customTableSuffix = "_CL"
+
+type LogAnalyticsWorkspaceTableModel struct {
+	Name                   string                                  `tfschema:"name"`
+	WorkspaceId            string                                  `tfschema:"workspace_id"`
+	Plan                   string                                  `tfschema:"plan"`
+	RetentionInDays        int64                                   `tfschema:"retention_in_days"`
+	TotalRetentionInDays   int64                                   `tfschema:"total_retention_in_days"`
+	Description            string                                  `tfschema:"description"`
+	Column                 []LogAnalyticsWorkspaceTableColumnModel `tfschema:"column"`
+	ArchiveRetentionInDays int64                                   `tfschema:"archive_retention_in_days"`
+	TableType              string                                  `tfschema:"table_type"`
+}
+
+type LogAnalyticsWorkspaceTableColumnModel struct {
+	Name        string `tfschema:"name"`
+	Type        string `tfschema:"type"`
+	Description string `tfschema:"description"`
+	DisplayName string `tfschema:"display_name"`
+}
+
+type LogAnalyticsWorkspaceTableResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = LogAnalyticsWorkspaceTableResource{}
+	_ sdk.ResourceWithCustomizeDiff = LogAnalyticsWorkspaceTableResource{}
+)
+
+func (r LogAnalyticsWorkspaceTableResource) ResourceType() string {
+	return "azurerm_log_analytics_workspace_table"
+}
+
+func (r LogAnalyticsWorkspaceTableResource) ModelObject() interface{} {
+	return &LogAnalyticsWorkspaceTableModel{}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return tables.ValidateTableID
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.LogAnalyticsWorkspaceTableName,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: tables.ValidateWorkspaceID,
+		},
+
+		"plan": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(tables.TablePlanEnumAnalytics),
+			ValidateFunc: validation.StringInSlice(tables.PossibleValuesForTablePlanEnum(), false),
+		},
+
+		"retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(4, 730),
+		},
+
+		"total_retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(4, 2556),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"column": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(tables.PossibleValuesForColumnTypeEnum(), false),
+					},
+
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"display_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"archive_retention_in_days": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"table_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			name := rd.Get("name").(string)
+			columns := rd.Get("column").([]interface{})
+			if isCustomLogTable(name) {
+				if len(columns) == 0 {
+					return fmt.Errorf("at least one `column` must be specified for the Custom Log table %q", name)
+				}
+			} else {
+				if len(columns) > 0 || rd.Get("description").(string) != "" {
+					return fmt.Errorf("`column` and `description` can only be specified for Custom Log tables, whose name must end with %q", customTableSuffix)
+				}
+			}
+
+			retentionInDays := rd.Get("retention_in_days").(int)
+			if rd.Get("plan").(string) == string(tables.TablePlanEnumBasic) && retentionInDays != 0 {
+				return fmt.Errorf("`retention_in_days` cannot be specified when `plan` is `Basic` since the interactive retention of Basic tables is fixed at 8 days")
+			}
+
+			totalRetentionInDays := rd.Get("total_retention_in_days").(int)
+			if retentionInDays != 0 && totalRetentionInDays != 0 && totalRetentionInDays < retentionInDays {
+				return fmt.Errorf("`total_retention_in_days` (%d) must be greater than or equal to `retention_in_days` (%d)", totalRetentionInDays, retentionInDays)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LogAnalyticsWorkspaceTableModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			workspaceId, err := tables.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			// built-in tables always exist within a Workspace, so only Custom Log tables can conflict with an existing resource
+			if isCustomLogTable(model.Name) {
+				existing, err := client.Get(ctx, id)
+				if err != nil && !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for existing %s: %+v", id, err)
+				}
+
+				if !response.WasNotFound(existing.HttpResponse) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			if err := createOrUpdateLogAnalyticsWorkspaceTable(ctx, client, id, expandLogAnalyticsWorkspaceTable(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogAnalyticsWorkspaceTableModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := createOrUpdateLogAnalyticsWorkspaceTable(ctx, client, *id, expandLogAnalyticsWorkspaceTable(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := LogAnalyticsWorkspaceTableModel{
+				Name:        id.TableName,
+				WorkspaceId: tables.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					plan := tables.TablePlanEnumAnalytics
+					if props.Plan != nil {
+						plan = *props.Plan
+					}
+					state.Plan = string(plan)
+
+					// the retention of a Basic table is fixed, and a value inherited from the Workspace is left unset
+					if plan != tables.TablePlanEnumBasic && props.RetentionInDays != nil && !utils.NormaliseNilableBool(props.RetentionInDaysAsDefault) {
+						state.RetentionInDays = *props.RetentionInDays
+					}
+
+					if props.TotalRetentionInDays != nil && !utils.NormaliseNilableBool(props.TotalRetentionInDaysAsDefault) {
+						state.TotalRetentionInDays = *props.TotalRetentionInDays
+					}
+
+					if props.ArchiveRetentionInDays != nil {
+						state.ArchiveRetentionInDays = *props.ArchiveRetentionInDays
+					}
+
+					if schema := props.Schema; schema != nil {
+						if schema.TableType != nil {
+							state.TableType = string(*schema.TableType)
+						}
+
+						if isCustomLogTable(id.TableName) {
+							state.Description = utils.NormalizeNilableString(schema.Description)
+							state.Column = flattenLogAnalyticsWorkspaceTableColumns(schema.Columns)
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if isCustomLogTable(id.TableName) {
+				if err := client.DeleteThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+
+				return nil
+			}
+
+			// built-in tables can't be deleted, so instead they're reset to the Analytics plan with the Workspace retention
+			plan := tables.TablePlanEnumAnalytics
+			parameters := tables.Table{
+				Properties: &tables.TableProperties{
+					Plan:                 &plan,
+					RetentionInDays:      utils.Int64(-1),
+					TotalRetentionInDays: utils.Int64(-1),
+				},
+			}
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("resetting %s to the default plan and retention: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func isCustomLogTable(name string) bool {
+	return strings.HasSuffix(name, customTableSuffix)
+}
+
+// createOrUpdateLogAnalyticsWorkspaceTable defines a Custom Log table (including its schema) using a PUT, whereas the
+// schema of a built-in table is managed by Log Analytics so only its plan and retention are patched
+func createOrUpdateLogAnalyticsWorkspaceTable(ctx context.Context, client *tables.TablesClient, id tables.TableId, input tables.Table) error {
+	if isCustomLogTable(id.TableName) {
+		return client.CreateOrUpdateThenPoll(ctx, id, input)
+	}
+
+	return client.UpdateThenPoll(ctx, id, input)
+}
+
+func expandLogAnalyticsWorkspaceTable(input LogAnalyticsWorkspaceTableModel) tables.Table {
+	plan := tables.TablePlanEnum(input.Plan)
+	properties := tables.TableProperties{
+		Plan: &plan,
+		// -1 inherits the retention from the Workspace
+		RetentionInDays:      utils.Int64(-1),
+		TotalRetentionInDays: utils.Int64(-1),
+	}
+
+	if input.RetentionInDays != 0 {
+		properties.RetentionInDays = utils.Int64(input.RetentionInDays)
+	}
+
+	if input.TotalRetentionInDays != 0 {
+		properties.TotalRetentionInDays = utils.Int64(input.TotalRetentionInDays)
+	}
+
+	if isCustomLogTable(input.Name) {
+		schema := tables.Schema{
+			Name:    utils.String(input.Name),
+			Columns: expandLogAnalyticsWorkspaceTableColumns(input.Column),
+		}
+
+		if input.Description != "" {
+			schema.Description = utils.String(input.Description)
+		}
+
+		properties.Schema = &schema
+	}
+
+	return tables.Table{
+		Properties: &properties,
+	}
+}
+
+func expandLogAnalyticsWorkspaceTableColumns(input []LogAnalyticsWorkspaceTableColumnModel) *[]tables.Column {
+	columns := make([]tables.Column, 0)
+	for _, item := range input {
+		columnType := tables.ColumnTypeEnum(item.Type)
+		column := tables.Column{
+			Name: utils.String(item.Name),
+			Type: &columnType,
+		}
+
+		if item.Description != "" {
+			column.Description = utils.String(item.Description)
+		}
+
+		if item.DisplayName != "" {
+			column.DisplayName = utils.String(item.DisplayName)
+		}
+
+		columns = append(columns, column)
+	}
+
+	return &columns
+}
+
+func flattenLogAnalyticsWorkspaceTableColumns(input *[]tables.Column) []LogAnalyticsWorkspaceTableColumnModel {
+	columns := make([]LogAnalyticsWorkspaceTableColumnModel, 0)
+	if input == nil {
+		return columns
+	}
+
+	for _, item := range *input {
+		column := LogAnalyticsWorkspaceTableColumnModel{
+			Name:        utils.NormalizeNilableString(item.Name),
+			Description: utils.NormalizeNilableString(item.Description),
+			DisplayName: utils.NormalizeNilableString(item.DisplayName),
+		}
+
+		if item.Type != nil {
+			column.Type = string(*item.Type)
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns
+}
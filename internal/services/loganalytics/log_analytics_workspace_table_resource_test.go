@@ -0,0 +1,257 @@
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LogAnalyticsWorkspaceTableResource struct{}
+
+func TestAccLogAnalyticsWorkspaceTable_builtInRetention(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table", "test")
+	r := LogAnalyticsWorkspaceTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.builtInRetention(data, 30, 90),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("table_type").HasValue("Microsoft"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.builtInRetention(data, 60, 365),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("archive_retention_in_days").HasValue("305"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceTable_builtInBasicPlan(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table", "test")
+	r := LogAnalyticsWorkspaceTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.builtInBasicPlan(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("plan").HasValue("Basic"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceTable_customTable(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table", "test")
+	r := LogAnalyticsWorkspaceTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customTable(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("table_type").HasValue("CustomLog"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceTable_customTableRequiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table", "test")
+	r := LogAnalyticsWorkspaceTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customTable(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceTable_customTableUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table", "test")
+	r := LogAnalyticsWorkspaceTableResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customTable(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.customTableComplete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("column.#").HasValue("4"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.customTable(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (LogAnalyticsWorkspaceTableResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := tables.ParseTableID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.LogAnalytics.TablesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r LogAnalyticsWorkspaceTableResource) builtInRetention(data acceptance.TestData, retentionInDays, totalRetentionInDays int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table" "test" {
+  name                    = "AppTraces"
+  workspace_id            = azurerm_log_analytics_workspace.test.id
+  retention_in_days       = %d
+  total_retention_in_days = %d
+}
+`, r.template(data), retentionInDays, totalRetentionInDays)
+}
+
+func (r LogAnalyticsWorkspaceTableResource) builtInBasicPlan(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table" "test" {
+  name                    = "ContainerLogV2"
+  workspace_id            = azurerm_log_analytics_workspace.test.id
+  plan                    = "Basic"
+  total_retention_in_days = 30
+}
+`, r.template(data))
+}
+
+func (r LogAnalyticsWorkspaceTableResource) customTable(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table" "test" {
+  name         = "acctest%d_CL"
+  workspace_id = azurerm_log_analytics_workspace.test.id
+
+  column {
+    name = "TimeGenerated"
+    type = "dateTime"
+  }
+
+  column {
+    name = "Message"
+    type = "string"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LogAnalyticsWorkspaceTableResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table" "import" {
+  name         = azurerm_log_analytics_workspace_table.test.name
+  workspace_id = azurerm_log_analytics_workspace_table.test.workspace_id
+
+  column {
+    name = "TimeGenerated"
+    type = "dateTime"
+  }
+
+  column {
+    name = "Message"
+    type = "string"
+  }
+}
+`, r.customTable(data))
+}
+
+func (r LogAnalyticsWorkspaceTableResource) customTableComplete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table" "test" {
+  name                    = "acctest%d_CL"
+  workspace_id            = azurerm_log_analytics_workspace.test.id
+  plan                    = "Analytics"
+  retention_in_days       = 30
+  total_retention_in_days = 180
+  description             = "A custom table for acceptance testing"
+
+  column {
+    name = "TimeGenerated"
+    type = "dateTime"
+  }
+
+  column {
+    name        = "Message"
+    type        = "string"
+    description = "The message which was logged"
+  }
+
+  column {
+    name         = "Severity"
+    type         = "int"
+    display_name = "Severity Level"
+  }
+
+  column {
+    name = "Properties"
+    type = "dynamic"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (LogAnalyticsWorkspaceTableResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-la-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,153 @@
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/sdk/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LogAnalyticsWorkspaceTablesDataSource struct{}
+
+var _ sdk.DataSource = LogAnalyticsWorkspaceTablesDataSource{}
+
+type LogAnalyticsWorkspaceTablesModel struct {
+	WorkspaceId string                                   `tfschema:"workspace_id"`
+	Tables      []LogAnalyticsWorkspaceTableSummaryModel `tfschema:"tables"`
+}
+
+type LogAnalyticsWorkspaceTableSummaryModel struct {
+	Name                   string `tfschema:"name"`
+	Plan                   string `tfschema:"plan"`
+	RetentionInDays        int64  `tfschema:"retention_in_days"`
+	TotalRetentionInDays   int64  `tfschema:"total_retention_in_days"`
+	ArchiveRetentionInDays int64  `tfschema:"archive_retention_in_days"`
+	TableType              string `tfschema:"table_type"`
+}
+
+func (d LogAnalyticsWorkspaceTablesDataSource) ResourceType() string {
+	return "azurerm_log_analytics_workspace_tables"
+}
+
+func (d LogAnalyticsWorkspaceTablesDataSource) ModelObject() interface{} {
+	return &LogAnalyticsWorkspaceTablesModel{}
+}
+
+func (d LogAnalyticsWorkspaceTablesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: tables.ValidateWorkspaceID,
+		},
+	}
+}
+
+func (d LogAnalyticsWorkspaceTablesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"tables": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"plan": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"retention_in_days": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"total_retention_in_days": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"archive_retention_in_days": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"table_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d LogAnalyticsWorkspaceTablesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			var model LogAnalyticsWorkspaceTablesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := tables.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListByWorkspaceComplete(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing the Tables for %s: %+v", *id, err)
+			}
+
+			results := make([]LogAnalyticsWorkspaceTableSummaryModel, 0)
+			for _, item := range resp.Items {
+				table := LogAnalyticsWorkspaceTableSummaryModel{
+					Name: utils.NormalizeNilableString(item.Name),
+				}
+
+				if props := item.Properties; props != nil {
+					if props.Plan != nil {
+						table.Plan = string(*props.Plan)
+					}
+
+					if props.RetentionInDays != nil {
+						table.RetentionInDays = *props.RetentionInDays
+					}
+
+					if props.TotalRetentionInDays != nil {
+						table.TotalRetentionInDays = *props.TotalRetentionInDays
+					}
+
+					if props.ArchiveRetentionInDays != nil {
+						table.ArchiveRetentionInDays = *props.ArchiveRetentionInDays
+					}
+
+					if props.Schema != nil && props.Schema.TableType != nil {
+						table.TableType = string(*props.Schema.TableType)
+					}
+				}
+
+				results = append(results, table)
+			}
+
+			state := LogAnalyticsWorkspaceTablesModel{
+				WorkspaceId: id.ID(),
+				Tables:      results,
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package loganalytics_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type LogAnalyticsWorkspaceTablesDataSource struct{}
+
+func TestAccLogAnalyticsWorkspaceTablesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_log_analytics_workspace_tables", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: LogAnalyticsWorkspaceTablesDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("tables.#").Exists(),
+				check.That(data.ResourceName).Key("tables.0.name").Exists(),
+				check.That(data.ResourceName).Key("tables.0.plan").Exists(),
+			),
+		},
+	})
+}
+
+func (LogAnalyticsWorkspaceTablesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_log_analytics_workspace_tables" "test" {
+  workspace_id = azurerm_log_analytics_workspace_table.test.workspace_id
+}
+`, LogAnalyticsWorkspaceTableResource{}.customTable(data))
+}
@@ -0,0 +1,238 @@
+package tables
+
+import "strings"
+
+type ColumnDataTypeHintEnum string
+
+const (
+	ColumnDataTypeHintEnumArmPath ColumnDataTypeHintEnum = "armPath"
+	ColumnDataTypeHintEnumGuid    ColumnDataTypeHintEnum = "guid"
+	ColumnDataTypeHintEnumIp      ColumnDataTypeHintEnum = "ip"
+	ColumnDataTypeHintEnumUri     ColumnDataTypeHintEnum = "uri"
+)
+
+func PossibleValuesForColumnDataTypeHintEnum() []string {
+	return []string{
+		string(ColumnDataTypeHintEnumArmPath),
+		string(ColumnDataTypeHintEnumGuid),
+		string(ColumnDataTypeHintEnumIp),
+		string(ColumnDataTypeHintEnumUri),
+	}
+}
+
+func parseColumnDataTypeHintEnum(input string) (*ColumnDataTypeHintEnum, error) {
+	vals := map[string]ColumnDataTypeHintEnum{
+		"armpath": ColumnDataTypeHintEnumArmPath,
+		"guid":    ColumnDataTypeHintEnumGuid,
+		"ip":      ColumnDataTypeHintEnumIp,
+		"uri":     ColumnDataTypeHintEnumUri,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ColumnDataTypeHintEnum(input)
+	return &out, nil
+}
+
+type ColumnTypeEnum string
+
+const (
+	ColumnTypeEnumBoolean  ColumnTypeEnum = "boolean"
+	ColumnTypeEnumDateTime ColumnTypeEnum = "dateTime"
+	ColumnTypeEnumDynamic  ColumnTypeEnum = "dynamic"
+	ColumnTypeEnumGuid     ColumnTypeEnum = "guid"
+	ColumnTypeEnumInt      ColumnTypeEnum = "int"
+	ColumnTypeEnumLong     ColumnTypeEnum = "long"
+	ColumnTypeEnumReal     ColumnTypeEnum = "real"
+	ColumnTypeEnumString   ColumnTypeEnum = "string"
+)
+
+func PossibleValuesForColumnTypeEnum() []string {
+	return []string{
+		string(ColumnTypeEnumBoolean),
+		string(ColumnTypeEnumDateTime),
+		string(ColumnTypeEnumDynamic),
+		string(ColumnTypeEnumGuid),
+		string(ColumnTypeEnumInt),
+		string(ColumnTypeEnumLong),
+		string(ColumnTypeEnumReal),
+		string(ColumnTypeEnumString),
+	}
+}
+
+func parseColumnTypeEnum(input string) (*ColumnTypeEnum, error) {
+	vals := map[string]ColumnTypeEnum{
+		"boolean":  ColumnTypeEnumBoolean,
+		"datetime": ColumnTypeEnumDateTime,
+		"dynamic":  ColumnTypeEnumDynamic,
+		"guid":     ColumnTypeEnumGuid,
+		"int":      ColumnTypeEnumInt,
+		"long":     ColumnTypeEnumLong,
+		"real":     ColumnTypeEnumReal,
+		"string":   ColumnTypeEnumString,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ColumnTypeEnum(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting   ProvisioningState = "Deleting"
+	ProvisioningStateInProgress ProvisioningState = "InProgress"
+	ProvisioningStateSucceeded  ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating   ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateInProgress),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"deleting":   ProvisioningStateDeleting,
+		"inprogress": ProvisioningStateInProgress,
+		"succeeded":  ProvisioningStateSucceeded,
+		"updating":   ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type SourceEnum string
+
+const (
+	SourceEnumCustomer  SourceEnum = "customer"
+	SourceEnumMicrosoft SourceEnum = "microsoft"
+)
+
+func PossibleValuesForSourceEnum() []string {
+	return []string{
+		string(SourceEnumCustomer),
+		string(SourceEnumMicrosoft),
+	}
+}
+
+func parseSourceEnum(input string) (*SourceEnum, error) {
+	vals := map[string]SourceEnum{
+		"customer":  SourceEnumCustomer,
+		"microsoft": SourceEnumMicrosoft,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SourceEnum(input)
+	return &out, nil
+}
+
+type TablePlanEnum string
+
+const (
+	TablePlanEnumAnalytics TablePlanEnum = "Analytics"
+	TablePlanEnumBasic     TablePlanEnum = "Basic"
+)
+
+func PossibleValuesForTablePlanEnum() []string {
+	return []string{
+		string(TablePlanEnumAnalytics),
+		string(TablePlanEnumBasic),
+	}
+}
+
+func parseTablePlanEnum(input string) (*TablePlanEnum, error) {
+	vals := map[string]TablePlanEnum{
+		"analytics": TablePlanEnumAnalytics,
+		"basic":     TablePlanEnumBasic,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TablePlanEnum(input)
+	return &out, nil
+}
+
+type TableSubType string
+
+const (
+	TableSubTypeAny                     TableSubType = "Any"
+	TableSubTypeClassic                 TableSubType = "Classic"
+	TableSubTypeDataCollectionRuleBased TableSubType = "DataCollectionRuleBased"
+)
+
+func PossibleValuesForTableSubType() []string {
+	return []string{
+		string(TableSubTypeAny),
+		string(TableSubTypeClassic),
+		string(TableSubTypeDataCollectionRuleBased),
+	}
+}
+
+func parseTableSubType(input string) (*TableSubType, error) {
+	vals := map[string]TableSubType{
+		"any":                     TableSubTypeAny,
+		"classic":                 TableSubTypeClassic,
+		"datacollectionrulebased": TableSubTypeDataCollectionRuleBased,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TableSubType(input)
+	return &out, nil
+}
+
+type TableType string
+
+const (
+	TableTypeCustomLog     TableType = "CustomLog"
+	TableTypeMicrosoft     TableType = "Microsoft"
+	TableTypeRestoredLogs  TableType = "RestoredLogs"
+	TableTypeSearchResults TableType = "SearchResults"
+)
+
+func PossibleValuesForTableType() []string {
+	return []string{
+		string(TableTypeCustomLog),
+		string(TableTypeMicrosoft),
+		string(TableTypeRestoredLogs),
+		string(TableTypeSearchResults),
+	}
+}
+
+func parseTableType(input string) (*TableType, error) {
+	vals := map[string]TableType{
+		"customlog":     TableTypeCustomLog,
+		"microsoft":     TableTypeMicrosoft,
+		"restoredlogs":  TableTypeRestoredLogs,
+		"searchresults": TableTypeSearchResults,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TableType(input)
+	return &out, nil
+}
@@ -0,0 +1,11 @@
+package tables
+
+type Column struct {
+	DataTypeHint     *ColumnDataTypeHintEnum `json:"dataTypeHint,omitempty"`
+	Description      *string                 `json:"description,omitempty"`
+	DisplayName      *string                 `json:"displayName,omitempty"`
+	IsDefaultDisplay *bool                   `json:"isDefaultDisplay,omitempty"`
+	IsHidden         *bool                   `json:"isHidden,omitempty"`
+	Name             *string                 `json:"name,omitempty"`
+	Type             *ColumnTypeEnum         `json:"type,omitempty"`
+}
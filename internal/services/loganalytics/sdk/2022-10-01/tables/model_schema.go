@@ -0,0 +1,15 @@
+package tables
+
+type Schema struct {
+	Categories      *[]string     `json:"categories,omitempty"`
+	Columns         *[]Column     `json:"columns,omitempty"`
+	Description     *string       `json:"description,omitempty"`
+	DisplayName     *string       `json:"displayName,omitempty"`
+	Labels          *[]string     `json:"labels,omitempty"`
+	Name            *string       `json:"name,omitempty"`
+	Solutions       *[]string     `json:"solutions,omitempty"`
+	Source          *SourceEnum   `json:"source,omitempty"`
+	StandardColumns *[]Column     `json:"standardColumns,omitempty"`
+	TableSubType    *TableSubType `json:"tableSubType,omitempty"`
+	TableType       *TableType    `json:"tableType,omitempty"`
+}
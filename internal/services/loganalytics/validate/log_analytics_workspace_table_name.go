@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func LogAnalyticsWorkspaceTableName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,62}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must start with a letter, can only contain letters, numbers and underscores and must be between 1 and 63 characters long", k))
+	}
+
+	return
+}
@@ -0,0 +1,73 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestLogAnalyticsWorkspaceTableName(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected bool
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Name:     "Invalid name starts with underscore",
+			Input:    "_InvalidTable_CL",
+			Expected: false,
+		},
+		{
+			Name:     "Invalid name starts with a number",
+			Input:    "1InvalidTable",
+			Expected: false,
+		},
+		{
+			Name:     "Invalid characters hyphen",
+			Input:    "Invalid-Table_CL",
+			Expected: false,
+		},
+		{
+			Name:     "Invalid characters space",
+			Input:    "Invalid Table",
+			Expected: false,
+		},
+		{
+			Name:     "Invalid name too long",
+			Input:    "ThisIsTooLooooooooooooooooooooooooooooooooooooooooongForATable_CL",
+			Expected: false,
+		},
+		{
+			Name:     "Valid built-in table name",
+			Input:    "AppTraces",
+			Expected: true,
+		},
+		{
+			Name:     "Valid custom table name",
+			Input:    "Custom_Table_CL",
+			Expected: true,
+		},
+		{
+			Name:     "Valid name max length",
+			Input:    "ThisIsTheLoooooooooooooooooooooooooooooooooooongestValidTableCL",
+			Expected: true,
+		},
+		{
+			Name:     "Valid name min length",
+			Input:    "T",
+			Expected: true,
+		},
+	}
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		_, errors := LogAnalyticsWorkspaceTableName(v.Input, "name")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %v but got %v (and %d errors)", v.Expected, result, len(errors))
+		}
+	}
+}
@@ -73,3 +73,23 @@ func ActionID(input string) (*ActionId, error) {
 
 	return &resourceId, nil
 }
+
+// ActionIDFromNames builds an ActionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workflowName}/{name}", using the specified Subscription ID when this is omitted
+func ActionIDFromNames(subscriptionId, input string) (*ActionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workflowName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workflowName}/{name}")
+		}
+	}
+
+	resourceId := NewActionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func IntegrationAccountAgreementID(input string) (*IntegrationAccountAgreementId
 
 	return &resourceId, nil
 }
+
+// IntegrationAccountAgreementIDFromNames builds an IntegrationAccountAgreementId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{agreementName}", using the specified Subscription ID when this is omitted
+func IntegrationAccountAgreementIDFromNames(subscriptionId, input string) (*IntegrationAccountAgreementId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{agreementName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{agreementName}")
+		}
+	}
+
+	resourceId := NewIntegrationAccountAgreementID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
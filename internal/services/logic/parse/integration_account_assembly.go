@@ -73,3 +73,23 @@ func IntegrationAccountAssemblyID(input string) (*IntegrationAccountAssemblyId,
 
 	return &resourceId, nil
 }
+
+// IntegrationAccountAssemblyIDFromNames builds an IntegrationAccountAssemblyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{assemblyName}", using the specified Subscription ID when this is omitted
+func IntegrationAccountAssemblyIDFromNames(subscriptionId, input string) (*IntegrationAccountAssemblyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{assemblyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{assemblyName}")
+		}
+	}
+
+	resourceId := NewIntegrationAccountAssemblyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func IntegrationAccountBatchConfigurationID(input string) (*IntegrationAccountBa
 
 	return &resourceId, nil
 }
+
+// IntegrationAccountBatchConfigurationIDFromNames builds an IntegrationAccountBatchConfigurationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{batchConfigurationName}", using the specified Subscription ID when this is omitted
+func IntegrationAccountBatchConfigurationIDFromNames(subscriptionId, input string) (*IntegrationAccountBatchConfigurationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{batchConfigurationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{batchConfigurationName}")
+		}
+	}
+
+	resourceId := NewIntegrationAccountBatchConfigurationID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func IntegrationAccountCertificateID(input string) (*IntegrationAccountCertifica
 
 	return &resourceId, nil
 }
+
+// IntegrationAccountCertificateIDFromNames builds an IntegrationAccountCertificateId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{certificateName}", using the specified Subscription ID when this is omitted
+func IntegrationAccountCertificateIDFromNames(subscriptionId, input string) (*IntegrationAccountCertificateId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{certificateName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{certificateName}")
+		}
+	}
+
+	resourceId := NewIntegrationAccountCertificateID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func IntegrationAccountMapID(input string) (*IntegrationAccountMapId, error) {
 
 	return &resourceId, nil
 }
+
+// IntegrationAccountMapIDFromNames builds an IntegrationAccountMapId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{mapName}", using the specified Subscription ID when this is omitted
+func IntegrationAccountMapIDFromNames(subscriptionId, input string) (*IntegrationAccountMapId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{mapName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{mapName}")
+		}
+	}
+
+	resourceId := NewIntegrationAccountMapID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func IntegrationAccountPartnerID(input string) (*IntegrationAccountPartnerId, er
 
 	return &resourceId, nil
 }
+
+// IntegrationAccountPartnerIDFromNames builds an IntegrationAccountPartnerId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{partnerName}", using the specified Subscription ID when this is omitted
+func IntegrationAccountPartnerIDFromNames(subscriptionId, input string) (*IntegrationAccountPartnerId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{partnerName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{partnerName}")
+		}
+	}
+
+	resourceId := NewIntegrationAccountPartnerID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
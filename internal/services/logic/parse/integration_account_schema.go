@@ -73,3 +73,23 @@ func IntegrationAccountSchemaID(input string) (*IntegrationAccountSchemaId, erro
 
 	return &resourceId, nil
 }
+
+// IntegrationAccountSchemaIDFromNames builds an IntegrationAccountSchemaId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{schemaName}", using the specified Subscription ID when this is omitted
+func IntegrationAccountSchemaIDFromNames(subscriptionId, input string) (*IntegrationAccountSchemaId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{schemaName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{schemaName}")
+		}
+	}
+
+	resourceId := NewIntegrationAccountSchemaID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func IntegrationAccountSessionID(input string) (*IntegrationAccountSessionId, er
 
 	return &resourceId, nil
 }
+
+// IntegrationAccountSessionIDFromNames builds an IntegrationAccountSessionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{sessionName}", using the specified Subscription ID when this is omitted
+func IntegrationAccountSessionIDFromNames(subscriptionId, input string) (*IntegrationAccountSessionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{sessionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{integrationAccountName}/{sessionName}")
+		}
+	}
+
+	resourceId := NewIntegrationAccountSessionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
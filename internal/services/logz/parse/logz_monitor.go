@@ -67,3 +67,23 @@ func LogzMonitorID(input string) (*LogzMonitorId, error) {
 
 	return &resourceId, nil
 }
+
+// LogzMonitorIDFromNames builds an LogzMonitorId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{monitorName}", using the specified Subscription ID when this is omitted
+func LogzMonitorIDFromNames(subscriptionId, input string) (*LogzMonitorId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{monitorName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{monitorName}")
+		}
+	}
+
+	resourceId := NewLogzMonitorID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
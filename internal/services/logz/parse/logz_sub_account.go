@@ -73,3 +73,23 @@ func LogzSubAccountID(input string) (*LogzSubAccountId, error) {
 
 	return &resourceId, nil
 }
+
+// LogzSubAccountIDFromNames builds an LogzSubAccountId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{monitorName}/{accountName}", using the specified Subscription ID when this is omitted
+func LogzSubAccountIDFromNames(subscriptionId, input string) (*LogzSubAccountId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{monitorName}/{accountName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{monitorName}/{accountName}")
+		}
+	}
+
+	resourceId := NewLogzSubAccountID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func LogzSubAccountTagRuleID(input string) (*LogzSubAccountTagRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// LogzSubAccountTagRuleIDFromNames builds an LogzSubAccountTagRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{monitorName}/{accountName}/{tagRuleName}", using the specified Subscription ID when this is omitted
+func LogzSubAccountTagRuleIDFromNames(subscriptionId, input string) (*LogzSubAccountTagRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{monitorName}/{accountName}/{tagRuleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{monitorName}/{accountName}/{tagRuleName}")
+		}
+	}
+
+	resourceId := NewLogzSubAccountTagRuleID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
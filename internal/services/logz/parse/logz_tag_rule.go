@@ -73,3 +73,23 @@ func LogzTagRuleID(input string) (*LogzTagRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// LogzTagRuleIDFromNames builds an LogzTagRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{monitorName}/{tagRuleName}", using the specified Subscription ID when this is omitted
+func LogzTagRuleIDFromNames(subscriptionId, input string) (*LogzTagRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{monitorName}/{tagRuleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{monitorName}/{tagRuleName}")
+		}
+	}
+
+	resourceId := NewLogzTagRuleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func ComputeClusterID(input string) (*ComputeClusterId, error) {
 
 	return &resourceId, nil
 }
+
+// ComputeClusterIDFromNames builds an ComputeClusterId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{computeName}", using the specified Subscription ID when this is omitted
+func ComputeClusterIDFromNames(subscriptionId, input string) (*ComputeClusterId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{computeName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{computeName}")
+		}
+	}
+
+	resourceId := NewComputeClusterID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
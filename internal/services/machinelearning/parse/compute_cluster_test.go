@@ -126,3 +126,87 @@ func TestComputeClusterID(t *testing.T) {
 		}
 	}
 }
+
+func TestComputeClusterIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *ComputeClusterId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "resGroup1/workspace1",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "00000000-0000-0000-0000-000000000000/resGroup1/workspace1/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "resGroup1/workspace1/cluster1",
+			Expected: &ComputeClusterId{
+				SubscriptionId: "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:  "resGroup1",
+				WorkspaceName:  "workspace1",
+				ComputeName:    "cluster1",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "00000000-0000-0000-0000-000000000000/resGroup1/workspace1/cluster1",
+			Expected: &ComputeClusterId{
+				SubscriptionId: "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:  "resGroup1",
+				WorkspaceName:  "workspace1",
+				ComputeName:    "cluster1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ComputeClusterIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.WorkspaceName != v.Expected.WorkspaceName {
+			t.Fatalf("Expected %q but got %q for WorkspaceName", v.Expected.WorkspaceName, actual.WorkspaceName)
+		}
+		if actual.ComputeName != v.Expected.ComputeName {
+			t.Fatalf("Expected %q but got %q for ComputeName", v.Expected.ComputeName, actual.ComputeName)
+		}
+	}
+}
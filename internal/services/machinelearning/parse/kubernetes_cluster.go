@@ -67,3 +67,23 @@ func KubernetesClusterID(input string) (*KubernetesClusterId, error) {
 
 	return &resourceId, nil
 }
+
+// KubernetesClusterIDFromNames builds an KubernetesClusterId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{managedClusterName}", using the specified Subscription ID when this is omitted
+func KubernetesClusterIDFromNames(subscriptionId, input string) (*KubernetesClusterId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{managedClusterName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{managedClusterName}")
+		}
+	}
+
+	resourceId := NewKubernetesClusterID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func AssetFilterID(input string) (*AssetFilterId, error) {
 
 	return &resourceId, nil
 }
+
+// AssetFilterIDFromNames builds an AssetFilterId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{mediaserviceName}/{assetName}/{name}", using the specified Subscription ID when this is omitted
+func AssetFilterIDFromNames(subscriptionId, input string) (*AssetFilterId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{mediaserviceName}/{assetName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{mediaserviceName}/{assetName}/{name}")
+		}
+	}
+
+	resourceId := NewAssetFilterID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
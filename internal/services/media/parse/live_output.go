@@ -79,3 +79,23 @@ func LiveOutputID(input string) (*LiveOutputId, error) {
 
 	return &resourceId, nil
 }
+
+// LiveOutputIDFromNames builds an LiveOutputId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{mediaserviceName}/{liveeventName}/{name}", using the specified Subscription ID when this is omitted
+func LiveOutputIDFromNames(subscriptionId, input string) (*LiveOutputId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{mediaserviceName}/{liveeventName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{mediaserviceName}/{liveeventName}/{name}")
+		}
+	}
+
+	resourceId := NewLiveOutputID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
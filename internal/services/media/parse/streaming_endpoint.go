@@ -73,3 +73,23 @@ func StreamingEndpointID(input string) (*StreamingEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// StreamingEndpointIDFromNames builds an StreamingEndpointId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{mediaserviceName}/{name}", using the specified Subscription ID when this is omitted
+func StreamingEndpointIDFromNames(subscriptionId, input string) (*StreamingEndpointId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{mediaserviceName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{mediaserviceName}/{name}")
+		}
+	}
+
+	resourceId := NewStreamingEndpointID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -7,11 +7,13 @@ import (
 	classic "github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
 	newActionGroupClient "github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-09-01-preview/insights"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionruleassociations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionrules"
 	diagnosticSettingClient "github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
 	diagnosticCategoryClient "github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-08-01/scheduledqueryrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-04-03/azuremonitorworkspaces"
 )
 
 type Client struct {
@@ -26,19 +28,21 @@ type Client struct {
 	SmartDetectorAlertRulesClient *alertsmanagement.SmartDetectorAlertRulesClient
 
 	// Monitor
-	ActionGroupsClient               *newActionGroupClient.ActionGroupsClient
-	ActivityLogAlertsClient          *insights.ActivityLogAlertsClient
-	AlertRulesClient                 *classic.AlertRulesClient
-	DataCollectionRulesClient        *datacollectionrules.DataCollectionRulesClient
-	DataCollectionEndpointsClient    *datacollectionendpoints.DataCollectionEndpointsClient
-	DiagnosticSettingsClient         *diagnosticSettingClient.DiagnosticSettingsClient
-	DiagnosticSettingsCategoryClient *diagnosticCategoryClient.DiagnosticSettingsCategoriesClient
-	LogProfilesClient                *classic.LogProfilesClient
-	MetricAlertsClient               *classic.MetricAlertsClient
-	PrivateLinkScopesClient          *classic.PrivateLinkScopesClient
-	PrivateLinkScopedResourcesClient *classic.PrivateLinkScopedResourcesClient
-	ScheduledQueryRulesClient        *classic.ScheduledQueryRulesClient
-	ScheduledQueryRulesV2Client      *scheduledqueryrules.ScheduledQueryRulesClient
+	ActionGroupsClient                   *newActionGroupClient.ActionGroupsClient
+	ActivityLogAlertsClient              *insights.ActivityLogAlertsClient
+	AlertRulesClient                     *classic.AlertRulesClient
+	AzureMonitorWorkspacesClient         *azuremonitorworkspaces.AzureMonitorWorkspacesClient
+	DataCollectionRuleAssociationsClient *datacollectionruleassociations.DataCollectionRuleAssociationsClient
+	DataCollectionRulesClient            *datacollectionrules.DataCollectionRulesClient
+	DataCollectionEndpointsClient        *datacollectionendpoints.DataCollectionEndpointsClient
+	DiagnosticSettingsClient             *diagnosticSettingClient.DiagnosticSettingsClient
+	DiagnosticSettingsCategoryClient     *diagnosticCategoryClient.DiagnosticSettingsCategoriesClient
+	LogProfilesClient                    *classic.LogProfilesClient
+	MetricAlertsClient                   *classic.MetricAlertsClient
+	PrivateLinkScopesClient              *classic.PrivateLinkScopesClient
+	PrivateLinkScopedResourcesClient     *classic.PrivateLinkScopedResourcesClient
+	ScheduledQueryRulesClient            *classic.ScheduledQueryRulesClient
+	ScheduledQueryRulesV2Client          *scheduledqueryrules.ScheduledQueryRulesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -63,9 +67,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	AlertRulesClient := classic.NewAlertRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&AlertRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	AzureMonitorWorkspacesClient := azuremonitorworkspaces.NewAzureMonitorWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&AzureMonitorWorkspacesClient.Client, o.ResourceManagerAuthorizer)
+
 	DataCollectionEndpointsClient := datacollectionendpoints.NewDataCollectionEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&DataCollectionEndpointsClient.Client, o.ResourceManagerAuthorizer)
 
+	DataCollectionRuleAssociationsClient := datacollectionruleassociations.NewDataCollectionRuleAssociationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&DataCollectionRuleAssociationsClient.Client, o.ResourceManagerAuthorizer)
+
 	DataCollectionRulesClient := datacollectionrules.NewDataCollectionRulesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&DataCollectionRulesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -94,22 +104,24 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&ScheduledQueryRulesV2Client.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AADDiagnosticSettingsClient:      &AADDiagnosticSettingsClient,
-		AutoscaleSettingsClient:          &AutoscaleSettingsClient,
-		ActionRulesClient:                &ActionRulesClient,
-		SmartDetectorAlertRulesClient:    &SmartDetectorAlertRulesClient,
-		ActionGroupsClient:               &ActionGroupsClient,
-		ActivityLogAlertsClient:          &ActivityLogAlertsClient,
-		AlertRulesClient:                 &AlertRulesClient,
-		DataCollectionEndpointsClient:    &DataCollectionEndpointsClient,
-		DataCollectionRulesClient:        &DataCollectionRulesClient,
-		DiagnosticSettingsClient:         &DiagnosticSettingsClient,
-		DiagnosticSettingsCategoryClient: &DiagnosticSettingsCategoryClient,
-		LogProfilesClient:                &LogProfilesClient,
-		MetricAlertsClient:               &MetricAlertsClient,
-		PrivateLinkScopesClient:          &PrivateLinkScopesClient,
-		PrivateLinkScopedResourcesClient: &PrivateLinkScopedResourcesClient,
-		ScheduledQueryRulesClient:        &ScheduledQueryRulesClient,
-		ScheduledQueryRulesV2Client:      &ScheduledQueryRulesV2Client,
+		AADDiagnosticSettingsClient:          &AADDiagnosticSettingsClient,
+		AutoscaleSettingsClient:              &AutoscaleSettingsClient,
+		ActionRulesClient:                    &ActionRulesClient,
+		SmartDetectorAlertRulesClient:        &SmartDetectorAlertRulesClient,
+		ActionGroupsClient:                   &ActionGroupsClient,
+		ActivityLogAlertsClient:              &ActivityLogAlertsClient,
+		AlertRulesClient:                     &AlertRulesClient,
+		AzureMonitorWorkspacesClient:         &AzureMonitorWorkspacesClient,
+		DataCollectionEndpointsClient:        &DataCollectionEndpointsClient,
+		DataCollectionRuleAssociationsClient: &DataCollectionRuleAssociationsClient,
+		DataCollectionRulesClient:            &DataCollectionRulesClient,
+		DiagnosticSettingsClient:             &DiagnosticSettingsClient,
+		DiagnosticSettingsCategoryClient:     &DiagnosticSettingsCategoryClient,
+		LogProfilesClient:                    &LogProfilesClient,
+		MetricAlertsClient:                   &MetricAlertsClient,
+		PrivateLinkScopesClient:              &PrivateLinkScopesClient,
+		PrivateLinkScopedResourcesClient:     &PrivateLinkScopedResourcesClient,
+		ScheduledQueryRulesClient:            &ScheduledQueryRulesClient,
+		ScheduledQueryRulesV2Client:          &ScheduledQueryRulesV2Client,
 	}
 }
@@ -0,0 +1,311 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionruleassociations"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// an association with a Data Collection Endpoint must use this (fixed) name, since only one
+// Data Collection Endpoint can be associated with a given Resource
+const dataCollectionEndpointAssociationName = "configurationAccessEndpoint"
+
+type DataCollectionRuleAssociation struct {
+	Name                     string `tfschema:"name"`
+	TargetResourceId         string `tfschema:"target_resource_id"`
+	DataCollectionEndpointId string `tfschema:"data_collection_endpoint_id"`
+	DataCollectionRuleId     string `tfschema:"data_collection_rule_id"`
+	Description              string `tfschema:"description"`
+}
+
+type DataCollectionRuleAssociationResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = DataCollectionRuleAssociationResource{}
+	_ sdk.ResourceWithCustomizeDiff = DataCollectionRuleAssociationResource{}
+)
+
+func (r DataCollectionRuleAssociationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      dataCollectionEndpointAssociationName,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"data_collection_endpoint_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: datacollectionendpoints.ValidateDataCollectionEndpointID,
+			ExactlyOneOf: []string{"data_collection_endpoint_id", "data_collection_rule_id"},
+		},
+
+		"data_collection_rule_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: datacollectionruleassociations.ValidateDataCollectionRuleID,
+			ExactlyOneOf: []string{"data_collection_endpoint_id", "data_collection_rule_id"},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r DataCollectionRuleAssociationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DataCollectionRuleAssociationResource) ResourceType() string {
+	return "azurerm_monitor_data_collection_rule_association"
+}
+
+func (r DataCollectionRuleAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return datacollectionruleassociations.ValidateScopedDataCollectionRuleAssociationID
+}
+
+func (r DataCollectionRuleAssociationResource) ModelObject() interface{} {
+	return &DataCollectionRuleAssociation{}
+}
+
+func (r DataCollectionRuleAssociationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			metadata.Logger.Info("Decoding state..")
+			var state DataCollectionRuleAssociation
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Monitor.DataCollectionRuleAssociationsClient
+			id := datacollectionruleassociations.NewScopedDataCollectionRuleAssociationID(state.TargetResourceId, state.Name)
+			metadata.Logger.Infof("creating %s", id)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := datacollectionruleassociations.DataCollectionRuleAssociationProxyOnlyResource{
+				Name: utils.String(state.Name),
+				Properties: &datacollectionruleassociations.DataCollectionRuleAssociation{
+					DataCollectionEndpointId: expandDataCollectionRuleAssociationOptionalString(state.DataCollectionEndpointId),
+					DataCollectionRuleId:     expandDataCollectionRuleAssociationOptionalString(state.DataCollectionRuleId),
+					Description:              expandDataCollectionRuleAssociationOptionalString(state.Description),
+				},
+			}
+
+			if _, err := client.Create(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r DataCollectionRuleAssociationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DataCollectionRuleAssociationsClient
+			id, err := datacollectionruleassociations.ParseScopedDataCollectionRuleAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("retrieving %s", *id)
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					metadata.Logger.Infof("%s was not found - removing from state!", *id)
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DataCollectionRuleAssociation{
+				Name:             id.AssociationName,
+				TargetResourceId: id.ResourceUri,
+			}
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Description = utils.NormalizeNilableString(props.Description)
+
+					if props.DataCollectionEndpointId != nil {
+						endpointId, err := datacollectionendpoints.ParseDataCollectionEndpointIDInsensitively(*props.DataCollectionEndpointId)
+						if err != nil {
+							return err
+						}
+						state.DataCollectionEndpointId = endpointId.ID()
+					}
+
+					if props.DataCollectionRuleId != nil {
+						ruleId, err := datacollectionruleassociations.ParseDataCollectionRuleIDInsensitively(*props.DataCollectionRuleId)
+						if err != nil {
+							return err
+						}
+						state.DataCollectionRuleId = ruleId.ID()
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r DataCollectionRuleAssociationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := datacollectionruleassociations.ParseScopedDataCollectionRuleAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("updating %s..", *id)
+			client := metadata.Client.Monitor.DataCollectionRuleAssociationsClient
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if resp.Model == nil {
+				return fmt.Errorf("unexpected null model of %s", *id)
+			}
+			existing := resp.Model
+			if existing.Properties == nil {
+				return fmt.Errorf("unexpected null properties of %s", *id)
+			}
+
+			var state DataCollectionRuleAssociation
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChange("data_collection_endpoint_id") {
+				existing.Properties.DataCollectionEndpointId = expandDataCollectionRuleAssociationOptionalString(state.DataCollectionEndpointId)
+			}
+
+			if metadata.ResourceData.HasChange("data_collection_rule_id") {
+				existing.Properties.DataCollectionRuleId = expandDataCollectionRuleAssociationOptionalString(state.DataCollectionRuleId)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				existing.Properties.Description = utils.String(state.Description)
+			}
+
+			if _, err := client.Create(ctx, *id, *existing); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r DataCollectionRuleAssociationResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			// the IDs may not be known until apply, so whether they're specified is determined from the config
+			config := rd.GetRawConfig()
+			hasEndpoint := !config.IsNull() && !config.GetAttr("data_collection_endpoint_id").IsNull()
+			hasRule := !config.IsNull() && !config.GetAttr("data_collection_rule_id").IsNull()
+
+			if rd.NewValueKnown("name") {
+				if err := validateDataCollectionRuleAssociationName(rd.Get("name").(string), hasEndpoint, hasRule); err != nil {
+					return err
+				}
+			}
+
+			// switching between associating a Data Collection Endpoint and a Data Collection Rule requires a new association
+			if rd.Id() != "" {
+				oldEndpoint, _ := rd.GetChange("data_collection_endpoint_id")
+				if oldEndpoint.(string) != "" && hasRule && rd.HasChange("data_collection_rule_id") {
+					if err := rd.ForceNew("data_collection_rule_id"); err != nil {
+						return err
+					}
+				}
+
+				oldRule, _ := rd.GetChange("data_collection_rule_id")
+				if oldRule.(string) != "" && hasEndpoint && rd.HasChange("data_collection_endpoint_id") {
+					if err := rd.ForceNew("data_collection_endpoint_id"); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r DataCollectionRuleAssociationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DataCollectionRuleAssociationsClient
+			id, err := datacollectionruleassociations.ParseScopedDataCollectionRuleAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			resp, err := client.Delete(ctx, *id)
+			if err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+// validateDataCollectionRuleAssociationName checks the name is appropriate for the kind of association, since the
+// API requires associations with a Data Collection Endpoint to use a fixed name (which rules can't use)
+func validateDataCollectionRuleAssociationName(name string, hasEndpoint, hasRule bool) error {
+	if hasEndpoint && name != dataCollectionEndpointAssociationName {
+		return fmt.Errorf("`name` must be %q when `data_collection_endpoint_id` is specified", dataCollectionEndpointAssociationName)
+	}
+
+	if hasRule && name == dataCollectionEndpointAssociationName {
+		return fmt.Errorf("`name` must be specified (and not be %q) when `data_collection_rule_id` is specified", dataCollectionEndpointAssociationName)
+	}
+
+	return nil
+}
+
+func expandDataCollectionRuleAssociationOptionalString(input string) *string {
+	if input == "" {
+		return nil
+	}
+
+	return utils.String(input)
+}
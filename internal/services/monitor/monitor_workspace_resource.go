@@ -0,0 +1,259 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionrules"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-04-03/azuremonitorworkspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorWorkspace struct {
+	Name                            string                 `tfschema:"name"`
+	ResourceGroupName               string                 `tfschema:"resource_group_name"`
+	Location                        string                 `tfschema:"location"`
+	EnablePublicNetworkAccess       bool                   `tfschema:"public_network_access_enabled"`
+	Tags                            map[string]interface{} `tfschema:"tags"`
+	DefaultDataCollectionEndpointId string                 `tfschema:"default_data_collection_endpoint_id"`
+	DefaultDataCollectionRuleId     string                 `tfschema:"default_data_collection_rule_id"`
+	QueryEndpoint                   string                 `tfschema:"query_endpoint"`
+}
+
+type MonitorWorkspaceResource struct{}
+
+var _ sdk.ResourceWithUpdate = MonitorWorkspaceResource{}
+
+func (r MonitorWorkspaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{2,42}[a-zA-Z0-9]$`),
+				"`name` must be between 4 and 44 characters, begin and end with a letter or number and can only contain letters, numbers and hyphens",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		// NOTE: the API only supports updating the Tags, so this can't be changed in-place
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MonitorWorkspaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"default_data_collection_endpoint_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"default_data_collection_rule_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"query_endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MonitorWorkspaceResource) ResourceType() string {
+	return "azurerm_monitor_workspace"
+}
+
+func (r MonitorWorkspaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuremonitorworkspaces.ValidateAccountID
+}
+
+func (r MonitorWorkspaceResource) ModelObject() interface{} {
+	return &MonitorWorkspace{}
+}
+
+func (r MonitorWorkspaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			metadata.Logger.Info("Decoding state..")
+			var state MonitorWorkspace
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Monitor.AzureMonitorWorkspacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := azuremonitorworkspaces.NewAccountID(subscriptionId, state.ResourceGroupName, state.Name)
+			metadata.Logger.Infof("creating %s", id)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := azuremonitorworkspaces.AzureMonitorWorkspaceResource{
+				Location: azure.NormalizeLocation(state.Location),
+				Properties: &azuremonitorworkspaces.AzureMonitorWorkspace{
+					PublicNetworkAccess: expandMonitorWorkspacePublicNetworkAccess(state.EnablePublicNetworkAccess),
+				},
+				Tags: tags.Expand(state.Tags),
+			}
+
+			if _, err := client.Create(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r MonitorWorkspaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AzureMonitorWorkspacesClient
+			id, err := azuremonitorworkspaces.ParseAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("retrieving %s", *id)
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					metadata.Logger.Infof("%s was not found - removing from state!", *id)
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MonitorWorkspace{
+				Name:              id.AccountName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+			if model := resp.Model; model != nil {
+				state.Location = azure.NormalizeLocation(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.EnablePublicNetworkAccess = flattenMonitorWorkspacePublicNetworkAccess(props.PublicNetworkAccess)
+
+					if props.Metrics != nil {
+						state.QueryEndpoint = utils.NormalizeNilableString(props.Metrics.PrometheusQueryEndpoint)
+					}
+
+					// the default Data Collection Endpoint & Rule are created (and managed) by the service
+					if settings := props.DefaultIngestionSettings; settings != nil {
+						if settings.DataCollectionEndpointResourceId != nil {
+							endpointId, err := datacollectionendpoints.ParseDataCollectionEndpointIDInsensitively(*settings.DataCollectionEndpointResourceId)
+							if err != nil {
+								return err
+							}
+							state.DefaultDataCollectionEndpointId = endpointId.ID()
+						}
+
+						if settings.DataCollectionRuleResourceId != nil {
+							ruleId, err := datacollectionrules.ParseDataCollectionRuleIDInsensitively(*settings.DataCollectionRuleResourceId)
+							if err != nil {
+								return err
+							}
+							state.DefaultDataCollectionRuleId = ruleId.ID()
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r MonitorWorkspaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := azuremonitorworkspaces.ParseAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("updating %s..", *id)
+			client := metadata.Client.Monitor.AzureMonitorWorkspacesClient
+
+			var state MonitorWorkspace
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			input := azuremonitorworkspaces.AzureMonitorWorkspaceResourceForUpdate{}
+			if metadata.ResourceData.HasChange("tags") {
+				input.Tags = tags.Expand(state.Tags)
+			}
+
+			if _, err := client.Update(ctx, *id, input); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r MonitorWorkspaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AzureMonitorWorkspacesClient
+			id, err := azuremonitorworkspaces.ParseAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func expandMonitorWorkspacePublicNetworkAccess(input bool) *azuremonitorworkspaces.PublicNetworkAccess {
+	result := azuremonitorworkspaces.PublicNetworkAccessDisabled
+	if input {
+		result = azuremonitorworkspaces.PublicNetworkAccessEnabled
+	}
+	return &result
+}
+
+func flattenMonitorWorkspacePublicNetworkAccess(input *azuremonitorworkspaces.PublicNetworkAccess) bool {
+	// the API defaults to Public Network Access being enabled when it's not specified
+	return input == nil || *input == azuremonitorworkspaces.PublicNetworkAccessEnabled
+}
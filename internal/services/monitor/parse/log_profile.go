@@ -59,3 +59,23 @@ func LogProfileID(input string) (*LogProfileId, error) {
 
 	return &resourceId, nil
 }
+
+// LogProfileIDFromNames builds an LogProfileId from the names of each segment in the format
+// "[{subscriptionId}/]{name}", using the specified Subscription ID when this is omitted
+func LogProfileIDFromNames(subscriptionId, input string) (*LogProfileId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 1 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{name}")
+		}
+	}
+
+	resourceId := NewLogProfileID(names[0], names[1])
+	return &resourceId, nil
+}
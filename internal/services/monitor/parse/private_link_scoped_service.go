@@ -73,3 +73,23 @@ func PrivateLinkScopedServiceID(input string) (*PrivateLinkScopedServiceId, erro
 
 	return &resourceId, nil
 }
+
+// PrivateLinkScopedServiceIDFromNames builds an PrivateLinkScopedServiceId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{privateLinkScopeName}/{scopedResourceName}", using the specified Subscription ID when this is omitted
+func PrivateLinkScopedServiceIDFromNames(subscriptionId, input string) (*PrivateLinkScopedServiceId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{privateLinkScopeName}/{scopedResourceName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{privateLinkScopeName}/{scopedResourceName}")
+		}
+	}
+
+	resourceId := NewPrivateLinkScopedServiceID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -111,3 +111,23 @@ func ScheduledQueryRulesIDInsensitively(input string) (*ScheduledQueryRulesId, e
 
 	return &resourceId, nil
 }
+
+// ScheduledQueryRulesIDFromNames builds an ScheduledQueryRulesId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{scheduledQueryRuleName}", using the specified Subscription ID when this is omitted
+func ScheduledQueryRulesIDFromNames(subscriptionId, input string) (*ScheduledQueryRulesId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{scheduledQueryRuleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{scheduledQueryRuleName}")
+		}
+	}
+
+	resourceId := NewScheduledQueryRulesID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
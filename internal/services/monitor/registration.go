@@ -25,7 +25,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		DataCollectionEndpointResource{},
+		DataCollectionRuleAssociationResource{},
 		DataCollectionRuleResource{},
+		MonitorWorkspaceResource{},
 		ScheduledQueryRulesAlertV2Resource{},
 	}
 }
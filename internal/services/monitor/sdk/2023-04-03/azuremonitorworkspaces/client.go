@@ -0,0 +1,15 @@
+package azuremonitorworkspaces
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AzureMonitorWorkspacesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAzureMonitorWorkspacesClientWithBaseURI(endpoint string) AzureMonitorWorkspacesClient {
+	return AzureMonitorWorkspacesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
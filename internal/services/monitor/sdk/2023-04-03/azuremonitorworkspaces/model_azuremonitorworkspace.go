@@ -0,0 +1,9 @@
+package azuremonitorworkspaces
+
+type AzureMonitorWorkspace struct {
+	AccountId                *string              `json:"accountId,omitempty"`
+	DefaultIngestionSettings *IngestionSettings   `json:"defaultIngestionSettings,omitempty"`
+	Metrics                  *Metrics             `json:"metrics,omitempty"`
+	ProvisioningState        *ProvisioningState   `json:"provisioningState,omitempty"`
+	PublicNetworkAccess      *PublicNetworkAccess `json:"publicNetworkAccess,omitempty"`
+}
@@ -0,0 +1,5 @@
+package azuremonitorworkspaces
+
+type AzureMonitorWorkspaceResourceForUpdate struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
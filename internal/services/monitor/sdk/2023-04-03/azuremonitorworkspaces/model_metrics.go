@@ -0,0 +1,6 @@
+package azuremonitorworkspaces
+
+type Metrics struct {
+	InternalId              *string `json:"internalId,omitempty"`
+	PrometheusQueryEndpoint *string `json:"prometheusQueryEndpoint,omitempty"`
+}
@@ -20,6 +20,9 @@ type Client struct {
 	InstanceFailoverGroupsClient                       *sql.InstanceFailoverGroupsClient
 	JobAgentsClient                                    *sql.JobAgentsClient
 	JobCredentialsClient                               *sql.JobCredentialsClient
+	JobsClient                                         *sql.JobsClient
+	JobStepsClient                                     *sql.JobStepsClient
+	JobTargetGroupsClient                              *sql.JobTargetGroupsClient
 	LongTermRetentionPoliciesClient                    *sql.LongTermRetentionPoliciesClient
 	ManagedDatabasesClient                             *sql.ManagedDatabasesClient
 	ManagedInstancesClient                             *sql.ManagedInstancesClient
@@ -83,6 +86,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	jobCredentialsClient := sql.NewJobCredentialsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&jobCredentialsClient.Client, o.ResourceManagerAuthorizer)
 
+	jobsClient := sql.NewJobsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobStepsClient := sql.NewJobStepsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobStepsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobTargetGroupsClient := sql.NewJobTargetGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobTargetGroupsClient.Client, o.ResourceManagerAuthorizer)
+
 	longTermRetentionPoliciesClient := sql.NewLongTermRetentionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&longTermRetentionPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -160,6 +172,9 @@ func NewClient(o *common.ClientOptions) *Client {
 		InstanceFailoverGroupsClient:                    &instanceFailoverGroupsClient,
 		JobAgentsClient:                                 &jobAgentsClient,
 		JobCredentialsClient:                            &jobCredentialsClient,
+		JobsClient:                                      &jobsClient,
+		JobStepsClient:                                  &jobStepsClient,
+		JobTargetGroupsClient:                           &jobTargetGroupsClient,
 		LongTermRetentionPoliciesClient:                 &longTermRetentionPoliciesClient,
 		ManagedDatabasesClient:                          &managedDatabasesClient,
 		ManagedInstanceAdministratorsClient:             &managedInstancesAdministratorsClient,
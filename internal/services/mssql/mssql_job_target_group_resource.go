@@ -0,0 +1,279 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMsSqlJobTargetGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMsSqlJobTargetGroupCreateUpdate,
+		Read:   resourceMsSqlJobTargetGroupRead,
+		Update: resourceMsSqlJobTargetGroupCreateUpdate,
+		Delete: resourceMsSqlJobTargetGroupDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.JobTargetGroupID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"job_agent_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.JobAgentID,
+			},
+
+			"job_target": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"server_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateMsSqlServerName,
+						},
+
+						"database_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateMsSqlDatabaseName,
+						},
+
+						"elastic_pool_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateMsSqlElasticPoolName,
+						},
+
+						// used to enumerate the databases within a Server or Elastic Pool when the Job runs
+						"job_credential_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.JobCredentialID,
+						},
+
+						"membership_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(sql.JobTargetGroupMembershipTypeInclude),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.JobTargetGroupMembershipTypeInclude),
+								string(sql.JobTargetGroupMembershipTypeExclude),
+							}, false),
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMsSqlJobTargetGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Job Target Group creation.")
+
+	jaId, err := parse.JobAgentID(d.Get("job_agent_id").(string))
+	if err != nil {
+		return err
+	}
+	targetGroupId := parse.NewJobTargetGroupID(jaId.SubscriptionId, jaId.ResourceGroup, jaId.ServerName, jaId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, targetGroupId.ResourceGroup, targetGroupId.ServerName, targetGroupId.JobAgentName, targetGroupId.TargetGroupName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing MsSql %s: %+v", targetGroupId, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_mssql_job_target_group", targetGroupId.ID())
+		}
+	}
+
+	members, err := expandMsSqlJobTargets(d.Get("job_target").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `job_target`: %+v", err)
+	}
+
+	targetGroup := sql.JobTargetGroup{
+		Name: utils.String(targetGroupId.TargetGroupName),
+		JobTargetGroupProperties: &sql.JobTargetGroupProperties{
+			Members: members,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, targetGroupId.ResourceGroup, targetGroupId.ServerName, targetGroupId.JobAgentName, targetGroupId.TargetGroupName, targetGroup); err != nil {
+		return fmt.Errorf("creating MsSql %s: %+v", targetGroupId, err)
+	}
+
+	d.SetId(targetGroupId.ID())
+
+	return resourceMsSqlJobTargetGroupRead(d, meta)
+}
+
+func resourceMsSqlJobTargetGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobTargetGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("reading MsSql %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TargetGroupName)
+
+	jobAgentId := parse.NewJobAgentID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName)
+	d.Set("job_agent_id", jobAgentId.ID())
+
+	members := make([]interface{}, 0)
+	if props := resp.JobTargetGroupProperties; props != nil {
+		members, err = flattenMsSqlJobTargets(props.Members)
+		if err != nil {
+			return fmt.Errorf("flattening `job_target`: %+v", err)
+		}
+	}
+	if err := d.Set("job_target", members); err != nil {
+		return fmt.Errorf("setting `job_target`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceMsSqlJobTargetGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobTargetGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName); err != nil {
+		return fmt.Errorf("deleting MsSql %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMsSqlJobTargets(input []interface{}) (*[]sql.JobTarget, error) {
+	targets := make([]sql.JobTarget, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		serverName := v["server_name"].(string)
+		databaseName := v["database_name"].(string)
+		elasticPoolName := v["elastic_pool_name"].(string)
+		credentialId := v["job_credential_id"].(string)
+		membershipType := sql.JobTargetGroupMembershipType(v["membership_type"].(string))
+
+		if databaseName != "" && elasticPoolName != "" {
+			return nil, fmt.Errorf("only one of `database_name` and `elastic_pool_name` can be specified for the target on server %q", serverName)
+		}
+
+		// the type of the target is inferred from which fields are specified
+		target := sql.JobTarget{
+			MembershipType: membershipType,
+			ServerName:     utils.String(serverName),
+		}
+		switch {
+		case databaseName != "":
+			target.Type = sql.JobTargetTypeSQLDatabase
+			target.DatabaseName = utils.String(databaseName)
+		case elasticPoolName != "":
+			target.Type = sql.JobTargetTypeSQLElasticPool
+			target.ElasticPoolName = utils.String(elasticPoolName)
+		default:
+			target.Type = sql.JobTargetTypeSQLServer
+		}
+
+		// a credential is needed to enumerate the databases within a Server or Elastic Pool - but only when they're included
+		if target.Type != sql.JobTargetTypeSQLDatabase && membershipType == sql.JobTargetGroupMembershipTypeInclude && credentialId == "" {
+			return nil, fmt.Errorf("`job_credential_id` must be specified when including a Server or Elastic Pool (server %q)", serverName)
+		}
+		if credentialId != "" {
+			target.RefreshCredential = utils.String(credentialId)
+		}
+
+		targets = append(targets, target)
+	}
+
+	return &targets, nil
+}
+
+func flattenMsSqlJobTargets(input *[]sql.JobTarget) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, target := range *input {
+		credentialId := ""
+		if target.RefreshCredential != nil {
+			id, err := parse.JobCredentialID(*target.RefreshCredential)
+			if err != nil {
+				return nil, err
+			}
+			credentialId = id.ID()
+		}
+
+		output = append(output, map[string]interface{}{
+			"server_name":       utils.NormalizeNilableString(target.ServerName),
+			"database_name":     utils.NormalizeNilableString(target.DatabaseName),
+			"elastic_pool_name": utils.NormalizeNilableString(target.ElasticPoolName),
+			"job_credential_id": credentialId,
+			"membership_type":   string(target.MembershipType),
+			"type":              string(target.Type),
+		})
+	}
+
+	return output, nil
+}
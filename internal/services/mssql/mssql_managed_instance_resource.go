@@ -325,7 +325,8 @@ func (r MsSqlManagedInstanceResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
-			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			// the creation of a Managed Instance can take several hours, so should this time out this is resumed during the next run
+			if err = metadata.WaitForCreation(ctx, id, future.FutureAPI, client.Client); err != nil {
 				if response.WasConflict(future.Response()) {
 					return fmt.Errorf("sql managed instance names need to be globally unique and %q is already in use", id.Name)
 				}
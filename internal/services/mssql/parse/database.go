@@ -73,3 +73,23 @@ func DatabaseID(input string) (*DatabaseId, error) {
 
 	return &resourceId, nil
 }
+
+// DatabaseIDFromNames builds an DatabaseId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverName}/{name}", using the specified Subscription ID when this is omitted
+func DatabaseIDFromNames(subscriptionId, input string) (*DatabaseId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{name}")
+		}
+	}
+
+	resourceId := NewDatabaseID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
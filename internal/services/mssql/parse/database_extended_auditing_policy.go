@@ -79,3 +79,23 @@ func DatabaseExtendedAuditingPolicyID(input string) (*DatabaseExtendedAuditingPo
 
 	return &resourceId, nil
 }
+
+// DatabaseExtendedAuditingPolicyIDFromNames builds an DatabaseExtendedAuditingPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverName}/{databaseName}/{extendedAuditingSettingName}", using the specified Subscription ID when this is omitted
+func DatabaseExtendedAuditingPolicyIDFromNames(subscriptionId, input string) (*DatabaseExtendedAuditingPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{databaseName}/{extendedAuditingSettingName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{databaseName}/{extendedAuditingSettingName}")
+		}
+	}
+
+	resourceId := NewDatabaseExtendedAuditingPolicyID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
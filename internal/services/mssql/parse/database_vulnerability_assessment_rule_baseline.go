@@ -91,3 +91,23 @@ func DatabaseVulnerabilityAssessmentRuleBaselineID(input string) (*DatabaseVulne
 
 	return &resourceId, nil
 }
+
+// DatabaseVulnerabilityAssessmentRuleBaselineIDFromNames builds an DatabaseVulnerabilityAssessmentRuleBaselineId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverName}/{databaseName}/{vulnerabilityAssessmentName}/{ruleName}/{baselineName}", using the specified Subscription ID when this is omitted
+func DatabaseVulnerabilityAssessmentRuleBaselineIDFromNames(subscriptionId, input string) (*DatabaseVulnerabilityAssessmentRuleBaselineId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 6 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 7 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{databaseName}/{vulnerabilityAssessmentName}/{ruleName}/{baselineName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{databaseName}/{vulnerabilityAssessmentName}/{ruleName}/{baselineName}")
+		}
+	}
+
+	resourceId := NewDatabaseVulnerabilityAssessmentRuleBaselineID(names[0], names[1], names[2], names[3], names[4], names[5], names[6])
+	return &resourceId, nil
+}
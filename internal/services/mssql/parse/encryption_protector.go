@@ -73,3 +73,23 @@ func EncryptionProtectorID(input string) (*EncryptionProtectorId, error) {
 
 	return &resourceId, nil
 }
+
+// EncryptionProtectorIDFromNames builds an EncryptionProtectorId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverName}/{encryptionProtectorName}", using the specified Subscription ID when this is omitted
+func EncryptionProtectorIDFromNames(subscriptionId, input string) (*EncryptionProtectorId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{encryptionProtectorName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{encryptionProtectorName}")
+		}
+	}
+
+	resourceId := NewEncryptionProtectorID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
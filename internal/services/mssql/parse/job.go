@@ -0,0 +1,101 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type JobId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	JobAgentName   string
+	Name           string
+}
+
+func NewJobID(subscriptionId, resourceGroup, serverName, jobAgentName, name string) JobId {
+	return JobId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		JobAgentName:   jobAgentName,
+		Name:           name,
+	}
+}
+
+func (id JobId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Job Agent Name %q", id.JobAgentName),
+		fmt.Sprintf("Server Name %q", id.ServerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Job", segmentsStr)
+}
+
+func (id JobId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/jobAgents/%s/jobs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName, id.Name)
+}
+
+// JobID parses a Job ID into an JobId struct
+func JobID(input string) (*JobId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := JobId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, err
+	}
+	if resourceId.JobAgentName, err = id.PopSegment("jobAgents"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("jobs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// JobIDFromNames builds an JobId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverName}/{jobAgentName}/{name}", using the specified Subscription ID when this is omitted
+func JobIDFromNames(subscriptionId, input string) (*JobId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{jobAgentName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{jobAgentName}/{name}")
+		}
+	}
+
+	resourceId := NewJobID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
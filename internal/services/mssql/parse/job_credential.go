@@ -79,3 +79,23 @@ func JobCredentialID(input string) (*JobCredentialId, error) {
 
 	return &resourceId, nil
 }
+
+// JobCredentialIDFromNames builds an JobCredentialId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverName}/{jobAgentName}/{credentialName}", using the specified Subscription ID when this is omitted
+func JobCredentialIDFromNames(subscriptionId, input string) (*JobCredentialId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{jobAgentName}/{credentialName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{jobAgentName}/{credentialName}")
+		}
+	}
+
+	resourceId := NewJobCredentialID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
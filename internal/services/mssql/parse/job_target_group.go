@@ -0,0 +1,101 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type JobTargetGroupId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	ServerName      string
+	JobAgentName    string
+	TargetGroupName string
+}
+
+func NewJobTargetGroupID(subscriptionId, resourceGroup, serverName, jobAgentName, targetGroupName string) JobTargetGroupId {
+	return JobTargetGroupId{
+		SubscriptionId:  subscriptionId,
+		ResourceGroup:   resourceGroup,
+		ServerName:      serverName,
+		JobAgentName:    jobAgentName,
+		TargetGroupName: targetGroupName,
+	}
+}
+
+func (id JobTargetGroupId) String() string {
+	segments := []string{
+		fmt.Sprintf("Target Group Name %q", id.TargetGroupName),
+		fmt.Sprintf("Job Agent Name %q", id.JobAgentName),
+		fmt.Sprintf("Server Name %q", id.ServerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Job Target Group", segmentsStr)
+}
+
+func (id JobTargetGroupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/jobAgents/%s/targetGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName)
+}
+
+// JobTargetGroupID parses a JobTargetGroup ID into an JobTargetGroupId struct
+func JobTargetGroupID(input string) (*JobTargetGroupId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := JobTargetGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, err
+	}
+	if resourceId.JobAgentName, err = id.PopSegment("jobAgents"); err != nil {
+		return nil, err
+	}
+	if resourceId.TargetGroupName, err = id.PopSegment("targetGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// JobTargetGroupIDFromNames builds an JobTargetGroupId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverName}/{jobAgentName}/{targetGroupName}", using the specified Subscription ID when this is omitted
+func JobTargetGroupIDFromNames(subscriptionId, input string) (*JobTargetGroupId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{jobAgentName}/{targetGroupName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{jobAgentName}/{targetGroupName}")
+		}
+	}
+
+	resourceId := NewJobTargetGroupID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func ManagedDatabaseID(input string) (*ManagedDatabaseId, error) {
 
 	return &resourceId, nil
 }
+
+// ManagedDatabaseIDFromNames builds an ManagedDatabaseId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{managedInstanceName}/{databaseName}", using the specified Subscription ID when this is omitted
+func ManagedDatabaseIDFromNames(subscriptionId, input string) (*ManagedDatabaseId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{managedInstanceName}/{databaseName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{managedInstanceName}/{databaseName}")
+		}
+	}
+
+	resourceId := NewManagedDatabaseID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func ManagedInstanceVulnerabilityAssessmentID(input string) (*ManagedInstanceVul
 
 	return &resourceId, nil
 }
+
+// ManagedInstanceVulnerabilityAssessmentIDFromNames builds an ManagedInstanceVulnerabilityAssessmentId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{managedInstanceName}/{vulnerabilityAssessmentName}", using the specified Subscription ID when this is omitted
+func ManagedInstanceVulnerabilityAssessmentIDFromNames(subscriptionId, input string) (*ManagedInstanceVulnerabilityAssessmentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{managedInstanceName}/{vulnerabilityAssessmentName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{managedInstanceName}/{vulnerabilityAssessmentName}")
+		}
+	}
+
+	resourceId := NewManagedInstanceVulnerabilityAssessmentID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func RecoverableDatabaseID(input string) (*RecoverableDatabaseId, error) {
 
 	return &resourceId, nil
 }
+
+// RecoverableDatabaseIDFromNames builds an RecoverableDatabaseId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverName}/{name}", using the specified Subscription ID when this is omitted
+func RecoverableDatabaseIDFromNames(subscriptionId, input string) (*RecoverableDatabaseId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{name}")
+		}
+	}
+
+	resourceId := NewRecoverableDatabaseID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func ServerDNSAliasID(input string) (*ServerDNSAliasId, error) {
 
 	return &resourceId, nil
 }
+
+// ServerDNSAliasIDFromNames builds an ServerDNSAliasId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverName}/{dnsAliaseName}", using the specified Subscription ID when this is omitted
+func ServerDNSAliasIDFromNames(subscriptionId, input string) (*ServerDNSAliasId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{dnsAliaseName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{dnsAliaseName}")
+		}
+	}
+
+	resourceId := NewServerDNSAliasID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
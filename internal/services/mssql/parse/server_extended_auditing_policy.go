@@ -73,3 +73,23 @@ func ServerExtendedAuditingPolicyID(input string) (*ServerExtendedAuditingPolicy
 
 	return &resourceId, nil
 }
+
+// ServerExtendedAuditingPolicyIDFromNames builds an ServerExtendedAuditingPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverName}/{extendedAuditingSettingName}", using the specified Subscription ID when this is omitted
+func ServerExtendedAuditingPolicyIDFromNames(subscriptionId, input string) (*ServerExtendedAuditingPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{extendedAuditingSettingName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{extendedAuditingSettingName}")
+		}
+	}
+
+	resourceId := NewServerExtendedAuditingPolicyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
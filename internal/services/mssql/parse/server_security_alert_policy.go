@@ -73,3 +73,23 @@ func ServerSecurityAlertPolicyID(input string) (*ServerSecurityAlertPolicyId, er
 
 	return &resourceId, nil
 }
+
+// ServerSecurityAlertPolicyIDFromNames builds an ServerSecurityAlertPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverName}/{securityAlertPolicyName}", using the specified Subscription ID when this is omitted
+func ServerSecurityAlertPolicyIDFromNames(subscriptionId, input string) (*ServerSecurityAlertPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{securityAlertPolicyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{securityAlertPolicyName}")
+		}
+	}
+
+	resourceId := NewServerSecurityAlertPolicyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func ServerVulnerabilityAssessmentID(input string) (*ServerVulnerabilityAssessme
 
 	return &resourceId, nil
 }
+
+// ServerVulnerabilityAssessmentIDFromNames builds an ServerVulnerabilityAssessmentId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverName}/{vulnerabilityAssessmentName}", using the specified Subscription ID when this is omitted
+func ServerVulnerabilityAssessmentIDFromNames(subscriptionId, input string) (*ServerVulnerabilityAssessmentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{vulnerabilityAssessmentName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{vulnerabilityAssessmentName}")
+		}
+	}
+
+	resourceId := NewServerVulnerabilityAssessmentID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
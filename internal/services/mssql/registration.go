@@ -45,7 +45,10 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_mssql_database_vulnerability_assessment_rule_baseline": resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaseline(),
 		"azurerm_mssql_elasticpool":                                     resourceMsSqlElasticPool(),
 		"azurerm_mssql_job_agent":                                       resourceMsSqlJobAgent(),
+		"azurerm_mssql_job":                                             resourceMsSqlJob(),
 		"azurerm_mssql_job_credential":                                  resourceMsSqlJobCredential(),
+		"azurerm_mssql_job_step":                                        resourceMsSqlJobStep(),
+		"azurerm_mssql_job_target_group":                                resourceMsSqlJobTargetGroup(),
 		"azurerm_mssql_managed_instance_vulnerability_assessment":       resourceMsSqlManagedInstanceVulnerabilityAssessment(),
 		"azurerm_mssql_firewall_rule":                                   resourceMsSqlFirewallRule(),
 		"azurerm_mssql_outbound_firewall_rule":                          resourceMsSqlOutboundFirewallRule(),
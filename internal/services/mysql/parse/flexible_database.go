@@ -73,3 +73,23 @@ func FlexibleDatabaseID(input string) (*FlexibleDatabaseId, error) {
 
 	return &resourceId, nil
 }
+
+// FlexibleDatabaseIDFromNames builds an FlexibleDatabaseId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{flexibleServerName}/{databaseName}", using the specified Subscription ID when this is omitted
+func FlexibleDatabaseIDFromNames(subscriptionId, input string) (*FlexibleDatabaseId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{flexibleServerName}/{databaseName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{flexibleServerName}/{databaseName}")
+		}
+	}
+
+	resourceId := NewFlexibleDatabaseID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
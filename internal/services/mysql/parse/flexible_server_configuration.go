@@ -73,3 +73,23 @@ func FlexibleServerConfigurationID(input string) (*FlexibleServerConfigurationId
 
 	return &resourceId, nil
 }
+
+// FlexibleServerConfigurationIDFromNames builds an FlexibleServerConfigurationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{flexibleServerName}/{configurationName}", using the specified Subscription ID when this is omitted
+func FlexibleServerConfigurationIDFromNames(subscriptionId, input string) (*FlexibleServerConfigurationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{flexibleServerName}/{configurationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{flexibleServerName}/{configurationName}")
+		}
+	}
+
+	resourceId := NewFlexibleServerConfigurationID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func FlexibleServerFirewallRuleID(input string) (*FlexibleServerFirewallRuleId,
 
 	return &resourceId, nil
 }
+
+// FlexibleServerFirewallRuleIDFromNames builds an FlexibleServerFirewallRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{flexibleServerName}/{firewallRuleName}", using the specified Subscription ID when this is omitted
+func FlexibleServerFirewallRuleIDFromNames(subscriptionId, input string) (*FlexibleServerFirewallRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{flexibleServerName}/{firewallRuleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{flexibleServerName}/{firewallRuleName}")
+		}
+	}
+
+	resourceId := NewFlexibleServerFirewallRuleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
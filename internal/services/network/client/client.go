@@ -62,6 +62,8 @@ type Client struct {
 	PrivateLinkServiceClient               *network.PrivateLinkServicesClient
 	ServiceAssociationLinkClient           *network.ServiceAssociationLinksClient
 	ResourceNavigationLinkClient           *network.ResourceNavigationLinksClient
+
+	options *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -290,5 +292,20 @@ func NewClient(o *common.ClientOptions) *Client {
 		PrivateLinkServiceClient:               &PrivateLinkServiceClient,
 		ServiceAssociationLinkClient:           &ServiceAssociationLinkClient,
 		ResourceNavigationLinkClient:           &ResourceNavigationLinkClient,
+
+		options: o,
+	}
+}
+
+// VnetPeeringsClientForSubscription returns a VirtualNetworkPeeringsClient for the specified Subscription, which
+// uses the same credentials and configuration as the Provider
+func (c Client) VnetPeeringsClientForSubscription(subscriptionId string) *network.VirtualNetworkPeeringsClient {
+	if subscriptionId == c.VnetPeeringsClient.SubscriptionID {
+		return c.VnetPeeringsClient
 	}
+
+	o := c.options.ForSubscription(subscriptionId)
+	vnetPeeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vnetPeeringsClient.Client, o.ResourceManagerAuthorizer)
+	return &vnetPeeringsClient
 }
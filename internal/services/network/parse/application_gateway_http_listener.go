@@ -73,3 +73,23 @@ func ApplicationGatewayHTTPListenerID(input string) (*ApplicationGatewayHTTPList
 
 	return &resourceId, nil
 }
+
+// ApplicationGatewayHTTPListenerIDFromNames builds an ApplicationGatewayHTTPListenerId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{httpListenerName}", using the specified Subscription ID when this is omitted
+func ApplicationGatewayHTTPListenerIDFromNames(subscriptionId, input string) (*ApplicationGatewayHTTPListenerId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{httpListenerName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{httpListenerName}")
+		}
+	}
+
+	resourceId := NewApplicationGatewayHTTPListenerID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func ApplicationGatewayURLPathMapPathRuleID(input string) (*ApplicationGatewayUR
 
 	return &resourceId, nil
 }
+
+// ApplicationGatewayURLPathMapPathRuleIDFromNames builds an ApplicationGatewayURLPathMapPathRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{urlPathMapName}/{pathRuleName}", using the specified Subscription ID when this is omitted
+func ApplicationGatewayURLPathMapPathRuleIDFromNames(subscriptionId, input string) (*ApplicationGatewayURLPathMapPathRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{urlPathMapName}/{pathRuleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{urlPathMapName}/{pathRuleName}")
+		}
+	}
+
+	resourceId := NewApplicationGatewayURLPathMapPathRuleID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
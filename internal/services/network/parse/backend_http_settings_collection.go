@@ -73,3 +73,23 @@ func BackendHttpSettingsCollectionID(input string) (*BackendHttpSettingsCollecti
 
 	return &resourceId, nil
 }
+
+// BackendHttpSettingsCollectionIDFromNames builds an BackendHttpSettingsCollectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{backendHttpSettingsCollectionName}", using the specified Subscription ID when this is omitted
+func BackendHttpSettingsCollectionIDFromNames(subscriptionId, input string) (*BackendHttpSettingsCollectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{backendHttpSettingsCollectionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{backendHttpSettingsCollectionName}")
+		}
+	}
+
+	resourceId := NewBackendHttpSettingsCollectionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
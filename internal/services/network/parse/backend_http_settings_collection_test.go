@@ -126,3 +126,87 @@ func TestBackendHttpSettingsCollectionID(t *testing.T) {
 		}
 	}
 }
+
+func TestBackendHttpSettingsCollectionIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *BackendHttpSettingsCollectionId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "group1/applicationGateway1",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "12345678-1234-9876-4563-123456789012/group1/applicationGateway1/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "group1/applicationGateway1/backendHttpSettingsCollection1",
+			Expected: &BackendHttpSettingsCollectionId{
+				SubscriptionId:                    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                     "group1",
+				ApplicationGatewayName:            "applicationGateway1",
+				BackendHttpSettingsCollectionName: "backendHttpSettingsCollection1",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "12345678-1234-9876-4563-123456789012/group1/applicationGateway1/backendHttpSettingsCollection1",
+			Expected: &BackendHttpSettingsCollectionId{
+				SubscriptionId:                    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                     "group1",
+				ApplicationGatewayName:            "applicationGateway1",
+				BackendHttpSettingsCollectionName: "backendHttpSettingsCollection1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := BackendHttpSettingsCollectionIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.ApplicationGatewayName != v.Expected.ApplicationGatewayName {
+			t.Fatalf("Expected %q but got %q for ApplicationGatewayName", v.Expected.ApplicationGatewayName, actual.ApplicationGatewayName)
+		}
+		if actual.BackendHttpSettingsCollectionName != v.Expected.BackendHttpSettingsCollectionName {
+			t.Fatalf("Expected %q but got %q for BackendHttpSettingsCollectionName", v.Expected.BackendHttpSettingsCollectionName, actual.BackendHttpSettingsCollectionName)
+		}
+	}
+}
@@ -73,3 +73,23 @@ func BgpConnectionID(input string) (*BgpConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// BgpConnectionIDFromNames builds an BgpConnectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualHubName}/{name}", using the specified Subscription ID when this is omitted
+func BgpConnectionIDFromNames(subscriptionId, input string) (*BgpConnectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualHubName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualHubName}/{name}")
+		}
+	}
+
+	resourceId := NewBgpConnectionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func ExpressRouteCircuitAuthorizationID(input string) (*ExpressRouteCircuitAutho
 
 	return &resourceId, nil
 }
+
+// ExpressRouteCircuitAuthorizationIDFromNames builds an ExpressRouteCircuitAuthorizationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{expressRouteCircuitName}/{authorizationName}", using the specified Subscription ID when this is omitted
+func ExpressRouteCircuitAuthorizationIDFromNames(subscriptionId, input string) (*ExpressRouteCircuitAuthorizationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{expressRouteCircuitName}/{authorizationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{expressRouteCircuitName}/{authorizationName}")
+		}
+	}
+
+	resourceId := NewExpressRouteCircuitAuthorizationID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func ExpressRouteCircuitConnectionID(input string) (*ExpressRouteCircuitConnecti
 
 	return &resourceId, nil
 }
+
+// ExpressRouteCircuitConnectionIDFromNames builds an ExpressRouteCircuitConnectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{expressRouteCircuitName}/{peeringName}/{connectionName}", using the specified Subscription ID when this is omitted
+func ExpressRouteCircuitConnectionIDFromNames(subscriptionId, input string) (*ExpressRouteCircuitConnectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{expressRouteCircuitName}/{peeringName}/{connectionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{expressRouteCircuitName}/{peeringName}/{connectionName}")
+		}
+	}
+
+	resourceId := NewExpressRouteCircuitConnectionID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
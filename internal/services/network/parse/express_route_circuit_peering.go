@@ -73,3 +73,23 @@ func ExpressRouteCircuitPeeringID(input string) (*ExpressRouteCircuitPeeringId,
 
 	return &resourceId, nil
 }
+
+// ExpressRouteCircuitPeeringIDFromNames builds an ExpressRouteCircuitPeeringId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{expressRouteCircuitName}/{peeringName}", using the specified Subscription ID when this is omitted
+func ExpressRouteCircuitPeeringIDFromNames(subscriptionId, input string) (*ExpressRouteCircuitPeeringId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{expressRouteCircuitName}/{peeringName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{expressRouteCircuitName}/{peeringName}")
+		}
+	}
+
+	resourceId := NewExpressRouteCircuitPeeringID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func ExpressRouteConnectionID(input string) (*ExpressRouteConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// ExpressRouteConnectionIDFromNames builds an ExpressRouteConnectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{expressRouteGatewayName}/{name}", using the specified Subscription ID when this is omitted
+func ExpressRouteConnectionIDFromNames(subscriptionId, input string) (*ExpressRouteConnectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{expressRouteGatewayName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{expressRouteGatewayName}/{name}")
+		}
+	}
+
+	resourceId := NewExpressRouteConnectionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
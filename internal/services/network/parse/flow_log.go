@@ -73,3 +73,23 @@ func FlowLogID(input string) (*FlowLogId, error) {
 
 	return &resourceId, nil
 }
+
+// FlowLogIDFromNames builds an FlowLogId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{networkWatcherName}/{name}", using the specified Subscription ID when this is omitted
+func FlowLogIDFromNames(subscriptionId, input string) (*FlowLogId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{networkWatcherName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{networkWatcherName}/{name}")
+		}
+	}
+
+	resourceId := NewFlowLogID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func FrontendPortID(input string) (*FrontendPortId, error) {
 
 	return &resourceId, nil
 }
+
+// FrontendPortIDFromNames builds an FrontendPortId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{name}", using the specified Subscription ID when this is omitted
+func FrontendPortIDFromNames(subscriptionId, input string) (*FrontendPortId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{name}")
+		}
+	}
+
+	resourceId := NewFrontendPortID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func HttpListenerID(input string) (*HttpListenerId, error) {
 
 	return &resourceId, nil
 }
+
+// HttpListenerIDFromNames builds an HttpListenerId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{name}", using the specified Subscription ID when this is omitted
+func HttpListenerIDFromNames(subscriptionId, input string) (*HttpListenerId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{name}")
+		}
+	}
+
+	resourceId := NewHttpListenerID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
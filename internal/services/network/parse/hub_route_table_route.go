@@ -79,3 +79,23 @@ func HubRouteTableRouteID(input string) (*HubRouteTableRouteId, error) {
 
 	return &resourceId, nil
 }
+
+// HubRouteTableRouteIDFromNames builds an HubRouteTableRouteId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualHubName}/{hubRouteTableName}/{routeName}", using the specified Subscription ID when this is omitted
+func HubRouteTableRouteIDFromNames(subscriptionId, input string) (*HubRouteTableRouteId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualHubName}/{hubRouteTableName}/{routeName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualHubName}/{hubRouteTableName}/{routeName}")
+		}
+	}
+
+	resourceId := NewHubRouteTableRouteID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func InboundNatRuleID(input string) (*InboundNatRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// InboundNatRuleIDFromNames builds an InboundNatRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{name}", using the specified Subscription ID when this is omitted
+func InboundNatRuleIDFromNames(subscriptionId, input string) (*InboundNatRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{name}")
+		}
+	}
+
+	resourceId := NewInboundNatRuleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func LoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddress
 
 	return &resourceId, nil
 }
+
+// LoadBalancerBackendAddressPoolIDFromNames builds an LoadBalancerBackendAddressPoolId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{backendAddressPoolName}", using the specified Subscription ID when this is omitted
+func LoadBalancerBackendAddressPoolIDFromNames(subscriptionId, input string) (*LoadBalancerBackendAddressPoolId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{backendAddressPoolName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{loadBalancerName}/{backendAddressPoolName}")
+		}
+	}
+
+	resourceId := NewLoadBalancerBackendAddressPoolID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -67,3 +67,23 @@ func NetworkGatewayConnectionID(input string) (*NetworkGatewayConnectionId, erro
 
 	return &resourceId, nil
 }
+
+// NetworkGatewayConnectionIDFromNames builds an NetworkGatewayConnectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{connectionName}", using the specified Subscription ID when this is omitted
+func NetworkGatewayConnectionIDFromNames(subscriptionId, input string) (*NetworkGatewayConnectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{connectionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{connectionName}")
+		}
+	}
+
+	resourceId := NewNetworkGatewayConnectionID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
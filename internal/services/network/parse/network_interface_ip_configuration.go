@@ -73,3 +73,23 @@ func NetworkInterfaceIpConfigurationID(input string) (*NetworkInterfaceIpConfigu
 
 	return &resourceId, nil
 }
+
+// NetworkInterfaceIpConfigurationIDFromNames builds an NetworkInterfaceIpConfigurationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{networkInterfaceName}/{ipConfigurationName}", using the specified Subscription ID when this is omitted
+func NetworkInterfaceIpConfigurationIDFromNames(subscriptionId, input string) (*NetworkInterfaceIpConfigurationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{networkInterfaceName}/{ipConfigurationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{networkInterfaceName}/{ipConfigurationName}")
+		}
+	}
+
+	resourceId := NewNetworkInterfaceIpConfigurationID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
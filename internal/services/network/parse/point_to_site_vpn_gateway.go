@@ -67,3 +67,23 @@ func PointToSiteVpnGatewayID(input string) (*PointToSiteVpnGatewayId, error) {
 
 	return &resourceId, nil
 }
+
+// PointToSiteVpnGatewayIDFromNames builds an PointToSiteVpnGatewayId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{p2sVpnGatewayName}", using the specified Subscription ID when this is omitted
+func PointToSiteVpnGatewayIDFromNames(subscriptionId, input string) (*PointToSiteVpnGatewayId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{p2sVpnGatewayName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{p2sVpnGatewayName}")
+		}
+	}
+
+	resourceId := NewPointToSiteVpnGatewayID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
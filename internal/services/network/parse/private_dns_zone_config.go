@@ -79,3 +79,23 @@ func PrivateDnsZoneConfigID(input string) (*PrivateDnsZoneConfigId, error) {
 
 	return &resourceId, nil
 }
+
+// PrivateDnsZoneConfigIDFromNames builds an PrivateDnsZoneConfigId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{privateEndpointName}/{privateDnsZoneGroupName}/{name}", using the specified Subscription ID when this is omitted
+func PrivateDnsZoneConfigIDFromNames(subscriptionId, input string) (*PrivateDnsZoneConfigId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{privateEndpointName}/{privateDnsZoneGroupName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{privateEndpointName}/{privateDnsZoneGroupName}/{name}")
+		}
+	}
+
+	resourceId := NewPrivateDnsZoneConfigID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func PrivateDnsZoneGroupID(input string) (*PrivateDnsZoneGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// PrivateDnsZoneGroupIDFromNames builds an PrivateDnsZoneGroupId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{privateEndpointName}/{name}", using the specified Subscription ID when this is omitted
+func PrivateDnsZoneGroupIDFromNames(subscriptionId, input string) (*PrivateDnsZoneGroupId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{privateEndpointName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{privateEndpointName}/{name}")
+		}
+	}
+
+	resourceId := NewPrivateDnsZoneGroupID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -67,3 +67,23 @@ func PrivateEndpointID(input string) (*PrivateEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// PrivateEndpointIDFromNames builds an PrivateEndpointId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{name}", using the specified Subscription ID when this is omitted
+func PrivateEndpointIDFromNames(subscriptionId, input string) (*PrivateEndpointId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{name}")
+		}
+	}
+
+	resourceId := NewPrivateEndpointID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -67,3 +67,23 @@ func PublicIpPrefixID(input string) (*PublicIpPrefixId, error) {
 
 	return &resourceId, nil
 }
+
+// PublicIpPrefixIDFromNames builds an PublicIpPrefixId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{publicIPPrefixeName}", using the specified Subscription ID when this is omitted
+func PublicIpPrefixIDFromNames(subscriptionId, input string) (*PublicIpPrefixId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{publicIPPrefixeName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{publicIPPrefixeName}")
+		}
+	}
+
+	resourceId := NewPublicIpPrefixID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func RedirectConfigurationsID(input string) (*RedirectConfigurationsId, error) {
 
 	return &resourceId, nil
 }
+
+// RedirectConfigurationsIDFromNames builds an RedirectConfigurationsId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{redirectConfigurationName}", using the specified Subscription ID when this is omitted
+func RedirectConfigurationsIDFromNames(subscriptionId, input string) (*RedirectConfigurationsId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{redirectConfigurationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{applicationGatewayName}/{redirectConfigurationName}")
+		}
+	}
+
+	resourceId := NewRedirectConfigurationsID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func RouteID(input string) (*RouteId, error) {
 
 	return &resourceId, nil
 }
+
+// RouteIDFromNames builds an RouteId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{routeTableName}/{name}", using the specified Subscription ID when this is omitted
+func RouteIDFromNames(subscriptionId, input string) (*RouteId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{routeTableName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{routeTableName}/{name}")
+		}
+	}
+
+	resourceId := NewRouteID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
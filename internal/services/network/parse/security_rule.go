@@ -73,3 +73,23 @@ func SecurityRuleID(input string) (*SecurityRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// SecurityRuleIDFromNames builds an SecurityRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{networkSecurityGroupName}/{name}", using the specified Subscription ID when this is omitted
+func SecurityRuleIDFromNames(subscriptionId, input string) (*SecurityRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{networkSecurityGroupName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{networkSecurityGroupName}/{name}")
+		}
+	}
+
+	resourceId := NewSecurityRuleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func SubnetIDInsensitively(input string) (*SubnetId, error) {
 
 	return &resourceId, nil
 }
+
+// SubnetIDFromNames builds an SubnetId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualNetworkName}/{name}", using the specified Subscription ID when this is omitted
+func SubnetIDFromNames(subscriptionId, input string) (*SubnetId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualNetworkName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualNetworkName}/{name}")
+		}
+	}
+
+	resourceId := NewSubnetID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -67,3 +67,23 @@ func SubnetServiceEndpointStoragePolicyID(input string) (*SubnetServiceEndpointS
 
 	return &resourceId, nil
 }
+
+// SubnetServiceEndpointStoragePolicyIDFromNames builds an SubnetServiceEndpointStoragePolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serviceEndpointPolicyName}", using the specified Subscription ID when this is omitted
+func SubnetServiceEndpointStoragePolicyIDFromNames(subscriptionId, input string) (*SubnetServiceEndpointStoragePolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceEndpointPolicyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serviceEndpointPolicyName}")
+		}
+	}
+
+	resourceId := NewSubnetServiceEndpointStoragePolicyID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
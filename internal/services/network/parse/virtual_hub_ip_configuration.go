@@ -73,3 +73,23 @@ func VirtualHubIpConfigurationID(input string) (*VirtualHubIpConfigurationId, er
 
 	return &resourceId, nil
 }
+
+// VirtualHubIpConfigurationIDFromNames builds an VirtualHubIpConfigurationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualHubName}/{ipConfigurationName}", using the specified Subscription ID when this is omitted
+func VirtualHubIpConfigurationIDFromNames(subscriptionId, input string) (*VirtualHubIpConfigurationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualHubName}/{ipConfigurationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualHubName}/{ipConfigurationName}")
+		}
+	}
+
+	resourceId := NewVirtualHubIpConfigurationID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -91,3 +91,23 @@ func VirtualMachineScaleSetPublicIPAddressID(input string) (*VirtualMachineScale
 
 	return &resourceId, nil
 }
+
+// VirtualMachineScaleSetPublicIPAddressIDFromNames builds an VirtualMachineScaleSetPublicIPAddressId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualMachineScaleSetName}/{virtualMachineName}/{networkInterfaceName}/{ipConfigurationName}/{publicIPAddressName}", using the specified Subscription ID when this is omitted
+func VirtualMachineScaleSetPublicIPAddressIDFromNames(subscriptionId, input string) (*VirtualMachineScaleSetPublicIPAddressId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 6 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 7 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualMachineScaleSetName}/{virtualMachineName}/{networkInterfaceName}/{ipConfigurationName}/{publicIPAddressName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualMachineScaleSetName}/{virtualMachineName}/{networkInterfaceName}/{ipConfigurationName}/{publicIPAddressName}")
+		}
+	}
+
+	resourceId := NewVirtualMachineScaleSetPublicIPAddressID(names[0], names[1], names[2], names[3], names[4], names[5], names[6])
+	return &resourceId, nil
+}
@@ -111,3 +111,23 @@ func VirtualNetworkIDInsensitively(input string) (*VirtualNetworkId, error) {
 
 	return &resourceId, nil
 }
+
+// VirtualNetworkIDFromNames builds an VirtualNetworkId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{name}", using the specified Subscription ID when this is omitted
+func VirtualNetworkIDFromNames(subscriptionId, input string) (*VirtualNetworkId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{name}")
+		}
+	}
+
+	resourceId := NewVirtualNetworkID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func VirtualNetworkDnsServersIDInsensitively(input string) (*VirtualNetworkDnsSe
 
 	return &resourceId, nil
 }
+
+// VirtualNetworkDnsServersIDFromNames builds an VirtualNetworkDnsServersId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualNetworkName}/{dnsServerName}", using the specified Subscription ID when this is omitted
+func VirtualNetworkDnsServersIDFromNames(subscriptionId, input string) (*VirtualNetworkDnsServersId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualNetworkName}/{dnsServerName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualNetworkName}/{dnsServerName}")
+		}
+	}
+
+	resourceId := NewVirtualNetworkDnsServersID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func VirtualNetworkGatewayIpConfigurationID(input string) (*VirtualNetworkGatewa
 
 	return &resourceId, nil
 }
+
+// VirtualNetworkGatewayIpConfigurationIDFromNames builds an VirtualNetworkGatewayIpConfigurationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualNetworkGatewayName}/{ipConfigurationName}", using the specified Subscription ID when this is omitted
+func VirtualNetworkGatewayIpConfigurationIDFromNames(subscriptionId, input string) (*VirtualNetworkGatewayIpConfigurationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualNetworkGatewayName}/{ipConfigurationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualNetworkGatewayName}/{ipConfigurationName}")
+		}
+	}
+
+	resourceId := NewVirtualNetworkGatewayIpConfigurationID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
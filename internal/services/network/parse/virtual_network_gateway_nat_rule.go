@@ -73,3 +73,23 @@ func VirtualNetworkGatewayNatRuleID(input string) (*VirtualNetworkGatewayNatRule
 
 	return &resourceId, nil
 }
+
+// VirtualNetworkGatewayNatRuleIDFromNames builds an VirtualNetworkGatewayNatRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualNetworkGatewayName}/{natRuleName}", using the specified Subscription ID when this is omitted
+func VirtualNetworkGatewayNatRuleIDFromNames(subscriptionId, input string) (*VirtualNetworkGatewayNatRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualNetworkGatewayName}/{natRuleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualNetworkGatewayName}/{natRuleName}")
+		}
+	}
+
+	resourceId := NewVirtualNetworkGatewayNatRuleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
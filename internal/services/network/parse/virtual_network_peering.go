@@ -73,3 +73,23 @@ func VirtualNetworkPeeringID(input string) (*VirtualNetworkPeeringId, error) {
 
 	return &resourceId, nil
 }
+
+// VirtualNetworkPeeringIDFromNames builds an VirtualNetworkPeeringId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualNetworkName}/{name}", using the specified Subscription ID when this is omitted
+func VirtualNetworkPeeringIDFromNames(subscriptionId, input string) (*VirtualNetworkPeeringId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualNetworkName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualNetworkName}/{name}")
+		}
+	}
+
+	resourceId := NewVirtualNetworkPeeringID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
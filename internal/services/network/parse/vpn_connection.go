@@ -73,3 +73,23 @@ func VpnConnectionID(input string) (*VpnConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// VpnConnectionIDFromNames builds an VpnConnectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vpnGatewayName}/{name}", using the specified Subscription ID when this is omitted
+func VpnConnectionIDFromNames(subscriptionId, input string) (*VpnConnectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vpnGatewayName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vpnGatewayName}/{name}")
+		}
+	}
+
+	resourceId := NewVpnConnectionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func VpnGatewayNatRuleID(input string) (*VpnGatewayNatRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// VpnGatewayNatRuleIDFromNames builds an VpnGatewayNatRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vpnGatewayName}/{natRuleName}", using the specified Subscription ID when this is omitted
+func VpnGatewayNatRuleIDFromNames(subscriptionId, input string) (*VpnGatewayNatRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vpnGatewayName}/{natRuleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vpnGatewayName}/{natRuleName}")
+		}
+	}
+
+	resourceId := NewVpnGatewayNatRuleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
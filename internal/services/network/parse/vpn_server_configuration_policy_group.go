@@ -73,3 +73,23 @@ func VpnServerConfigurationPolicyGroupID(input string) (*VpnServerConfigurationP
 
 	return &resourceId, nil
 }
+
+// VpnServerConfigurationPolicyGroupIDFromNames builds an VpnServerConfigurationPolicyGroupId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vpnServerConfigurationName}/{configurationPolicyGroupName}", using the specified Subscription ID when this is omitted
+func VpnServerConfigurationPolicyGroupIDFromNames(subscriptionId, input string) (*VpnServerConfigurationPolicyGroupId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vpnServerConfigurationName}/{configurationPolicyGroupName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vpnServerConfigurationName}/{configurationPolicyGroupName}")
+		}
+	}
+
+	resourceId := NewVpnServerConfigurationPolicyGroupID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func VpnSiteLinkID(input string) (*VpnSiteLinkId, error) {
 
 	return &resourceId, nil
 }
+
+// VpnSiteLinkIDFromNames builds an VpnSiteLinkId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vpnSiteName}/{name}", using the specified Subscription ID when this is omitted
+func VpnSiteLinkIDFromNames(subscriptionId, input string) (*VpnSiteLinkId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vpnSiteName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vpnSiteName}/{name}")
+		}
+	}
+
+	resourceId := NewVpnSiteLinkID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -0,0 +1,74 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.TypedServiceRegistrationWithSweepers = Registration{}
+
+// Sweepers returns the Sweepers for the leaked resources within this Service
+func (r Registration) Sweepers() []sdk.Sweeper {
+	return []sdk.Sweeper{
+		{
+			Name: "azurerm_management_group_policy_assignment",
+			Func: sweepManagementGroupPolicyAssignments,
+		},
+	}
+}
+
+// sweepManagementGroupPolicyAssignments deletes the Policy Assignments created by the Acceptance Tests which
+// are assigned at the scope of a Management Group - since these aren't removed when a Resource Group is deleted.
+//
+// Policy Assignments aren't scoped to a Location, so these are removed regardless of the Location being swept.
+func sweepManagementGroupPolicyAssignments(ctx context.Context, client *clients.Client, _ string) error {
+	groupsClient := client.ManagementGroups.GroupsClient
+	assignmentsClient := client.Policy.AssignmentsClient
+
+	groupsIterator, err := groupsClient.ListComplete(ctx, "", "")
+	if err != nil {
+		return fmt.Errorf("listing Management Groups: %+v", err)
+	}
+
+	var errs *multierror.Error
+	for groupsIterator.NotDone() {
+		group := groupsIterator.Value()
+		if err := groupsIterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Management Groups: %+v", err)
+		}
+		if group.Name == nil {
+			continue
+		}
+
+		// only the Policy Assignments assigned at the scope of this Management Group (rather than those inherited)
+		assignmentsIterator, err := assignmentsClient.ListForManagementGroupComplete(ctx, *group.Name, "atScope()", nil)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing Policy Assignments for Management Group %q: %+v", *group.Name, err))
+			continue
+		}
+
+		for assignmentsIterator.NotDone() {
+			assignment := assignmentsIterator.Value()
+			if err := assignmentsIterator.NextWithContext(ctx); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("listing Policy Assignments for Management Group %q: %+v", *group.Name, err))
+				break
+			}
+
+			if assignment.ID == nil || assignment.Name == nil || !sdk.IsSweepable(*assignment.Name) {
+				continue
+			}
+
+			log.Printf("[DEBUG] Deleting Policy Assignment %q..", *assignment.ID)
+			if _, err := assignmentsClient.DeleteByID(ctx, *assignment.ID); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("deleting Policy Assignment %q: %+v", *assignment.ID, err))
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
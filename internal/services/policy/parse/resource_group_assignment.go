@@ -67,3 +67,23 @@ func ResourceGroupAssignmentID(input string) (*ResourceGroupAssignmentId, error)
 
 	return &resourceId, nil
 }
+
+// ResourceGroupAssignmentIDFromNames builds an ResourceGroupAssignmentId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{policyAssignmentName}", using the specified Subscription ID when this is omitted
+func ResourceGroupAssignmentIDFromNames(subscriptionId, input string) (*ResourceGroupAssignmentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{policyAssignmentName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{policyAssignmentName}")
+		}
+	}
+
+	resourceId := NewResourceGroupAssignmentID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
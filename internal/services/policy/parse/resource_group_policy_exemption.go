@@ -67,3 +67,23 @@ func ResourceGroupPolicyExemptionID(input string) (*ResourceGroupPolicyExemption
 
 	return &resourceId, nil
 }
+
+// ResourceGroupPolicyExemptionIDFromNames builds an ResourceGroupPolicyExemptionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{policyExemptionName}", using the specified Subscription ID when this is omitted
+func ResourceGroupPolicyExemptionIDFromNames(subscriptionId, input string) (*ResourceGroupPolicyExemptionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{policyExemptionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{policyExemptionName}")
+		}
+	}
+
+	resourceId := NewResourceGroupPolicyExemptionID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -67,3 +67,23 @@ func ResourceGroupPolicyRemediationID(input string) (*ResourceGroupPolicyRemedia
 
 	return &resourceId, nil
 }
+
+// ResourceGroupPolicyRemediationIDFromNames builds an ResourceGroupPolicyRemediationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{remediationName}", using the specified Subscription ID when this is omitted
+func ResourceGroupPolicyRemediationIDFromNames(subscriptionId, input string) (*ResourceGroupPolicyRemediationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{remediationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{remediationName}")
+		}
+	}
+
+	resourceId := NewResourceGroupPolicyRemediationID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -59,3 +59,23 @@ func SubscriptionAssignmentID(input string) (*SubscriptionAssignmentId, error) {
 
 	return &resourceId, nil
 }
+
+// SubscriptionAssignmentIDFromNames builds an SubscriptionAssignmentId from the names of each segment in the format
+// "[{subscriptionId}/]{policyAssignmentName}", using the specified Subscription ID when this is omitted
+func SubscriptionAssignmentIDFromNames(subscriptionId, input string) (*SubscriptionAssignmentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 1 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{policyAssignmentName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{policyAssignmentName}")
+		}
+	}
+
+	resourceId := NewSubscriptionAssignmentID(names[0], names[1])
+	return &resourceId, nil
+}
@@ -59,3 +59,23 @@ func SubscriptionPolicyExemptionID(input string) (*SubscriptionPolicyExemptionId
 
 	return &resourceId, nil
 }
+
+// SubscriptionPolicyExemptionIDFromNames builds an SubscriptionPolicyExemptionId from the names of each segment in the format
+// "[{subscriptionId}/]{policyExemptionName}", using the specified Subscription ID when this is omitted
+func SubscriptionPolicyExemptionIDFromNames(subscriptionId, input string) (*SubscriptionPolicyExemptionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 1 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{policyExemptionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{policyExemptionName}")
+		}
+	}
+
+	resourceId := NewSubscriptionPolicyExemptionID(names[0], names[1])
+	return &resourceId, nil
+}
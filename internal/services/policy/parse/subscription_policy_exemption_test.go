@@ -94,3 +94,77 @@ func TestSubscriptionPolicyExemptionID(t *testing.T) {
 		}
 	}
 }
+
+func TestSubscriptionPolicyExemptionIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *SubscriptionPolicyExemptionId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "12345678-1234-9876-4563-123456789012/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "exemption1",
+			Expected: &SubscriptionPolicyExemptionId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				PolicyExemptionName: "exemption1",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "12345678-1234-9876-4563-123456789012/exemption1",
+			Expected: &SubscriptionPolicyExemptionId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				PolicyExemptionName: "exemption1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := SubscriptionPolicyExemptionIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.PolicyExemptionName != v.Expected.PolicyExemptionName {
+			t.Fatalf("Expected %q but got %q for PolicyExemptionName", v.Expected.PolicyExemptionName, actual.PolicyExemptionName)
+		}
+	}
+}
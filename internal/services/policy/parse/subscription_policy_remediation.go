@@ -59,3 +59,23 @@ func SubscriptionPolicyRemediationID(input string) (*SubscriptionPolicyRemediati
 
 	return &resourceId, nil
 }
+
+// SubscriptionPolicyRemediationIDFromNames builds an SubscriptionPolicyRemediationId from the names of each segment in the format
+// "[{subscriptionId}/]{remediationName}", using the specified Subscription ID when this is omitted
+func SubscriptionPolicyRemediationIDFromNames(subscriptionId, input string) (*SubscriptionPolicyRemediationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 1 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{remediationName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{remediationName}")
+		}
+	}
+
+	resourceId := NewSubscriptionPolicyRemediationID(names[0], names[1])
+	return &resourceId, nil
+}
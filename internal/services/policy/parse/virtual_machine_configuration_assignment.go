@@ -129,3 +129,23 @@ func VirtualMachineConfigurationAssignmentIDInsensitively(input string) (*Virtua
 
 	return &resourceId, nil
 }
+
+// VirtualMachineConfigurationAssignmentIDFromNames builds an VirtualMachineConfigurationAssignmentId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualMachineName}/{guestConfigurationAssignmentName}", using the specified Subscription ID when this is omitted
+func VirtualMachineConfigurationAssignmentIDFromNames(subscriptionId, input string) (*VirtualMachineConfigurationAssignmentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualMachineName}/{guestConfigurationAssignmentName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualMachineName}/{guestConfigurationAssignmentName}")
+		}
+	}
+
+	resourceId := NewVirtualMachineConfigurationAssignmentID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
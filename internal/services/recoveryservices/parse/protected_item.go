@@ -85,3 +85,23 @@ func ProtectedItemID(input string) (*ProtectedItemId, error) {
 
 	return &resourceId, nil
 }
+
+// ProtectedItemIDFromNames builds an ProtectedItemId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vaultName}/{backupFabricName}/{protectionContainerName}/{name}", using the specified Subscription ID when this is omitted
+func ProtectedItemIDFromNames(subscriptionId, input string) (*ProtectedItemId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 5 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 6 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{backupFabricName}/{protectionContainerName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{backupFabricName}/{protectionContainerName}/{name}")
+		}
+	}
+
+	resourceId := NewProtectedItemID(names[0], names[1], names[2], names[3], names[4], names[5])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func ProtectionContainerID(input string) (*ProtectionContainerId, error) {
 
 	return &resourceId, nil
 }
+
+// ProtectionContainerIDFromNames builds an ProtectionContainerId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vaultName}/{backupFabricName}/{name}", using the specified Subscription ID when this is omitted
+func ProtectionContainerIDFromNames(subscriptionId, input string) (*ProtectionContainerId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{backupFabricName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{backupFabricName}/{name}")
+		}
+	}
+
+	resourceId := NewProtectionContainerID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
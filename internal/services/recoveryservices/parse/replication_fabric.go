@@ -73,3 +73,23 @@ func ReplicationFabricID(input string) (*ReplicationFabricId, error) {
 
 	return &resourceId, nil
 }
+
+// ReplicationFabricIDFromNames builds an ReplicationFabricId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vaultName}/{name}", using the specified Subscription ID when this is omitted
+func ReplicationFabricIDFromNames(subscriptionId, input string) (*ReplicationFabricId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{name}")
+		}
+	}
+
+	resourceId := NewReplicationFabricID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -85,3 +85,23 @@ func ReplicationProtectedItemID(input string) (*ReplicationProtectedItemId, erro
 
 	return &resourceId, nil
 }
+
+// ReplicationProtectedItemIDFromNames builds an ReplicationProtectedItemId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vaultName}/{replicationFabricName}/{replicationProtectionContainerName}/{name}", using the specified Subscription ID when this is omitted
+func ReplicationProtectedItemIDFromNames(subscriptionId, input string) (*ReplicationProtectedItemId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 5 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 6 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{replicationFabricName}/{replicationProtectionContainerName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{replicationFabricName}/{replicationProtectionContainerName}/{name}")
+		}
+	}
+
+	resourceId := NewReplicationProtectedItemID(names[0], names[1], names[2], names[3], names[4], names[5])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func ReplicationProtectionContainerID(input string) (*ReplicationProtectionConta
 
 	return &resourceId, nil
 }
+
+// ReplicationProtectionContainerIDFromNames builds an ReplicationProtectionContainerId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vaultName}/{replicationFabricName}/{name}", using the specified Subscription ID when this is omitted
+func ReplicationProtectionContainerIDFromNames(subscriptionId, input string) (*ReplicationProtectionContainerId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{replicationFabricName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{replicationFabricName}/{name}")
+		}
+	}
+
+	resourceId := NewReplicationProtectionContainerID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -85,3 +85,23 @@ func ReplicationProtectionContainerMappingsID(input string) (*ReplicationProtect
 
 	return &resourceId, nil
 }
+
+// ReplicationProtectionContainerMappingsIDFromNames builds an ReplicationProtectionContainerMappingsId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{vaultName}/{replicationFabricName}/{replicationProtectionContainerName}/{replicationProtectionContainerMappingName}", using the specified Subscription ID when this is omitted
+func ReplicationProtectionContainerMappingsIDFromNames(subscriptionId, input string) (*ReplicationProtectionContainerMappingsId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 5 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 6 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{replicationFabricName}/{replicationProtectionContainerName}/{replicationProtectionContainerMappingName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{vaultName}/{replicationFabricName}/{replicationProtectionContainerName}/{replicationProtectionContainerMappingName}")
+		}
+	}
+
+	resourceId := NewReplicationProtectionContainerMappingsID(names[0], names[1], names[2], names[3], names[4], names[5])
+	return &resourceId, nil
+}
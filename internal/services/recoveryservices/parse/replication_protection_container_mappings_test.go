@@ -158,3 +158,97 @@ func TestReplicationProtectionContainerMappingsID(t *testing.T) {
 		}
 	}
 }
+
+func TestReplicationProtectionContainerMappingsIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *ReplicationProtectionContainerMappingsId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "group1/vault1/fabric1/container1",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "12345678-1234-9876-4563-123456789012/group1/vault1/fabric1/container1/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "group1/vault1/fabric1/container1/mapping1",
+			Expected: &ReplicationProtectionContainerMappingsId{
+				SubscriptionId:                     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                      "group1",
+				VaultName:                          "vault1",
+				ReplicationFabricName:              "fabric1",
+				ReplicationProtectionContainerName: "container1",
+				ReplicationProtectionContainerMappingName: "mapping1",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "12345678-1234-9876-4563-123456789012/group1/vault1/fabric1/container1/mapping1",
+			Expected: &ReplicationProtectionContainerMappingsId{
+				SubscriptionId:                     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                      "group1",
+				VaultName:                          "vault1",
+				ReplicationFabricName:              "fabric1",
+				ReplicationProtectionContainerName: "container1",
+				ReplicationProtectionContainerMappingName: "mapping1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ReplicationProtectionContainerMappingsIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.VaultName != v.Expected.VaultName {
+			t.Fatalf("Expected %q but got %q for VaultName", v.Expected.VaultName, actual.VaultName)
+		}
+		if actual.ReplicationFabricName != v.Expected.ReplicationFabricName {
+			t.Fatalf("Expected %q but got %q for ReplicationFabricName", v.Expected.ReplicationFabricName, actual.ReplicationFabricName)
+		}
+		if actual.ReplicationProtectionContainerName != v.Expected.ReplicationProtectionContainerName {
+			t.Fatalf("Expected %q but got %q for ReplicationProtectionContainerName", v.Expected.ReplicationProtectionContainerName, actual.ReplicationProtectionContainerName)
+		}
+		if actual.ReplicationProtectionContainerMappingName != v.Expected.ReplicationProtectionContainerMappingName {
+			t.Fatalf("Expected %q but got %q for ReplicationProtectionContainerMappingName", v.Expected.ReplicationProtectionContainerMappingName, actual.ReplicationProtectionContainerMappingName)
+		}
+	}
+}
@@ -73,3 +73,23 @@ func FirewallRuleID(input string) (*FirewallRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// FirewallRuleIDFromNames builds an FirewallRuleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{rediName}/{name}", using the specified Subscription ID when this is omitted
+func FirewallRuleIDFromNames(subscriptionId, input string) (*FirewallRuleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{rediName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{rediName}/{name}")
+		}
+	}
+
+	resourceId := NewFirewallRuleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
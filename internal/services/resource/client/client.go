@@ -7,10 +7,12 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2020-10-01/deploymentscripts"
 )
 
 type Client struct {
 	DeploymentsClient           *resources.DeploymentsClient
+	DeploymentScriptsClient     *deploymentscripts.DeploymentScriptsClient
 	FeaturesClient              *features.Client
 	GroupsClient                *resources.GroupsClient
 	LocksClient                 *locks.ManagementLocksClient
@@ -27,6 +29,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	deploymentsClient := resources.NewDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&deploymentsClient.Client, o.ResourceManagerAuthorizer)
 
+	deploymentScriptsClient := deploymentscripts.NewDeploymentScriptsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&deploymentScriptsClient.Client, o.ResourceManagerAuthorizer)
+
 	featuresClient := features.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&featuresClient.Client, o.ResourceManagerAuthorizer)
 
@@ -56,6 +61,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	return &Client{
 		GroupsClient:                &groupsClient,
 		DeploymentsClient:           &deploymentsClient,
+		DeploymentScriptsClient:     &deploymentScriptsClient,
 		FeaturesClient:              &featuresClient,
 		LocksClient:                 &locksClient,
 		ProvidersClient:             &providersClient,
@@ -68,8 +74,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	}
 }
 
-func (c Client) TagsClientForSubscription(subscriptionID string) *resources.TagsClient {
-	tagsClient := resources.NewTagsClientWithBaseURI(c.options.ResourceManagerEndpoint, subscriptionID)
-	c.options.ConfigureClient(&tagsClient.Client, c.options.ResourceManagerAuthorizer)
+// TagsClientForSubscription returns a TagsClient for the specified Subscription, which uses the same
+// credentials and configuration as the Provider
+func (c Client) TagsClientForSubscription(subscriptionId string) *resources.TagsClient {
+	if subscriptionId == c.TagsClient.SubscriptionID {
+		return c.TagsClient
+	}
+
+	o := c.options.ForSubscription(subscriptionId)
+	tagsClient := resources.NewTagsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&tagsClient.Client, o.ResourceManagerAuthorizer)
 	return &tagsClient
 }
@@ -60,3 +60,23 @@ func ResourceGroupID(input string) (*ResourceGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// ResourceGroupIDFromNames builds an ResourceGroupId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}", using the specified Subscription ID when this is omitted
+func ResourceGroupIDFromNames(subscriptionId, input string) (*ResourceGroupId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 1 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}")
+		}
+	}
+
+	resourceId := NewResourceGroupID(names[0], names[1])
+	return &resourceId, nil
+}
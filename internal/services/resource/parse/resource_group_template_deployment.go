@@ -111,3 +111,23 @@ func ResourceGroupTemplateDeploymentIDInsensitively(input string) (*ResourceGrou
 
 	return &resourceId, nil
 }
+
+// ResourceGroupTemplateDeploymentIDFromNames builds an ResourceGroupTemplateDeploymentId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{deploymentName}", using the specified Subscription ID when this is omitted
+func ResourceGroupTemplateDeploymentIDFromNames(subscriptionId, input string) (*ResourceGroupTemplateDeploymentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{deploymentName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{deploymentName}")
+		}
+	}
+
+	resourceId := NewResourceGroupTemplateDeploymentID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -59,3 +59,23 @@ func SubscriptionTemplateDeploymentID(input string) (*SubscriptionTemplateDeploy
 
 	return &resourceId, nil
 }
+
+// SubscriptionTemplateDeploymentIDFromNames builds an SubscriptionTemplateDeploymentId from the names of each segment in the format
+// "[{subscriptionId}/]{deploymentName}", using the specified Subscription ID when this is omitted
+func SubscriptionTemplateDeploymentIDFromNames(subscriptionId, input string) (*SubscriptionTemplateDeploymentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 1 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{deploymentName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{deploymentName}")
+		}
+	}
+
+	resourceId := NewSubscriptionTemplateDeploymentID(names[0], names[1])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func TemplateSpecVersionID(input string) (*TemplateSpecVersionId, error) {
 
 	return &resourceId, nil
 }
+
+// TemplateSpecVersionIDFromNames builds an TemplateSpecVersionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{templateSpecName}/{versionName}", using the specified Subscription ID when this is omitted
+func TemplateSpecVersionIDFromNames(subscriptionId, input string) (*TemplateSpecVersionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{templateSpecName}/{versionName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{templateSpecName}/{versionName}")
+		}
+	}
+
+	resourceId := NewTemplateSpecVersionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -0,0 +1,101 @@
+package resource
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2020-10-01/deploymentscripts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ResourceDeploymentScriptAzureCliResource struct{}
+
+var _ sdk.ResourceWithUpdate = ResourceDeploymentScriptAzureCliResource{}
+
+func (r ResourceDeploymentScriptAzureCliResource) ResourceType() string {
+	return "azurerm_resource_deployment_script_azure_cli"
+}
+
+func (r ResourceDeploymentScriptAzureCliResource) ModelObject() interface{} {
+	return &DeploymentScriptModel{}
+}
+
+func (r ResourceDeploymentScriptAzureCliResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return deploymentscripts.ValidateDeploymentScriptID
+}
+
+func (r ResourceDeploymentScriptAzureCliResource) Arguments() map[string]*pluginsdk.Schema {
+	return deploymentScriptArguments()
+}
+
+func (r ResourceDeploymentScriptAzureCliResource) Attributes() map[string]*pluginsdk.Schema {
+	return deploymentScriptAttributes()
+}
+
+func (r ResourceDeploymentScriptAzureCliResource) Create() sdk.ResourceFunc {
+	return deploymentScriptCreateFunc(r.ResourceType(), deploymentScriptAzureCliKind)
+}
+
+func (r ResourceDeploymentScriptAzureCliResource) Update() sdk.ResourceFunc {
+	return deploymentScriptUpdateFunc()
+}
+
+func (r ResourceDeploymentScriptAzureCliResource) Read() sdk.ResourceFunc {
+	return deploymentScriptReadFunc(deploymentScriptAzureCliKind)
+}
+
+func (r ResourceDeploymentScriptAzureCliResource) Delete() sdk.ResourceFunc {
+	return deploymentScriptDeleteFunc()
+}
+
+var deploymentScriptAzureCliKind = deploymentScriptKind{
+	name: "Azure CLI",
+	expand: func(input deploymentScript) deploymentscripts.DeploymentScript {
+		props := input.Properties
+		return deploymentscripts.AzureCliScript{
+			Identity: input.Identity,
+			Location: input.Location,
+			Properties: deploymentscripts.AzureCliScriptProperties{
+				Arguments:              props.Arguments,
+				AzCliVersion:           props.Version,
+				CleanupPreference:      props.CleanupPreference,
+				ContainerSettings:      props.ContainerSettings,
+				EnvironmentVariables:   props.EnvironmentVariables,
+				ForceUpdateTag:         props.ForceUpdateTag,
+				PrimaryScriptUri:       props.PrimaryScriptUri,
+				RetentionInterval:      props.RetentionInterval,
+				ScriptContent:          props.ScriptContent,
+				StorageAccountSettings: props.StorageAccountSettings,
+				SupportingScriptUris:   props.SupportingScriptUris,
+				Timeout:                props.Timeout,
+			},
+			Tags: input.Tags,
+		}
+	},
+	flatten: func(input deploymentscripts.DeploymentScript) (*deploymentScript, bool) {
+		script, ok := input.(deploymentscripts.AzureCliScript)
+		if !ok {
+			return nil, false
+		}
+
+		props := script.Properties
+		return &deploymentScript{
+			Identity: script.Identity,
+			Location: script.Location,
+			Tags:     script.Tags,
+			Properties: deploymentScriptProperties{
+				Arguments:              props.Arguments,
+				CleanupPreference:      props.CleanupPreference,
+				ContainerSettings:      props.ContainerSettings,
+				EnvironmentVariables:   props.EnvironmentVariables,
+				ForceUpdateTag:         props.ForceUpdateTag,
+				Outputs:                props.Outputs,
+				PrimaryScriptUri:       props.PrimaryScriptUri,
+				RetentionInterval:      props.RetentionInterval,
+				ScriptContent:          props.ScriptContent,
+				StorageAccountSettings: props.StorageAccountSettings,
+				SupportingScriptUris:   props.SupportingScriptUris,
+				Timeout:                props.Timeout,
+				Version:                props.AzCliVersion,
+			},
+		}, true
+	},
+}
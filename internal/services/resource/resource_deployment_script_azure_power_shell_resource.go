@@ -0,0 +1,101 @@
+package resource
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2020-10-01/deploymentscripts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ResourceDeploymentScriptAzurePowerShellResource struct{}
+
+var _ sdk.ResourceWithUpdate = ResourceDeploymentScriptAzurePowerShellResource{}
+
+func (r ResourceDeploymentScriptAzurePowerShellResource) ResourceType() string {
+	return "azurerm_resource_deployment_script_azure_power_shell"
+}
+
+func (r ResourceDeploymentScriptAzurePowerShellResource) ModelObject() interface{} {
+	return &DeploymentScriptModel{}
+}
+
+func (r ResourceDeploymentScriptAzurePowerShellResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return deploymentscripts.ValidateDeploymentScriptID
+}
+
+func (r ResourceDeploymentScriptAzurePowerShellResource) Arguments() map[string]*pluginsdk.Schema {
+	return deploymentScriptArguments()
+}
+
+func (r ResourceDeploymentScriptAzurePowerShellResource) Attributes() map[string]*pluginsdk.Schema {
+	return deploymentScriptAttributes()
+}
+
+func (r ResourceDeploymentScriptAzurePowerShellResource) Create() sdk.ResourceFunc {
+	return deploymentScriptCreateFunc(r.ResourceType(), deploymentScriptAzurePowerShellKind)
+}
+
+func (r ResourceDeploymentScriptAzurePowerShellResource) Update() sdk.ResourceFunc {
+	return deploymentScriptUpdateFunc()
+}
+
+func (r ResourceDeploymentScriptAzurePowerShellResource) Read() sdk.ResourceFunc {
+	return deploymentScriptReadFunc(deploymentScriptAzurePowerShellKind)
+}
+
+func (r ResourceDeploymentScriptAzurePowerShellResource) Delete() sdk.ResourceFunc {
+	return deploymentScriptDeleteFunc()
+}
+
+var deploymentScriptAzurePowerShellKind = deploymentScriptKind{
+	name: "Azure PowerShell",
+	expand: func(input deploymentScript) deploymentscripts.DeploymentScript {
+		props := input.Properties
+		return deploymentscripts.AzurePowerShellScript{
+			Identity: input.Identity,
+			Location: input.Location,
+			Properties: deploymentscripts.AzurePowerShellScriptProperties{
+				Arguments:              props.Arguments,
+				AzPowerShellVersion:    props.Version,
+				CleanupPreference:      props.CleanupPreference,
+				ContainerSettings:      props.ContainerSettings,
+				EnvironmentVariables:   props.EnvironmentVariables,
+				ForceUpdateTag:         props.ForceUpdateTag,
+				PrimaryScriptUri:       props.PrimaryScriptUri,
+				RetentionInterval:      props.RetentionInterval,
+				ScriptContent:          props.ScriptContent,
+				StorageAccountSettings: props.StorageAccountSettings,
+				SupportingScriptUris:   props.SupportingScriptUris,
+				Timeout:                props.Timeout,
+			},
+			Tags: input.Tags,
+		}
+	},
+	flatten: func(input deploymentscripts.DeploymentScript) (*deploymentScript, bool) {
+		script, ok := input.(deploymentscripts.AzurePowerShellScript)
+		if !ok {
+			return nil, false
+		}
+
+		props := script.Properties
+		return &deploymentScript{
+			Identity: script.Identity,
+			Location: script.Location,
+			Tags:     script.Tags,
+			Properties: deploymentScriptProperties{
+				Arguments:              props.Arguments,
+				CleanupPreference:      props.CleanupPreference,
+				ContainerSettings:      props.ContainerSettings,
+				EnvironmentVariables:   props.EnvironmentVariables,
+				ForceUpdateTag:         props.ForceUpdateTag,
+				Outputs:                props.Outputs,
+				PrimaryScriptUri:       props.PrimaryScriptUri,
+				RetentionInterval:      props.RetentionInterval,
+				ScriptContent:          props.ScriptContent,
+				StorageAccountSettings: props.StorageAccountSettings,
+				SupportingScriptUris:   props.SupportingScriptUris,
+				Timeout:                props.Timeout,
+				Version:                props.AzPowerShellVersion,
+			},
+		}, true
+	},
+}
@@ -0,0 +1,604 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdk/2020-10-01/deploymentscripts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DeploymentScriptModel struct {
+	Name                 string                                `tfschema:"name"`
+	ResourceGroupName    string                                `tfschema:"resource_group_name"`
+	Location             string                                `tfschema:"location"`
+	Version              string                                `tfschema:"version"`
+	RetentionInterval    string                                `tfschema:"retention_interval"`
+	CommandLine          string                                `tfschema:"command_line"`
+	CleanupPreference    string                                `tfschema:"cleanup_preference"`
+	Container            []DeploymentScriptContainerModel      `tfschema:"container"`
+	EnvironmentVariables []DeploymentScriptEnvironmentVariable `tfschema:"environment_variable"`
+	ForceUpdateTag       string                                `tfschema:"force_update_tag"`
+	Identity             []identity.ModelUserAssigned          `tfschema:"identity"`
+	PrimaryScriptUri     string                                `tfschema:"primary_script_uri"`
+	ScriptContent        string                                `tfschema:"script_content"`
+	StorageAccount       []DeploymentScriptStorageAccountModel `tfschema:"storage_account"`
+	SupportingScriptUris []string                              `tfschema:"supporting_script_uris"`
+	Timeout              string                                `tfschema:"timeout"`
+	Tags                 map[string]string                     `tfschema:"tags"`
+	Outputs              string                                `tfschema:"outputs"`
+}
+
+// deploymentScript is a Deployment Script of any kind, since the Azure CLI and Azure PowerShell kinds only
+// differ in the name of the field containing the version
+type deploymentScript struct {
+	Identity   *identity.UserAssignedMap
+	Location   string
+	Tags       *map[string]string
+	Properties deploymentScriptProperties
+}
+
+type deploymentScriptProperties struct {
+	Arguments              *string
+	CleanupPreference      *deploymentscripts.CleanupOptions
+	ContainerSettings      *deploymentscripts.ContainerConfiguration
+	EnvironmentVariables   *[]deploymentscripts.EnvironmentVariable
+	ForceUpdateTag         *string
+	Outputs                *map[string]interface{}
+	PrimaryScriptUri       *string
+	RetentionInterval      string
+	ScriptContent          *string
+	StorageAccountSettings *deploymentscripts.StorageAccountConfiguration
+	SupportingScriptUris   *[]string
+	Timeout                *string
+	Version                string
+}
+
+// deploymentScriptKind converts between a deploymentScript and the API model for a kind of Deployment Script
+type deploymentScriptKind struct {
+	// name is the name of this kind of Deployment Script, e.g. `Azure CLI`
+	name string
+
+	expand func(input deploymentScript) deploymentscripts.DeploymentScript
+
+	// flatten returns false when the Deployment Script isn't of this kind
+	flatten func(input deploymentscripts.DeploymentScript) (*deploymentScript, bool)
+}
+
+type DeploymentScriptContainerModel struct {
+	ContainerGroupName string `tfschema:"container_group_name"`
+}
+
+type DeploymentScriptEnvironmentVariable struct {
+	Name        string `tfschema:"name"`
+	SecureValue string `tfschema:"secure_value"`
+	Value       string `tfschema:"value"`
+}
+
+type DeploymentScriptStorageAccountModel struct {
+	Key  string `tfschema:"key"`
+	Name string `tfschema:"name"`
+}
+
+func deploymentScriptArguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[-\w._()]{1,90}$`),
+				"`name` may only contain alphanumeric characters, underscores, periods, parentheses and hyphens, and must be between 1 and 90 characters long",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"retention_interval": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ISO8601DurationBetween("PT1H", "PT26H"),
+		},
+
+		"command_line": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cleanup_preference": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(deploymentscripts.CleanupOptionsAlways),
+			ValidateFunc: validation.StringInSlice(deploymentscripts.PossibleValuesForCleanupOptions(), false),
+		},
+
+		"container": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"container_group_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"environment_variable": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"secure_value": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"force_update_tag": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"identity": commonschema.UserAssignedIdentityOptionalForceNew(),
+
+		"primary_script_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			ExactlyOneOf: []string{"primary_script_uri", "script_content"},
+		},
+
+		"script_content": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"primary_script_uri", "script_content"},
+		},
+
+		"storage_account": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"supporting_script_uris": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+		},
+
+		"timeout": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "P1D",
+			ValidateFunc: validate.ISO8601DurationBetween("PT1M", "P1D"),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func deploymentScriptAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"outputs": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func deploymentScriptCreateFunc(resourceType string, kind deploymentScriptKind) sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model DeploymentScriptModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Resource.DeploymentScriptsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := deploymentscripts.NewDeploymentScriptID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(resourceType, id)
+			}
+
+			identityValue, err := expandDeploymentScriptIdentity(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			cleanupPreference := deploymentscripts.CleanupOptions(model.CleanupPreference)
+			script := deploymentScript{
+				Identity: identityValue,
+				Location: location.Normalize(model.Location),
+				Properties: deploymentScriptProperties{
+					CleanupPreference:      &cleanupPreference,
+					ContainerSettings:      expandDeploymentScriptContainerConfiguration(model.Container),
+					EnvironmentVariables:   expandDeploymentScriptEnvironmentVariables(model.EnvironmentVariables),
+					RetentionInterval:      model.RetentionInterval,
+					StorageAccountSettings: expandDeploymentScriptStorageAccountConfiguration(model.StorageAccount),
+					Timeout:                utils.String(model.Timeout),
+					Version:                model.Version,
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.CommandLine != "" {
+				script.Properties.Arguments = utils.String(model.CommandLine)
+			}
+
+			if model.ForceUpdateTag != "" {
+				script.Properties.ForceUpdateTag = utils.String(model.ForceUpdateTag)
+			}
+
+			if model.PrimaryScriptUri != "" {
+				script.Properties.PrimaryScriptUri = utils.String(model.PrimaryScriptUri)
+			}
+
+			if model.ScriptContent != "" {
+				script.Properties.ScriptContent = utils.String(model.ScriptContent)
+			}
+
+			if len(model.SupportingScriptUris) > 0 {
+				script.Properties.SupportingScriptUris = &model.SupportingScriptUris
+			}
+
+			if err := client.CreateThenPoll(ctx, id, kind.expand(script)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func deploymentScriptUpdateFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentScriptsClient
+
+			id, err := deploymentscripts.ParseDeploymentScriptID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DeploymentScriptModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters := deploymentscripts.DeploymentScriptUpdateParameter{
+					Tags: &model.Tags,
+				}
+
+				if _, err := client.Update(ctx, *id, parameters); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func deploymentScriptReadFunc(kind deploymentScriptKind) sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentScriptsClient
+
+			id, err := deploymentscripts.ParseDeploymentScriptID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			model, ok := kind.flatten(*resp.Model)
+			if !ok {
+				return fmt.Errorf("retrieving %s: expected an %s Deployment Script", *id, kind.name)
+			}
+
+			// the secure values aren't returned from the API so we retain these from the existing state
+			var existing DeploymentScriptModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := DeploymentScriptModel{
+				Name:              id.DeploymentScriptName,
+				ResourceGroupName: id.ResourceGroupName,
+				Location:          location.Normalize(model.Location),
+			}
+
+			identityValue, err := flattenDeploymentScriptIdentity(model.Identity)
+			if err != nil {
+				return fmt.Errorf("flattening `identity`: %+v", err)
+			}
+			state.Identity = identityValue
+
+			props := model.Properties
+			state.Version = props.Version
+			state.RetentionInterval = props.RetentionInterval
+			state.CommandLine = utils.NormalizeNilableString(props.Arguments)
+			state.Container = flattenDeploymentScriptContainerConfiguration(props.ContainerSettings)
+			state.EnvironmentVariables = flattenDeploymentScriptEnvironmentVariables(props.EnvironmentVariables, existing.EnvironmentVariables)
+			state.ForceUpdateTag = utils.NormalizeNilableString(props.ForceUpdateTag)
+			state.PrimaryScriptUri = utils.NormalizeNilableString(props.PrimaryScriptUri)
+			state.ScriptContent = utils.NormalizeNilableString(props.ScriptContent)
+			state.StorageAccount = flattenDeploymentScriptStorageAccountConfiguration(props.StorageAccountSettings, existing.StorageAccount)
+			state.Timeout = utils.NormalizeNilableString(props.Timeout)
+
+			if props.CleanupPreference != nil {
+				state.CleanupPreference = string(*props.CleanupPreference)
+			}
+
+			if props.SupportingScriptUris != nil {
+				state.SupportingScriptUris = *props.SupportingScriptUris
+			}
+
+			outputs, err := flattenDeploymentScriptOutputs(props.Outputs)
+			if err != nil {
+				return err
+			}
+			state.Outputs = outputs
+
+			if model.Tags != nil {
+				state.Tags = *model.Tags
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func deploymentScriptDeleteFunc() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentScriptsClient
+
+			id, err := deploymentscripts.ParseDeploymentScriptID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDeploymentScriptIdentity(input []identity.ModelUserAssigned) (*identity.UserAssignedMap, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	return identity.ExpandUserAssignedMapFromModel(input)
+}
+
+func expandDeploymentScriptContainerConfiguration(input []DeploymentScriptContainerModel) *deploymentscripts.ContainerConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := &deploymentscripts.ContainerConfiguration{}
+	if v := input[0].ContainerGroupName; v != "" {
+		output.ContainerGroupName = utils.String(v)
+	}
+
+	return output
+}
+
+func expandDeploymentScriptEnvironmentVariables(input []DeploymentScriptEnvironmentVariable) *[]deploymentscripts.EnvironmentVariable {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make([]deploymentscripts.EnvironmentVariable, 0)
+	for _, v := range input {
+		variable := deploymentscripts.EnvironmentVariable{
+			Name: v.Name,
+		}
+		if v.SecureValue != "" {
+			variable.SecureValue = utils.String(v.SecureValue)
+		}
+		if v.Value != "" {
+			variable.Value = utils.String(v.Value)
+		}
+		output = append(output, variable)
+	}
+
+	return &output
+}
+
+func expandDeploymentScriptStorageAccountConfiguration(input []DeploymentScriptStorageAccountModel) *deploymentscripts.StorageAccountConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &deploymentscripts.StorageAccountConfiguration{
+		StorageAccountKey:  utils.String(input[0].Key),
+		StorageAccountName: utils.String(input[0].Name),
+	}
+}
+
+func flattenDeploymentScriptContainerConfiguration(input *deploymentscripts.ContainerConfiguration) []DeploymentScriptContainerModel {
+	if input == nil {
+		return []DeploymentScriptContainerModel{}
+	}
+
+	return []DeploymentScriptContainerModel{
+		{
+			ContainerGroupName: utils.NormalizeNilableString(input.ContainerGroupName),
+		},
+	}
+}
+
+// flattenDeploymentScriptEnvironmentVariables flattens the Environment Variables, the secure values
+// aren't returned from the API so these are retained from the existing state
+func flattenDeploymentScriptEnvironmentVariables(input *[]deploymentscripts.EnvironmentVariable, existing []DeploymentScriptEnvironmentVariable) []DeploymentScriptEnvironmentVariable {
+	output := make([]DeploymentScriptEnvironmentVariable, 0)
+	if input == nil {
+		return output
+	}
+
+	secureValues := make(map[string]string)
+	for _, v := range existing {
+		secureValues[v.Name] = v.SecureValue
+	}
+
+	for _, v := range *input {
+		output = append(output, DeploymentScriptEnvironmentVariable{
+			Name:        v.Name,
+			SecureValue: secureValues[v.Name],
+			Value:       utils.NormalizeNilableString(v.Value),
+		})
+	}
+
+	return output
+}
+
+// flattenDeploymentScriptStorageAccountConfiguration flattens the Storage Account, the key isn't
+// returned from the API so this is retained from the existing state
+func flattenDeploymentScriptStorageAccountConfiguration(input *deploymentscripts.StorageAccountConfiguration, existing []DeploymentScriptStorageAccountModel) []DeploymentScriptStorageAccountModel {
+	if input == nil {
+		return []DeploymentScriptStorageAccountModel{}
+	}
+
+	key := ""
+	if len(existing) > 0 {
+		key = existing[0].Key
+	}
+
+	return []DeploymentScriptStorageAccountModel{
+		{
+			Key:  key,
+			Name: utils.NormalizeNilableString(input.StorageAccountName),
+		},
+	}
+}
+
+func flattenDeploymentScriptIdentity(input *identity.UserAssignedMap) ([]identity.ModelUserAssigned, error) {
+	output, err := identity.FlattenUserAssignedMapToModel(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return *output, nil
+}
+
+func flattenDeploymentScriptOutputs(input *map[string]interface{}) (string, error) {
+	if input == nil {
+		return "", nil
+	}
+
+	outputs, err := json.Marshal(*input)
+	if err != nil {
+		return "", fmt.Errorf("marshaling `outputs`: %+v", err)
+	}
+
+	return string(outputs), nil
+}
@@ -0,0 +1,75 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.UntypedServiceRegistrationWithSweepers = Registration{}
+
+// Sweepers returns the Sweepers for the leaked resources within this Service
+func (r Registration) Sweepers() []sdk.Sweeper {
+	return []sdk.Sweeper{
+		{
+			Name: "azurerm_resource_group",
+			Func: sweepResourceGroups,
+		},
+	}
+}
+
+// sweepResourceGroups deletes the Resource Groups created by the Acceptance Tests (e.g. `acctestRG-*`)
+// within the specified Location - and in turn the resources within them
+func sweepResourceGroups(ctx context.Context, client *clients.Client, locationName string) error {
+	groupsClient := client.Resource.GroupsClient
+
+	iterator, err := groupsClient.ListComplete(ctx, "", nil)
+	if err != nil {
+		return fmt.Errorf("listing Resource Groups: %+v", err)
+	}
+
+	var errs *multierror.Error
+
+	// the deletions are started in parallel (since each can take a while) and then waited on
+	futures := make(map[string]resources.GroupsDeleteFuture)
+	for iterator.NotDone() {
+		group := iterator.Value()
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Resource Groups: %+v", err)
+		}
+
+		if group.Name == nil || !sdk.IsSweepable(*group.Name) {
+			continue
+		}
+		if group.Location == nil || location.Normalize(*group.Location) != locationName {
+			continue
+		}
+		if props := group.Properties; props != nil && props.ProvisioningState != nil && strings.EqualFold(*props.ProvisioningState, "Deleting") {
+			log.Printf("[DEBUG] Resource Group %q is already being deleted - skipping", *group.Name)
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Resource Group %q..", *group.Name)
+		future, err := groupsClient.Delete(ctx, *group.Name, "")
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("deleting Resource Group %q: %+v", *group.Name, err))
+			continue
+		}
+		futures[*group.Name] = future
+	}
+
+	for name, future := range futures {
+		if err := future.WaitForCompletionRef(ctx, groupsClient.Client); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("waiting for deletion of Resource Group %q: %+v", name, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,15 @@
+package deploymentscripts
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DeploymentScriptsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDeploymentScriptsClientWithBaseURI(endpoint string) DeploymentScriptsClient {
+	return DeploymentScriptsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,47 @@
+package deploymentscripts
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+var _ DeploymentScript = AzureCliScript{}
+
+type AzureCliScript struct {
+	Properties AzureCliScriptProperties `json:"properties"`
+
+	// Fields inherited from DeploymentScript
+	Id         *string                   `json:"id,omitempty"`
+	Identity   *identity.UserAssignedMap `json:"identity,omitempty"`
+	Location   string                    `json:"location"`
+	Name       *string                   `json:"name,omitempty"`
+	SystemData *SystemData               `json:"systemData,omitempty"`
+	Tags       *map[string]string        `json:"tags,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+var _ json.Marshaler = AzureCliScript{}
+
+func (s AzureCliScript) MarshalJSON() ([]byte, error) {
+	type wrapper AzureCliScript
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling AzureCliScript: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling AzureCliScript: %+v", err)
+	}
+	decoded["kind"] = "AzureCLI"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling AzureCliScript: %+v", err)
+	}
+
+	return encoded, nil
+}
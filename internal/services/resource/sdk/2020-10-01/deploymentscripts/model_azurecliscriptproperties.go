@@ -0,0 +1,19 @@
+package deploymentscripts
+
+type AzureCliScriptProperties struct {
+	Arguments              *string                      `json:"arguments,omitempty"`
+	AzCliVersion           string                       `json:"azCliVersion"`
+	CleanupPreference      *CleanupOptions              `json:"cleanupPreference,omitempty"`
+	ContainerSettings      *ContainerConfiguration      `json:"containerSettings,omitempty"`
+	EnvironmentVariables   *[]EnvironmentVariable       `json:"environmentVariables,omitempty"`
+	ForceUpdateTag         *string                      `json:"forceUpdateTag,omitempty"`
+	Outputs                *map[string]interface{}      `json:"outputs,omitempty"`
+	PrimaryScriptUri       *string                      `json:"primaryScriptUri,omitempty"`
+	ProvisioningState      *ScriptProvisioningState     `json:"provisioningState,omitempty"`
+	RetentionInterval      string                       `json:"retentionInterval"`
+	ScriptContent          *string                      `json:"scriptContent,omitempty"`
+	Status                 *ScriptStatus                `json:"status,omitempty"`
+	StorageAccountSettings *StorageAccountConfiguration `json:"storageAccountSettings,omitempty"`
+	SupportingScriptUris   *[]string                    `json:"supportingScriptUris,omitempty"`
+	Timeout                *string                      `json:"timeout,omitempty"`
+}
@@ -0,0 +1,5 @@
+package deploymentscripts
+
+type ContainerConfiguration struct {
+	ContainerGroupName *string `json:"containerGroupName,omitempty"`
+}
@@ -0,0 +1,53 @@
+package deploymentscripts
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type DeploymentScript interface {
+}
+
+func unmarshalDeploymentScriptImplementation(input []byte) (DeploymentScript, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling DeploymentScript into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["kind"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "AzureCLI") {
+		var out AzureCliScript
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into AzureCliScript: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "AzurePowerShell") {
+		var out AzurePowerShellScript
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into AzurePowerShellScript: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawDeploymentScriptImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawDeploymentScriptImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+
+}
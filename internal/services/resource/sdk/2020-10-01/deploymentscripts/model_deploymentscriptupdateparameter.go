@@ -0,0 +1,5 @@
+package deploymentscripts
+
+type DeploymentScriptUpdateParameter struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
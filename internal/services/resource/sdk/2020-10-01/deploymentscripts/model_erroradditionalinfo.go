@@ -0,0 +1,6 @@
+package deploymentscripts
+
+type ErrorAdditionalInfo struct {
+	Info *interface{} `json:"info,omitempty"`
+	Type *string      `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package deploymentscripts
+
+type ErrorResponse struct {
+	AdditionalInfo *[]ErrorAdditionalInfo `json:"additionalInfo,omitempty"`
+	Code           *string                `json:"code,omitempty"`
+	Details        *[]ErrorResponse       `json:"details,omitempty"`
+	Message        *string                `json:"message,omitempty"`
+	Target         *string                `json:"target,omitempty"`
+}
@@ -0,0 +1,52 @@
+package deploymentscripts
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/dates"
+)
+
+type ScriptStatus struct {
+	ContainerInstanceId *string        `json:"containerInstanceId,omitempty"`
+	EndTime             *string        `json:"endTime,omitempty"`
+	Error               *ErrorResponse `json:"error,omitempty"`
+	ExpirationTime      *string        `json:"expirationTime,omitempty"`
+	StartTime           *string        `json:"startTime,omitempty"`
+	StorageAccountId    *string        `json:"storageAccountId,omitempty"`
+}
+
+func (o *ScriptStatus) GetEndTimeAsTime() (*time.Time, error) {
+	if o.EndTime == nil {
+		return nil, nil
+	}
+	return dates.ParseAsFormat(o.EndTime, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o *ScriptStatus) SetEndTimeAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.EndTime = &formatted
+}
+
+func (o *ScriptStatus) GetExpirationTimeAsTime() (*time.Time, error) {
+	if o.ExpirationTime == nil {
+		return nil, nil
+	}
+	return dates.ParseAsFormat(o.ExpirationTime, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o *ScriptStatus) SetExpirationTimeAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.ExpirationTime = &formatted
+}
+
+func (o *ScriptStatus) GetStartTimeAsTime() (*time.Time, error) {
+	if o.StartTime == nil {
+		return nil, nil
+	}
+	return dates.ParseAsFormat(o.StartTime, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o *ScriptStatus) SetStartTimeAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.StartTime = &formatted
+}
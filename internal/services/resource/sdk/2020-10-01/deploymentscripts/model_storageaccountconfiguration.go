@@ -0,0 +1,6 @@
+package deploymentscripts
+
+type StorageAccountConfiguration struct {
+	StorageAccountKey  *string `json:"storageAccountKey,omitempty"`
+	StorageAccountName *string `json:"storageAccountName,omitempty"`
+}
@@ -59,3 +59,23 @@ func AssessmentMetadataID(input string) (*AssessmentMetadataId, error) {
 
 	return &resourceId, nil
 }
+
+// AssessmentMetadataIDFromNames builds an AssessmentMetadataId from the names of each segment in the format
+// "[{subscriptionId}/]{assessmentMetadataName}", using the specified Subscription ID when this is omitted
+func AssessmentMetadataIDFromNames(subscriptionId, input string) (*AssessmentMetadataId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 1 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{assessmentMetadataName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{assessmentMetadataName}")
+		}
+	}
+
+	resourceId := NewAssessmentMetadataID(names[0], names[1])
+	return &resourceId, nil
+}
@@ -98,3 +98,23 @@ func AutoProvisioningSettingIDInsensitively(input string) (*AutoProvisioningSett
 
 	return &resourceId, nil
 }
+
+// AutoProvisioningSettingIDFromNames builds an AutoProvisioningSettingId from the names of each segment in the format
+// "[{subscriptionId}/]{name}", using the specified Subscription ID when this is omitted
+func AutoProvisioningSettingIDFromNames(subscriptionId, input string) (*AutoProvisioningSettingId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 1 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{name}")
+		}
+	}
+
+	resourceId := NewAutoProvisioningSettingID(names[0], names[1])
+	return &resourceId, nil
+}
@@ -59,3 +59,23 @@ func ContactID(input string) (*ContactId, error) {
 
 	return &resourceId, nil
 }
+
+// ContactIDFromNames builds an ContactId from the names of each segment in the format
+// "[{subscriptionId}/]{securityContactName}", using the specified Subscription ID when this is omitted
+func ContactIDFromNames(subscriptionId, input string) (*ContactId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 1 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{securityContactName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{securityContactName}")
+		}
+	}
+
+	resourceId := NewContactID(names[0], names[1])
+	return &resourceId, nil
+}
@@ -59,3 +59,23 @@ func PricingID(input string) (*PricingId, error) {
 
 	return &resourceId, nil
 }
+
+// PricingIDFromNames builds an PricingId from the names of each segment in the format
+// "[{subscriptionId}/]{name}", using the specified Subscription ID when this is omitted
+func PricingIDFromNames(subscriptionId, input string) (*PricingId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 1 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{name}")
+		}
+	}
+
+	resourceId := NewPricingID(names[0], names[1])
+	return &resourceId, nil
+}
@@ -59,3 +59,23 @@ func SettingID(input string) (*SettingId, error) {
 
 	return &resourceId, nil
 }
+
+// SettingIDFromNames builds an SettingId from the names of each segment in the format
+// "[{subscriptionId}/]{name}", using the specified Subscription ID when this is omitted
+func SettingIDFromNames(subscriptionId, input string) (*SettingId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 1 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{name}")
+		}
+	}
+
+	resourceId := NewSettingID(names[0], names[1])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func VulnerabilityAssessmentVmID(input string) (*VulnerabilityAssessmentVmId, er
 
 	return &resourceId, nil
 }
+
+// VulnerabilityAssessmentVmIDFromNames builds an VulnerabilityAssessmentVmId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{virtualMachineName}/{serverVulnerabilityAssessmentName}", using the specified Subscription ID when this is omitted
+func VulnerabilityAssessmentVmIDFromNames(subscriptionId, input string) (*VulnerabilityAssessmentVmId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualMachineName}/{serverVulnerabilityAssessmentName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{virtualMachineName}/{serverVulnerabilityAssessmentName}")
+		}
+	}
+
+	resourceId := NewVulnerabilityAssessmentVmID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -126,3 +126,87 @@ func TestVulnerabilityAssessmentVmID(t *testing.T) {
 		}
 	}
 }
+
+func TestVulnerabilityAssessmentVmIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *VulnerabilityAssessmentVmId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resGroup1/vm-name1",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "12345678-1234-9876-4563-123456789012/resGroup1/vm-name1/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resGroup1/vm-name1/default1",
+			Expected: &VulnerabilityAssessmentVmId{
+				SubscriptionId:                    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                     "resGroup1",
+				VirtualMachineName:                "vm-name1",
+				ServerVulnerabilityAssessmentName: "default1",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "12345678-1234-9876-4563-123456789012/resGroup1/vm-name1/default1",
+			Expected: &VulnerabilityAssessmentVmId{
+				SubscriptionId:                    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                     "resGroup1",
+				VirtualMachineName:                "vm-name1",
+				ServerVulnerabilityAssessmentName: "default1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := VulnerabilityAssessmentVmIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.VirtualMachineName != v.Expected.VirtualMachineName {
+			t.Fatalf("Expected %q but got %q for VirtualMachineName", v.Expected.VirtualMachineName, actual.VirtualMachineName)
+		}
+		if actual.ServerVulnerabilityAssessmentName != v.Expected.ServerVulnerabilityAssessmentName {
+			t.Fatalf("Expected %q but got %q for ServerVulnerabilityAssessmentName", v.Expected.ServerVulnerabilityAssessmentName, actual.ServerVulnerabilityAssessmentName)
+		}
+	}
+}
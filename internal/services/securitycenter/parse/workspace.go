@@ -59,3 +59,23 @@ func WorkspaceID(input string) (*WorkspaceId, error) {
 
 	return &resourceId, nil
 }
+
+// WorkspaceIDFromNames builds an WorkspaceId from the names of each segment in the format
+// "[{subscriptionId}/]{workspaceSettingName}", using the specified Subscription ID when this is omitted
+func WorkspaceIDFromNames(subscriptionId, input string) (*WorkspaceId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 1 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 2 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{workspaceSettingName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{workspaceSettingName}")
+		}
+	}
+
+	resourceId := NewWorkspaceID(names[0], names[1])
+	return &resourceId, nil
+}
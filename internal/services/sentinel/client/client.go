@@ -10,6 +10,8 @@ type Client struct {
 	AlertRuleTemplatesClient *securityinsight.AlertRuleTemplatesClient
 	AutomationRulesClient    *securityinsight.AutomationRulesClient
 	DataConnectorsClient     *securityinsight.DataConnectorsClient
+	MetadataClient           *securityinsight.MetadataClient
+	ThreatIntelligenceClient *securityinsight.ThreatIntelligenceIndicatorClient
 	WatchlistsClient         *securityinsight.WatchlistsClient
 	WatchlistItemsClient     *securityinsight.WatchlistItemsClient
 }
@@ -27,6 +29,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	dataConnectorsClient := securityinsight.NewDataConnectorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataConnectorsClient.Client, o.ResourceManagerAuthorizer)
 
+	metadataClient := securityinsight.NewMetadataClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&metadataClient.Client, o.ResourceManagerAuthorizer)
+
+	threatIntelligenceClient := securityinsight.NewThreatIntelligenceIndicatorClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&threatIntelligenceClient.Client, o.ResourceManagerAuthorizer)
+
 	watchListsClient := securityinsight.NewWatchlistsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&watchListsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -38,6 +46,8 @@ func NewClient(o *common.ClientOptions) *Client {
 		AlertRuleTemplatesClient: &alertRuleTemplatesClient,
 		AutomationRulesClient:    &automationRulesClient,
 		DataConnectorsClient:     &dataConnectorsClient,
+		MetadataClient:           &metadataClient,
+		ThreatIntelligenceClient: &threatIntelligenceClient,
 		WatchlistsClient:         &watchListsClient,
 		WatchlistItemsClient:     &watchListItemsClient,
 	}
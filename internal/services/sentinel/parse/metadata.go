@@ -0,0 +1,95 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type MetadataId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	WorkspaceName  string
+	MetadataName   string
+}
+
+func NewMetadataID(subscriptionId, resourceGroup, workspaceName, metadataName string) MetadataId {
+	return MetadataId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		WorkspaceName:  workspaceName,
+		MetadataName:   metadataName,
+	}
+}
+
+func (id MetadataId) String() string {
+	segments := []string{
+		fmt.Sprintf("Metadata Name %q", id.MetadataName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Metadata", segmentsStr)
+}
+
+func (id MetadataId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/providers/Microsoft.SecurityInsights/metadata/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.MetadataName)
+}
+
+// MetadataID parses a Metadata ID into an MetadataId struct
+func MetadataID(input string) (*MetadataId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := MetadataId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.MetadataName, err = id.PopSegment("metadata"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// MetadataIDFromNames builds an MetadataId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{metadataName}", using the specified Subscription ID when this is omitted
+func MetadataIDFromNames(subscriptionId, input string) (*MetadataId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{metadataName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{metadataName}")
+		}
+	}
+
+	resourceId := NewMetadataID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
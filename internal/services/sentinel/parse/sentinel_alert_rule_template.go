@@ -73,3 +73,23 @@ func SentinelAlertRuleTemplateID(input string) (*SentinelAlertRuleTemplateId, er
 
 	return &resourceId, nil
 }
+
+// SentinelAlertRuleTemplateIDFromNames builds an SentinelAlertRuleTemplateId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{alertRuleTemplateName}", using the specified Subscription ID when this is omitted
+func SentinelAlertRuleTemplateIDFromNames(subscriptionId, input string) (*SentinelAlertRuleTemplateId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{alertRuleTemplateName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{alertRuleTemplateName}")
+		}
+	}
+
+	resourceId := NewSentinelAlertRuleTemplateID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
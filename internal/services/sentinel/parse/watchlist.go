@@ -73,3 +73,23 @@ func WatchlistID(input string) (*WatchlistId, error) {
 
 	return &resourceId, nil
 }
+
+// WatchlistIDFromNames builds an WatchlistId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{name}", using the specified Subscription ID when this is omitted
+func WatchlistIDFromNames(subscriptionId, input string) (*WatchlistId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{name}")
+		}
+	}
+
+	resourceId := NewWatchlistID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func WatchlistItemID(input string) (*WatchlistItemId, error) {
 
 	return &resourceId, nil
 }
+
+// WatchlistItemIDFromNames builds an WatchlistItemId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{watchlistName}/{name}", using the specified Subscription ID when this is omitted
+func WatchlistItemIDFromNames(subscriptionId, input string) (*WatchlistItemId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{watchlistName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{watchlistName}/{name}")
+		}
+	}
+
+	resourceId := NewWatchlistItemID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
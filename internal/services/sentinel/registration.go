@@ -62,5 +62,7 @@ func (r Registration) Resources() []sdk.Resource {
 		WatchlistResource{},
 		WatchlistItemResource{},
 		DataConnectorAwsS3Resource{},
+		ThreatIntelligenceIndicatorResource{},
+		MetadataResource{},
 	}
 }
@@ -0,0 +1,565 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MetadataResource struct{}
+
+var _ sdk.ResourceWithUpdate = MetadataResource{}
+
+type MetadataModel struct {
+	Name                    string                    `tfschema:"name"`
+	LogAnalyticsWorkspaceId string                    `tfschema:"log_analytics_workspace_id"`
+	ContentId               string                    `tfschema:"content_id"`
+	Kind                    string                    `tfschema:"kind"`
+	ParentId                string                    `tfschema:"parent_id"`
+	Version                 string                    `tfschema:"version"`
+	Source                  []MetadataSourceModel     `tfschema:"source"`
+	Author                  []MetadataAuthorModel     `tfschema:"author"`
+	Support                 []MetadataSupportModel    `tfschema:"support"`
+	Category                []MetadataCategoriesModel `tfschema:"category"`
+	Providers               []string                  `tfschema:"providers"`
+	FirstPublishDate        string                    `tfschema:"first_publish_date"`
+	LastPublishDate         string                    `tfschema:"last_publish_date"`
+}
+
+type MetadataSourceModel struct {
+	Kind string `tfschema:"kind"`
+	Name string `tfschema:"name"`
+	Id   string `tfschema:"id"`
+}
+
+type MetadataAuthorModel struct {
+	Name  string `tfschema:"name"`
+	Email string `tfschema:"email"`
+	Link  string `tfschema:"link"`
+}
+
+type MetadataSupportModel struct {
+	Tier  string `tfschema:"tier"`
+	Name  string `tfschema:"name"`
+	Email string `tfschema:"email"`
+	Link  string `tfschema:"link"`
+}
+
+type MetadataCategoriesModel struct {
+	Domains   []string `tfschema:"domains"`
+	Verticals []string `tfschema:"verticals"`
+}
+
+func (r MetadataResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"content_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kind": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(securityinsight.KindAnalyticsRule),
+				string(securityinsight.KindAnalyticsRuleTemplate),
+				string(securityinsight.KindDataConnector),
+				string(securityinsight.KindDataType),
+				string(securityinsight.KindHuntingQuery),
+				string(securityinsight.KindInvestigationQuery),
+				string(securityinsight.KindParser),
+				string(securityinsight.KindPlaybook),
+				string(securityinsight.KindPlaybookTemplate),
+				string(securityinsight.KindSolution),
+				string(securityinsight.KindWatchlist),
+				string(securityinsight.KindWatchlistTemplate),
+				string(securityinsight.KindWorkbook),
+				string(securityinsight.KindWorkbookTemplate),
+			}, false),
+		},
+
+		"parent_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"kind": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(securityinsight.SourceKindCommunity),
+							string(securityinsight.SourceKindLocalWorkspace),
+							string(securityinsight.SourceKindSolution),
+							string(securityinsight.SourceKindSourceRepository),
+						}, false),
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"author": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"email": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"link": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+				},
+			},
+		},
+
+		"support": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"tier": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(securityinsight.SupportTierCommunity),
+							string(securityinsight.SupportTierMicrosoft),
+							string(securityinsight.SupportTierPartner),
+						}, false),
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"email": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"link": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+				},
+			},
+		},
+
+		"category": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"domains": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"verticals": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"providers": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"first_publish_date": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be in the format `YYYY-MM-DD`"),
+		},
+
+		"last_publish_date": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be in the format `YYYY-MM-DD`"),
+		},
+	}
+}
+
+func (r MetadataResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MetadataResource) ResourceType() string {
+	return "azurerm_sentinel_metadata"
+}
+
+func (r MetadataResource) ModelObject() interface{} {
+	return &MetadataModel{}
+}
+
+func (r MetadataResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.MetadataID
+}
+
+func (r MetadataResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.MetadataClient
+
+			var model MetadataModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			id := parse.NewMetadataID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.MetadataName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props, err := expandSentinelMetadataProperties(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Create(ctx, id.ResourceGroup, id.WorkspaceName, id.MetadataName, securityinsight.MetadataModel{MetadataProperties: props}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MetadataResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.MetadataClient
+
+			id, err := parse.MetadataID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.MetadataName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := MetadataModel{
+				Name:                    id.MetadataName,
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+			}
+
+			if props := resp.MetadataProperties; props != nil {
+				model.ContentId = utils.NormalizeNilableString(props.ContentID)
+				model.Kind = string(props.Kind)
+				model.ParentId = utils.NormalizeNilableString(props.ParentID)
+				model.Version = utils.NormalizeNilableString(props.Version)
+
+				if props.Providers != nil {
+					model.Providers = *props.Providers
+				}
+				if props.FirstPublishDate != nil {
+					model.FirstPublishDate = props.FirstPublishDate.String()
+				}
+				if props.LastPublishDate != nil {
+					model.LastPublishDate = props.LastPublishDate.String()
+				}
+
+				if source := props.Source; source != nil {
+					model.Source = []MetadataSourceModel{
+						{
+							Kind: string(source.Kind),
+							Name: utils.NormalizeNilableString(source.Name),
+							Id:   utils.NormalizeNilableString(source.SourceID),
+						},
+					}
+				}
+
+				if author := props.Author; author != nil {
+					model.Author = []MetadataAuthorModel{
+						{
+							Name:  utils.NormalizeNilableString(author.Name),
+							Email: utils.NormalizeNilableString(author.Email),
+							Link:  utils.NormalizeNilableString(author.Link),
+						},
+					}
+				}
+
+				if support := props.Support; support != nil {
+					model.Support = []MetadataSupportModel{
+						{
+							Tier:  string(support.Tier),
+							Name:  utils.NormalizeNilableString(support.Name),
+							Email: utils.NormalizeNilableString(support.Email),
+							Link:  utils.NormalizeNilableString(support.Link),
+						},
+					}
+				}
+
+				if categories := props.Categories; categories != nil {
+					category := MetadataCategoriesModel{}
+					if categories.Domains != nil {
+						category.Domains = *categories.Domains
+					}
+					if categories.Verticals != nil {
+						category.Verticals = *categories.Verticals
+					}
+					model.Category = []MetadataCategoriesModel{category}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MetadataResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.MetadataClient
+
+			id, err := parse.MetadataID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MetadataModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.MetadataName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			props, err := expandSentinelMetadataProperties(model)
+			if err != nil {
+				return err
+			}
+
+			// the Dependencies aren't exposed in the schema, so retain any which have been set outside of Terraform
+			if existing.MetadataProperties != nil {
+				props.Dependencies = existing.MetadataProperties.Dependencies
+			}
+
+			param := securityinsight.MetadataModel{
+				Etag:               existing.Etag,
+				MetadataProperties: props,
+			}
+
+			if _, err := client.Create(ctx, id.ResourceGroup, id.WorkspaceName, id.MetadataName, param); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MetadataResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.MetadataClient
+
+			id, err := parse.MetadataID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.MetadataName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSentinelMetadataProperties(model MetadataModel) (*securityinsight.MetadataProperties, error) {
+	props := &securityinsight.MetadataProperties{
+		ContentID: utils.String(model.ContentId),
+		Kind:      securityinsight.Kind(model.Kind),
+		ParentID:  utils.String(model.ParentId),
+	}
+
+	if model.Version != "" {
+		props.Version = utils.String(model.Version)
+	}
+
+	if len(model.Providers) != 0 {
+		props.Providers = &model.Providers
+	}
+
+	if model.FirstPublishDate != "" {
+		firstPublishDate, err := date.ParseDate(model.FirstPublishDate)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `first_publish_date`: %+v", err)
+		}
+		props.FirstPublishDate = &firstPublishDate
+	}
+
+	if model.LastPublishDate != "" {
+		lastPublishDate, err := date.ParseDate(model.LastPublishDate)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `last_publish_date`: %+v", err)
+		}
+		props.LastPublishDate = &lastPublishDate
+	}
+
+	if len(model.Source) != 0 {
+		source := model.Source[0]
+		props.Source = &securityinsight.MetadataSource{
+			Kind: securityinsight.SourceKind(source.Kind),
+		}
+		if source.Name != "" {
+			props.Source.Name = utils.String(source.Name)
+		}
+		if source.Id != "" {
+			props.Source.SourceID = utils.String(source.Id)
+		}
+	}
+
+	if len(model.Author) != 0 {
+		author := model.Author[0]
+		props.Author = &securityinsight.MetadataAuthor{}
+		if author.Name != "" {
+			props.Author.Name = utils.String(author.Name)
+		}
+		if author.Email != "" {
+			props.Author.Email = utils.String(author.Email)
+		}
+		if author.Link != "" {
+			props.Author.Link = utils.String(author.Link)
+		}
+	}
+
+	if len(model.Support) != 0 {
+		support := model.Support[0]
+		props.Support = &securityinsight.MetadataSupport{
+			Tier: securityinsight.SupportTier(support.Tier),
+		}
+		if support.Name != "" {
+			props.Support.Name = utils.String(support.Name)
+		}
+		if support.Email != "" {
+			props.Support.Email = utils.String(support.Email)
+		}
+		if support.Link != "" {
+			props.Support.Link = utils.String(support.Link)
+		}
+	}
+
+	if len(model.Category) != 0 {
+		category := model.Category[0]
+		props.Categories = &securityinsight.MetadataCategories{}
+		if len(category.Domains) != 0 {
+			props.Categories.Domains = &category.Domains
+		}
+		if len(category.Verticals) != 0 {
+			props.Categories.Verticals = &category.Verticals
+		}
+	}
+
+	return props, nil
+}
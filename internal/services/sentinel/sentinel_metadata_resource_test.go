@@ -0,0 +1,228 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MetadataResource struct{}
+
+func TestAccMetadata_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_metadata", "test")
+	r := MetadataResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMetadata_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_metadata", "test")
+	r := MetadataResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMetadata_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_metadata", "test")
+	r := MetadataResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMetadata_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_metadata", "test")
+	r := MetadataResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MetadataResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Sentinel.MetadataClient
+
+	id, err := parse.MetadataID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.MetadataName); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r MetadataResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_metadata" "test" {
+  name                       = "acctest-metadata-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  content_id                 = azurerm_sentinel_alert_rule_nrt.test.name
+  kind                       = "AnalyticsRule"
+  parent_id                  = azurerm_sentinel_alert_rule_nrt.test.id
+}
+`, template, data.RandomInteger)
+}
+
+func (r MetadataResource) complete(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_metadata" "test" {
+  name                       = "acctest-metadata-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  content_id                 = azurerm_sentinel_alert_rule_nrt.test.name
+  kind                       = "AnalyticsRule"
+  parent_id                  = azurerm_sentinel_alert_rule_nrt.test.id
+  version                    = "1.0.0"
+  providers                  = ["testprovider1"]
+  first_publish_date         = "2022-11-01"
+  last_publish_date          = "2022-12-01"
+
+  source {
+    kind = "Solution"
+    name = "test Solution"
+    id   = "b688a130-76f4-4a07-bf57-762222a3cadf"
+  }
+
+  author {
+    name  = "test user"
+    email = "acc@test.com"
+    link  = "https://www.example.com"
+  }
+
+  support {
+    tier  = "Partner"
+    name  = "support user"
+    email = "support@test.com"
+    link  = "https://www.example.com/support"
+  }
+
+  category {
+    domains   = ["Application"]
+    verticals = ["Healthcare"]
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func (r MetadataResource) requiresImport(data acceptance.TestData) string {
+	template := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_metadata" "import" {
+  name                       = azurerm_sentinel_metadata.test.name
+  log_analytics_workspace_id = azurerm_sentinel_metadata.test.log_analytics_workspace_id
+  content_id                 = azurerm_sentinel_metadata.test.content_id
+  kind                       = azurerm_sentinel_metadata.test.kind
+  parent_id                  = azurerm_sentinel_metadata.test.parent_id
+}
+`, template)
+}
+
+func (r MetadataResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%d"
+  location = %q
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-workspace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_solution" "sentinel" {
+  solution_name         = "SecurityInsights"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  workspace_resource_id = azurerm_log_analytics_workspace.test.id
+  workspace_name        = azurerm_log_analytics_workspace.test.name
+
+  plan {
+    publisher = "Microsoft"
+    product   = "OMSGallery/SecurityInsights"
+  }
+}
+
+resource "azurerm_sentinel_alert_rule_nrt" "test" {
+  name                       = "acctest-SentinelAlertRule-NRT-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  display_name               = "Some Rule"
+  severity                   = "High"
+  query                      = <<QUERY
+AzureActivity |
+  where OperationName == "Create or Update Virtual Machine" or OperationName =="Create Deployment" |
+  where ActivityStatus == "Succeeded" |
+  make-series dcount(ResourceId) default=0 on EventSubmissionTimestamp in range(ago(7d), now(), 1d) by Caller
+QUERY
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
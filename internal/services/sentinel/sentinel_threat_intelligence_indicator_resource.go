@@ -0,0 +1,676 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the only container of indicators within a workspace is named `main`
+const threatIntelligenceName = "main"
+
+type ThreatIntelligenceIndicatorResource struct{}
+
+var _ sdk.ResourceWithUpdate = ThreatIntelligenceIndicatorResource{}
+
+type ThreatIntelligenceIndicatorModel struct {
+	LogAnalyticsWorkspaceId string                                     `tfschema:"log_analytics_workspace_id"`
+	DisplayName             string                                     `tfschema:"display_name"`
+	Pattern                 string                                     `tfschema:"pattern"`
+	PatternType             string                                     `tfschema:"pattern_type"`
+	PatternVersion          string                                     `tfschema:"pattern_version"`
+	Source                  string                                     `tfschema:"source"`
+	ValidateFromUtc         string                                     `tfschema:"validate_from_utc"`
+	ValidateUntilUtc        string                                     `tfschema:"validate_until_utc"`
+	Confidence              int                                        `tfschema:"confidence"`
+	CreatedBy               string                                     `tfschema:"created_by"`
+	Description             string                                     `tfschema:"description"`
+	Language                string                                     `tfschema:"language"`
+	Revoked                 bool                                       `tfschema:"revoked"`
+	ThreatIntelligenceTags  []string                                   `tfschema:"threat_intelligence_tags"`
+	ThreatTypes             []string                                   `tfschema:"threat_types"`
+	ObjectMarkingRefs       []string                                   `tfschema:"object_marking_refs"`
+	KillChainPhases         []ThreatIntelligenceKillChainPhaseModel    `tfschema:"kill_chain_phase"`
+	ExternalReferences      []ThreatIntelligenceExternalReferenceModel `tfschema:"external_reference"`
+	GranularMarkings        []ThreatIntelligenceGranularMarkingModel   `tfschema:"granular_marking"`
+	Guid                    string                                     `tfschema:"guid"`
+	CreatedOn               string                                     `tfschema:"created_on"`
+	LastUpdatedTimeUtc      string                                     `tfschema:"last_updated_time_utc"`
+	Defanged                bool                                       `tfschema:"defanged"`
+	ExternalId              string                                     `tfschema:"external_id"`
+	IndicatorTypes          []string                                   `tfschema:"indicator_types"`
+}
+
+type ThreatIntelligenceKillChainPhaseModel struct {
+	KillChainName string `tfschema:"kill_chain_name"`
+	PhaseName     string `tfschema:"phase_name"`
+}
+
+type ThreatIntelligenceExternalReferenceModel struct {
+	Description string            `tfschema:"description"`
+	ExternalId  string            `tfschema:"external_id"`
+	SourceName  string            `tfschema:"source_name"`
+	Url         string            `tfschema:"url"`
+	Hashes      map[string]string `tfschema:"hashes"`
+}
+
+type ThreatIntelligenceGranularMarkingModel struct {
+	Language   string   `tfschema:"language"`
+	MarkingRef int      `tfschema:"marking_ref"`
+	Selectors  []string `tfschema:"selectors"`
+}
+
+func (r ThreatIntelligenceIndicatorResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"pattern": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"pattern_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"domain-name",
+				"file",
+				"ipv4-addr",
+				"ipv6-addr",
+				"url",
+			}, false),
+		},
+
+		"source": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"validate_from_utc": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"validate_until_utc": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"pattern_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"confidence": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+
+		"created_by": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"language": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"revoked": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"threat_intelligence_tags": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"threat_types": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"object_marking_refs": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"kill_chain_phase": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"kill_chain_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"phase_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"external_reference": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"source_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"external_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"hashes": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"granular_marking": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"language": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"marking_ref": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+					},
+
+					"selectors": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"guid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"created_on": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_updated_time_utc": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"defanged": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"external_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"indicator_types": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) ResourceType() string {
+	return "azurerm_sentinel_threat_intelligence_indicator"
+}
+
+func (r ThreatIntelligenceIndicatorResource) ModelObject() interface{} {
+	return &ThreatIntelligenceIndicatorModel{}
+}
+
+func (r ThreatIntelligenceIndicatorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ThreatIntelligenceIndicatorID
+}
+
+func (r ThreatIntelligenceIndicatorResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceClient
+
+			var model ThreatIntelligenceIndicatorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			// the name of the Indicator isn't returned from the `createIndicator` endpoint, so we generate one
+			// and create the Indicator using it instead, which is what the Portal does too
+			name, err := uuid.GenerateUUID()
+			if err != nil {
+				return fmt.Errorf("generating UUID for Threat Intelligence Indicator: %+v", err)
+			}
+
+			id := parse.NewThreatIntelligenceIndicatorID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, threatIntelligenceName, name)
+
+			param := securityinsight.ThreatIntelligenceIndicatorModelForRequestBody{
+				Kind:                                  utils.String(string(securityinsight.KindBasicThreatIntelligenceInformationKindIndicator)),
+				ThreatIntelligenceIndicatorProperties: expandThreatIntelligenceIndicatorProperties(model),
+			}
+
+			if _, err := client.Create(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceClient
+
+			id, err := parse.ThreatIntelligenceIndicatorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if resp.Value == nil {
+				return fmt.Errorf("retrieving %s: `value` was nil", id)
+			}
+
+			indicator, ok := resp.Value.AsThreatIntelligenceIndicatorModel()
+			if !ok {
+				return fmt.Errorf("%s was not a Threat Intelligence Indicator", id)
+			}
+
+			model := ThreatIntelligenceIndicatorModel{
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+				Guid:                    id.IndicatorName,
+			}
+
+			if props := indicator.ThreatIntelligenceIndicatorProperties; props != nil {
+				model.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+				model.Pattern = utils.NormalizeNilableString(props.Pattern)
+				model.PatternType = utils.NormalizeNilableString(props.PatternType)
+				model.PatternVersion = utils.NormalizeNilableString(props.PatternVersion)
+				model.Source = utils.NormalizeNilableString(props.Source)
+				model.CreatedBy = utils.NormalizeNilableString(props.CreatedByRef)
+				model.Description = utils.NormalizeNilableString(props.Description)
+				model.Language = utils.NormalizeNilableString(props.Language)
+				model.CreatedOn = utils.NormalizeNilableString(props.Created)
+				model.LastUpdatedTimeUtc = utils.NormalizeNilableString(props.LastUpdatedTimeUtc)
+				model.ExternalId = utils.NormalizeNilableString(props.ExternalID)
+				model.ValidateFromUtc = normalizeThreatIntelligenceTime(props.ValidFrom)
+				model.ValidateUntilUtc = normalizeThreatIntelligenceTime(props.ValidUntil)
+
+				if props.Confidence != nil {
+					model.Confidence = int(*props.Confidence)
+				}
+				if props.Revoked != nil {
+					model.Revoked = *props.Revoked
+				}
+				if props.Defanged != nil {
+					model.Defanged = *props.Defanged
+				}
+				if props.ThreatIntelligenceTags != nil {
+					model.ThreatIntelligenceTags = *props.ThreatIntelligenceTags
+				}
+				if props.ThreatTypes != nil {
+					model.ThreatTypes = *props.ThreatTypes
+				}
+				if props.ObjectMarkingRefs != nil {
+					model.ObjectMarkingRefs = *props.ObjectMarkingRefs
+				}
+				if props.IndicatorTypes != nil {
+					model.IndicatorTypes = *props.IndicatorTypes
+				}
+
+				model.KillChainPhases = flattenThreatIntelligenceKillChainPhases(props.KillChainPhases)
+				model.ExternalReferences = flattenThreatIntelligenceExternalReferences(props.ExternalReferences)
+				model.GranularMarkings = flattenThreatIntelligenceGranularMarkings(props.GranularMarkings)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceClient
+
+			id, err := parse.ThreatIntelligenceIndicatorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ThreatIntelligenceIndicatorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if resp.Value == nil {
+				return fmt.Errorf("retrieving %s: `value` was nil", id)
+			}
+
+			existing, ok := resp.Value.AsThreatIntelligenceIndicatorModel()
+			if !ok {
+				return fmt.Errorf("%s was not a Threat Intelligence Indicator", id)
+			}
+
+			param := securityinsight.ThreatIntelligenceIndicatorModelForRequestBody{
+				Etag:                                  existing.Etag,
+				Kind:                                  utils.String(string(securityinsight.KindBasicThreatIntelligenceInformationKindIndicator)),
+				ThreatIntelligenceIndicatorProperties: expandThreatIntelligenceIndicatorProperties(model),
+			}
+
+			if _, err := client.Create(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName, param); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceClient
+
+			id, err := parse.ThreatIntelligenceIndicatorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandThreatIntelligenceIndicatorProperties(model ThreatIntelligenceIndicatorModel) *securityinsight.ThreatIntelligenceIndicatorProperties {
+	props := &securityinsight.ThreatIntelligenceIndicatorProperties{
+		DisplayName: utils.String(model.DisplayName),
+		Pattern:     utils.String(model.Pattern),
+		PatternType: utils.String(model.PatternType),
+		Source:      utils.String(model.Source),
+		ValidFrom:   utils.String(model.ValidateFromUtc),
+		Revoked:     utils.Bool(model.Revoked),
+	}
+
+	if model.ValidateUntilUtc != "" {
+		props.ValidUntil = utils.String(model.ValidateUntilUtc)
+	}
+	if model.PatternVersion != "" {
+		props.PatternVersion = utils.String(model.PatternVersion)
+	}
+	if model.Confidence != 0 {
+		props.Confidence = utils.Int32(int32(model.Confidence))
+	}
+	if model.CreatedBy != "" {
+		props.CreatedByRef = utils.String(model.CreatedBy)
+	}
+	if model.Description != "" {
+		props.Description = utils.String(model.Description)
+	}
+	if model.Language != "" {
+		props.Language = utils.String(model.Language)
+	}
+	if len(model.ThreatIntelligenceTags) != 0 {
+		props.ThreatIntelligenceTags = &model.ThreatIntelligenceTags
+	}
+	if len(model.ThreatTypes) != 0 {
+		props.ThreatTypes = &model.ThreatTypes
+	}
+	if len(model.ObjectMarkingRefs) != 0 {
+		props.ObjectMarkingRefs = &model.ObjectMarkingRefs
+	}
+
+	killChainPhases := make([]securityinsight.ThreatIntelligenceKillChainPhase, 0)
+	for _, v := range model.KillChainPhases {
+		killChainPhases = append(killChainPhases, securityinsight.ThreatIntelligenceKillChainPhase{
+			KillChainName: utils.String(v.KillChainName),
+			PhaseName:     utils.String(v.PhaseName),
+		})
+	}
+	props.KillChainPhases = &killChainPhases
+
+	externalReferences := make([]securityinsight.ThreatIntelligenceExternalReference, 0)
+	for _, v := range model.ExternalReferences {
+		reference := securityinsight.ThreatIntelligenceExternalReference{
+			SourceName: utils.String(v.SourceName),
+			Hashes:     map[string]*string{},
+		}
+		if v.Description != "" {
+			reference.Description = utils.String(v.Description)
+		}
+		if v.ExternalId != "" {
+			reference.ExternalID = utils.String(v.ExternalId)
+		}
+		if v.Url != "" {
+			reference.URL = utils.String(v.Url)
+		}
+		for k, hash := range v.Hashes {
+			reference.Hashes[k] = utils.String(hash)
+		}
+		externalReferences = append(externalReferences, reference)
+	}
+	props.ExternalReferences = &externalReferences
+
+	granularMarkings := make([]securityinsight.ThreatIntelligenceGranularMarkingModel, 0)
+	for _, v := range model.GranularMarkings {
+		marking := securityinsight.ThreatIntelligenceGranularMarkingModel{
+			MarkingRef: utils.Int32(int32(v.MarkingRef)),
+		}
+		if v.Language != "" {
+			marking.Language = utils.String(v.Language)
+		}
+		if len(v.Selectors) != 0 {
+			selectors := v.Selectors
+			marking.Selectors = &selectors
+		}
+		granularMarkings = append(granularMarkings, marking)
+	}
+	props.GranularMarkings = &granularMarkings
+
+	return props
+}
+
+func flattenThreatIntelligenceKillChainPhases(input *[]securityinsight.ThreatIntelligenceKillChainPhase) []ThreatIntelligenceKillChainPhaseModel {
+	output := make([]ThreatIntelligenceKillChainPhaseModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ThreatIntelligenceKillChainPhaseModel{
+			KillChainName: utils.NormalizeNilableString(v.KillChainName),
+			PhaseName:     utils.NormalizeNilableString(v.PhaseName),
+		})
+	}
+
+	return output
+}
+
+func flattenThreatIntelligenceExternalReferences(input *[]securityinsight.ThreatIntelligenceExternalReference) []ThreatIntelligenceExternalReferenceModel {
+	output := make([]ThreatIntelligenceExternalReferenceModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		hashes := make(map[string]string)
+		for k, hash := range v.Hashes {
+			if hash != nil {
+				hashes[k] = *hash
+			}
+		}
+
+		output = append(output, ThreatIntelligenceExternalReferenceModel{
+			Description: utils.NormalizeNilableString(v.Description),
+			ExternalId:  utils.NormalizeNilableString(v.ExternalID),
+			SourceName:  utils.NormalizeNilableString(v.SourceName),
+			Url:         utils.NormalizeNilableString(v.URL),
+			Hashes:      hashes,
+		})
+	}
+
+	return output
+}
+
+func flattenThreatIntelligenceGranularMarkings(input *[]securityinsight.ThreatIntelligenceGranularMarkingModel) []ThreatIntelligenceGranularMarkingModel {
+	output := make([]ThreatIntelligenceGranularMarkingModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		marking := ThreatIntelligenceGranularMarkingModel{
+			Language: utils.NormalizeNilableString(v.Language),
+		}
+		if v.MarkingRef != nil {
+			marking.MarkingRef = int(*v.MarkingRef)
+		}
+		if v.Selectors != nil {
+			marking.Selectors = *v.Selectors
+		}
+		output = append(output, marking)
+	}
+
+	return output
+}
+
+// normalizeThreatIntelligenceTime returns the time in RFC3339 format, since the API returns it with a
+// varying precision (e.g. `2022-12-14T16:00:00.0000000Z`) which would otherwise cause a diff
+func normalizeThreatIntelligenceTime(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	t, err := time.Parse(time.RFC3339, *input)
+	if err != nil {
+		return *input
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,190 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ThreatIntelligenceIndicatorResource struct{}
+
+func TestAccThreatIntelligenceIndicator_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := ThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("guid").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccThreatIntelligenceIndicator_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := ThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccThreatIntelligenceIndicator_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := ThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ThreatIntelligenceIndicatorResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Sentinel.ThreatIntelligenceClient
+
+	id, err := parse.ThreatIntelligenceIndicatorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r ThreatIntelligenceIndicatorResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_threat_intelligence_indicator" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  display_name               = "acctest-indicator-%d"
+  pattern                    = "[ipv4-addr:value = '1.1.1.1']"
+  pattern_type               = "ipv4-addr"
+  source                     = "Microsoft Sentinel"
+  validate_from_utc          = "2022-12-14T16:00:00Z"
+}
+`, template, data.RandomInteger)
+}
+
+func (r ThreatIntelligenceIndicatorResource) complete(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_threat_intelligence_indicator" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  display_name               = "acctest-indicator-%d"
+  pattern                    = "[ipv4-addr:value = '1.1.1.1']"
+  pattern_type               = "ipv4-addr"
+  pattern_version            = "2.1"
+  source                     = "Microsoft Sentinel"
+  validate_from_utc          = "2022-12-14T16:00:00Z"
+  validate_until_utc         = "2023-12-14T16:00:00Z"
+  confidence                 = 80
+  created_by                 = "testcreator@microsoft.com"
+  description                = "test indicator"
+  language                   = "en"
+  revoked                    = true
+  threat_intelligence_tags   = ["test-tags"]
+  threat_types               = ["malicious-activity"]
+  object_marking_refs        = ["testrefs"]
+
+  kill_chain_phase {
+    kill_chain_name = "lockheed-martin-cyber-kill-chain"
+    phase_name      = "reconnaissance"
+  }
+
+  external_reference {
+    source_name = "test-source"
+    description = "test-external"
+    url         = "https://www.example.com"
+    hashes = {
+      SHA-256 = "DE5C4A45A3CB1224D6D0D0F8ED3F6F3F3D0A10E6E0D5D1E5F4B68C9E7F5F8A1B"
+    }
+  }
+
+  granular_marking {
+    language    = "en"
+    marking_ref = 1
+    selectors   = ["test"]
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func (r ThreatIntelligenceIndicatorResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%d"
+  location = %q
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-workspace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_log_analytics_solution" "sentinel" {
+  solution_name         = "SecurityInsights"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  workspace_resource_id = azurerm_log_analytics_workspace.test.id
+  workspace_name        = azurerm_log_analytics_workspace.test.name
+
+  plan {
+    publisher = "Microsoft"
+    product   = "OMSGallery/SecurityInsights"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,209 @@
+package sentinel
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+)
+
+// watchlistCsvItem is a single row of a Watchlist CSV, keyed by the column name
+type watchlistCsvItem map[string]string
+
+// watchlistExistingItem is a Watchlist Item which already exists within Sentinel
+type watchlistExistingItem struct {
+	id     string
+	values watchlistCsvItem
+}
+
+// watchlistItemUpsert is a Watchlist Item which needs to be created (when `id` is empty) or updated
+type watchlistItemUpsert struct {
+	id     string
+	values watchlistCsvItem
+}
+
+func readWatchlistCsvContent(source, sourceContent string) (string, error) {
+	if sourceContent != "" {
+		return sourceContent, nil
+	}
+
+	if source == "" {
+		return "", nil
+	}
+
+	content, err := os.ReadFile(source)
+	if err != nil {
+		return "", fmt.Errorf("reading Watchlist CSV file %q: %+v", source, err)
+	}
+
+	return string(content), nil
+}
+
+// parseWatchlistCsv parses the CSV content into a map of rows keyed by the value of the `searchKey` column
+func parseWatchlistCsv(content string, linesToSkip int, searchKey string) (map[string]watchlistCsvItem, error) {
+	for i := 0; i < linesToSkip; i++ {
+		idx := strings.IndexByte(content, '\n')
+		if idx == -1 {
+			return nil, fmt.Errorf("the CSV content has fewer than %d lines to skip", linesToSkip)
+		}
+		content = content[idx+1:]
+	}
+
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("the CSV content must contain a header row")
+		}
+		return nil, fmt.Errorf("reading the CSV header row: %+v", err)
+	}
+
+	searchKeyIndex := -1
+	for i, column := range header {
+		if strings.EqualFold(column, searchKey) {
+			searchKeyIndex = i
+			break
+		}
+	}
+	if searchKeyIndex == -1 {
+		return nil, fmt.Errorf("the CSV header row must contain the `item_search_key` column %q", searchKey)
+	}
+
+	items := make(map[string]watchlistCsvItem)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading the CSV content: %+v", err)
+		}
+
+		key := record[searchKeyIndex]
+		if key == "" {
+			return nil, fmt.Errorf("a CSV row has an empty value for the `item_search_key` column %q", searchKey)
+		}
+		if _, exists := items[key]; exists {
+			return nil, fmt.Errorf("the CSV content contains the duplicate `item_search_key` value %q", key)
+		}
+
+		item := make(watchlistCsvItem)
+		for i, column := range header {
+			item[column] = record[i]
+		}
+		items[key] = item
+	}
+
+	return items, nil
+}
+
+// flattenWatchlistExistingItems maps the Watchlist Items returned from the API by the value of the `searchKey` column
+func flattenWatchlistExistingItems(input []securityinsight.WatchlistItem, searchKey string) map[string]watchlistExistingItem {
+	output := make(map[string]watchlistExistingItem)
+
+	for _, item := range input {
+		if item.Name == nil || item.WatchlistItemProperties == nil {
+			continue
+		}
+
+		raw, ok := item.WatchlistItemProperties.ItemsKeyValue.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		values := make(watchlistCsvItem)
+		key := ""
+		for column, value := range raw {
+			v := ""
+			if value != nil {
+				v = fmt.Sprintf("%v", value)
+			}
+			values[column] = v
+
+			if strings.EqualFold(column, searchKey) {
+				key = v
+			}
+		}
+
+		if key == "" {
+			continue
+		}
+
+		output[key] = watchlistExistingItem{
+			id:     *item.Name,
+			values: values,
+		}
+	}
+
+	return output
+}
+
+// diffWatchlistItems compares the desired rows against the existing Watchlist Items, returning the items which
+// need to be created or updated and the IDs of the items which need to be deleted
+func diffWatchlistItems(desired map[string]watchlistCsvItem, existing map[string]watchlistExistingItem) ([]watchlistItemUpsert, []string) {
+	upserts := make([]watchlistItemUpsert, 0)
+	deletes := make([]string, 0)
+
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		values := desired[key]
+
+		current, exists := existing[key]
+		if !exists {
+			upserts = append(upserts, watchlistItemUpsert{
+				values: values,
+			})
+			continue
+		}
+
+		if !watchlistCsvItemsEqual(values, current.values) {
+			upserts = append(upserts, watchlistItemUpsert{
+				id:     current.id,
+				values: values,
+			})
+		}
+	}
+
+	for key, current := range existing {
+		if _, ok := desired[key]; !ok {
+			deletes = append(deletes, current.id)
+		}
+	}
+	sort.Strings(deletes)
+
+	return upserts, deletes
+}
+
+func watchlistCsvItemsEqual(first, second watchlistCsvItem) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	for column, value := range first {
+		v, ok := second[column]
+		if !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandWatchlistCsvItem(input watchlistCsvItem) map[string]interface{} {
+	output := make(map[string]interface{})
+	for column, value := range input {
+		output[column] = value
+	}
+	return output
+}
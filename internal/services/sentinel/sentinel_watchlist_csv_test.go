@@ -0,0 +1,159 @@
+package sentinel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestParseWatchlistCsv(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Content     string
+		LinesToSkip int
+		SearchKey   string
+		Expected    map[string]watchlistCsvItem
+		ExpectError bool
+	}{
+		{
+			Name:        "Empty",
+			Content:     "",
+			SearchKey:   "Key",
+			ExpectError: true,
+		},
+		{
+			Name:        "Missing Search Key Column",
+			Content:     "Name,Value\nfoo,bar\n",
+			SearchKey:   "Key",
+			ExpectError: true,
+		},
+		{
+			Name:      "Header Only",
+			Content:   "Key,Value\n",
+			SearchKey: "Key",
+			Expected:  map[string]watchlistCsvItem{},
+		},
+		{
+			Name:      "Valid",
+			Content:   "Key,Value\nfoo,bar\nbaz,\"quoted, value\"\n",
+			SearchKey: "Key",
+			Expected: map[string]watchlistCsvItem{
+				"foo": {"Key": "foo", "Value": "bar"},
+				"baz": {"Key": "baz", "Value": "quoted, value"},
+			},
+		},
+		{
+			Name:      "Search Key Case Insensitive",
+			Content:   "IPAddress,Owner\n10.0.0.1,alice\n",
+			SearchKey: "ipaddress",
+			Expected: map[string]watchlistCsvItem{
+				"10.0.0.1": {"IPAddress": "10.0.0.1", "Owner": "alice"},
+			},
+		},
+		{
+			Name:        "Lines To Skip",
+			Content:     "# exported from the CMDB\n# do not edit\nKey,Value\nfoo,bar\n",
+			LinesToSkip: 2,
+			SearchKey:   "Key",
+			Expected: map[string]watchlistCsvItem{
+				"foo": {"Key": "foo", "Value": "bar"},
+			},
+		},
+		{
+			Name:        "Too Many Lines To Skip",
+			Content:     "Key,Value\n",
+			LinesToSkip: 3,
+			SearchKey:   "Key",
+			ExpectError: true,
+		},
+		{
+			Name:        "Duplicate Search Key",
+			Content:     "Key,Value\nfoo,bar\nfoo,baz\n",
+			SearchKey:   "Key",
+			ExpectError: true,
+		},
+		{
+			Name:        "Empty Search Key",
+			Content:     "Key,Value\n,bar\n",
+			SearchKey:   "Key",
+			ExpectError: true,
+		},
+		{
+			Name:        "Inconsistent Columns",
+			Content:     "Key,Value\nfoo,bar,baz\n",
+			SearchKey:   "Key",
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := parseWatchlistCsv(v.Content, v.LinesToSkip, v.SearchKey)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestDiffWatchlistItems(t *testing.T) {
+	existingItems := []securityinsight.WatchlistItem{
+		{
+			Name: utils.String("item-unchanged"),
+			WatchlistItemProperties: &securityinsight.WatchlistItemProperties{
+				ItemsKeyValue: map[string]interface{}{"Key": "unchanged", "Value": "a"},
+			},
+		},
+		{
+			Name: utils.String("item-changed"),
+			WatchlistItemProperties: &securityinsight.WatchlistItemProperties{
+				ItemsKeyValue: map[string]interface{}{"Key": "changed", "Value": "b"},
+			},
+		},
+		{
+			Name: utils.String("item-removed"),
+			WatchlistItemProperties: &securityinsight.WatchlistItemProperties{
+				ItemsKeyValue: map[string]interface{}{"Key": "removed", "Value": "c"},
+			},
+		},
+	}
+
+	desired := map[string]watchlistCsvItem{
+		"unchanged": {"Key": "unchanged", "Value": "a"},
+		"changed":   {"Key": "changed", "Value": "updated"},
+		"added":     {"Key": "added", "Value": "d"},
+	}
+
+	upserts, deletes := diffWatchlistItems(desired, flattenWatchlistExistingItems(existingItems, "Key"))
+
+	expectedUpserts := []watchlistItemUpsert{
+		{
+			values: watchlistCsvItem{"Key": "added", "Value": "d"},
+		},
+		{
+			id:     "item-changed",
+			values: watchlistCsvItem{"Key": "changed", "Value": "updated"},
+		},
+	}
+	if !reflect.DeepEqual(expectedUpserts, upserts) {
+		t.Fatalf("Expected upserts %+v but got %+v", expectedUpserts, upserts)
+	}
+
+	expectedDeletes := []string{"item-removed"}
+	if !reflect.DeepEqual(expectedDeletes, deletes) {
+		t.Fatalf("Expected deletes %+v but got %+v", expectedDeletes, deletes)
+	}
+}
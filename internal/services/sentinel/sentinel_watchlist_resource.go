@@ -3,10 +3,12 @@ package sentinel
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-uuid"
 	commonValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
@@ -18,7 +20,7 @@ import (
 
 type WatchlistResource struct{}
 
-var _ sdk.Resource = WatchlistResource{}
+var _ sdk.ResourceWithUpdate = WatchlistResource{}
 
 type WatchlistModel struct {
 	Name                    string   `tfschema:"name"`
@@ -28,6 +30,10 @@ type WatchlistModel struct {
 	Labels                  []string `tfschema:"labels"`
 	DefaultDuration         string   `tfschema:"default_duration"`
 	ItemSearchKey           string   `tfschema:"item_search_key"`
+	Source                  string   `tfschema:"source"`
+	SourceContent           string   `tfschema:"source_content"`
+	ContentMd5              string   `tfschema:"content_md5"`
+	NumberOfLinesToSkip     int      `tfschema:"number_of_lines_to_skip"`
 }
 
 func (r WatchlistResource) Arguments() map[string]*pluginsdk.Schema {
@@ -77,6 +83,30 @@ func (r WatchlistResource) Arguments() map[string]*pluginsdk.Schema {
 			ForceNew:     true,
 			ValidateFunc: commonValidate.ISO8601Duration,
 		},
+		"source": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"source_content"},
+		},
+		"source_content": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"source"},
+		},
+		"content_md5": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"source_content"},
+		},
+		"number_of_lines_to_skip": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
 	}
 }
 
@@ -148,11 +178,32 @@ func (r WatchlistResource) Create() sdk.ResourceFunc {
 				param.WatchlistProperties.DefaultDuration = &model.DefaultDuration
 			}
 
+			content, err := readWatchlistCsvContent(model.Source, model.SourceContent)
+			if err != nil {
+				return err
+			}
+			if content != "" {
+				// parse the content up-front so that a missing search key column or malformed row surfaces before the upload
+				if _, err := parseWatchlistCsv(content, model.NumberOfLinesToSkip, model.ItemSearchKey); err != nil {
+					return fmt.Errorf("parsing the CSV content for %s: %+v", id, err)
+				}
+
+				param.WatchlistProperties.RawContent = utils.String(content)
+				param.WatchlistProperties.ContentType = utils.String("text/csv")
+				param.WatchlistProperties.NumberOfLinesToSkip = utils.Int32(int32(model.NumberOfLinesToSkip))
+			}
+
 			_, err = client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, param)
 			if err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
+			if content != "" {
+				if err := waitForWatchlistUpload(ctx, client, id); err != nil {
+					return err
+				}
+			}
+
 			metadata.SetID(id)
 			return nil
 		},
@@ -178,9 +229,19 @@ func (r WatchlistResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: %+v", id, err)
 			}
 
+			// the CSV content isn't returned from the API, so we retain the values from the state
+			var state WatchlistModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
 			model := WatchlistModel{
 				Name:                    id.Name,
 				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+				Source:                  state.Source,
+				SourceContent:           state.SourceContent,
+				ContentMd5:              state.ContentMd5,
+				NumberOfLinesToSkip:     state.NumberOfLinesToSkip,
 			}
 
 			if props := resp.WatchlistProperties; props != nil {
@@ -206,6 +267,91 @@ func (r WatchlistResource) Read() sdk.ResourceFunc {
 	}
 }
 
+func (r WatchlistResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.WatchlistItemsClient
+
+			id, err := parse.WatchlistID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WatchlistModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			if !metadata.ResourceData.HasChanges("source", "source_content", "content_md5", "number_of_lines_to_skip") {
+				return nil
+			}
+
+			content, err := readWatchlistCsvContent(model.Source, model.SourceContent)
+			if err != nil {
+				return err
+			}
+			if content == "" {
+				log.Printf("[DEBUG] no CSV content specified for %s - leaving the existing Watchlist Items untouched", id)
+				return nil
+			}
+
+			desired, err := parseWatchlistCsv(content, model.NumberOfLinesToSkip, model.ItemSearchKey)
+			if err != nil {
+				return fmt.Errorf("parsing the CSV content for %s: %+v", id, err)
+			}
+
+			// the Watchlist Items are only available once the upload of the CSV content has completed
+			if err := waitForWatchlistUpload(ctx, metadata.Client.Sentinel.WatchlistsClient, *id); err != nil {
+				return err
+			}
+
+			items := make([]securityinsight.WatchlistItem, 0)
+			iterator, err := client.ListComplete(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+			if err != nil {
+				return fmt.Errorf("listing Watchlist Items for %s: %+v", id, err)
+			}
+			for iterator.NotDone() {
+				items = append(items, iterator.Value())
+				if err := iterator.NextWithContext(ctx); err != nil {
+					return fmt.Errorf("listing Watchlist Items for %s: %+v", id, err)
+				}
+			}
+
+			upserts, deletes := diffWatchlistItems(desired, flattenWatchlistExistingItems(items, model.ItemSearchKey))
+			log.Printf("[DEBUG] updating %s: %d Watchlist Items to create or update, %d to delete", id, len(upserts), len(deletes))
+
+			for _, item := range upserts {
+				itemId := item.id
+				if itemId == "" {
+					if itemId, err = uuid.GenerateUUID(); err != nil {
+						return fmt.Errorf("generating UUID for Watchlist Item: %+v", err)
+					}
+				}
+
+				param := securityinsight.WatchlistItem{
+					WatchlistItemProperties: &securityinsight.WatchlistItemProperties{
+						ItemsKeyValue: expandWatchlistCsvItem(item.values),
+					},
+				}
+				itemResourceId := parse.NewWatchlistItemID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.Name, itemId)
+				if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, itemId, param); err != nil {
+					return fmt.Errorf("creating/updating %s: %+v", itemResourceId, err)
+				}
+			}
+
+			for _, itemId := range deletes {
+				itemResourceId := parse.NewWatchlistItemID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.Name, itemId)
+				if _, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, itemId); err != nil {
+					return fmt.Errorf("deleting %s: %+v", itemResourceId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r WatchlistResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -225,3 +371,43 @@ func (r WatchlistResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+func waitForWatchlistUpload(ctx context.Context, client *securityinsight.WatchlistsClient, id parse.WatchlistId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"New", "InProgress"},
+		Target:     []string{"Complete"},
+		Refresh:    watchlistUploadStatusRefreshFunc(ctx, client, id),
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the upload of the CSV content for %s to complete: %+v", id, err)
+	}
+
+	return nil
+}
+
+func watchlistUploadStatusRefreshFunc(ctx context.Context, client *securityinsight.WatchlistsClient, id parse.WatchlistId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] checking the upload status of %s", id)
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		// the upload status isn't returned when there's nothing to upload
+		status := "Complete"
+		if props := resp.WatchlistProperties; props != nil && props.UploadStatus != nil && *props.UploadStatus != "" {
+			status = *props.UploadStatus
+		}
+
+		return resp, status, nil
+	}
+}
@@ -46,6 +46,29 @@ func TestAccWatchlist_complete(t *testing.T) {
 	})
 }
 
+func TestAccWatchlist_sourceContent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist", "test")
+	r := WatchlistResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.sourceContent(data, "10.0.0.1,alice\\n10.0.0.2,bob\\n10.0.0.3,carol"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source_content"),
+		{
+			// updates a row, removes a row and adds a row
+			Config: r.sourceContent(data, "10.0.0.1,alice\\n10.0.0.2,robert\\n10.0.0.4,dave"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source_content"),
+	})
+}
+
 func TestAccWatchlist_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist", "test")
 	r := WatchlistResource{}
@@ -110,6 +133,21 @@ resource "azurerm_sentinel_watchlist" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r WatchlistResource) sourceContent(data acceptance.TestData, rows string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_watchlist" "test" {
+  name                       = "accTestWL-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_solution.sentinel.workspace_resource_id
+  display_name               = "test"
+  item_search_key            = "IPAddress"
+  source_content             = "IPAddress,Owner\n%s\n"
+}
+`, template, data.RandomInteger, rows)
+}
+
 func (r WatchlistResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,222 @@
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2022-01-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceServiceBusNamespaceCustomerManagedKey() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceServiceBusNamespaceCustomerManagedKeyCreateUpdate,
+		Read:   resourceServiceBusNamespaceCustomerManagedKeyRead,
+		Update: resourceServiceBusNamespaceCustomerManagedKeyCreateUpdate,
+		Delete: resourceServiceBusNamespaceCustomerManagedKeyDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := namespaces.ParseNamespaceID(id)
+			return err
+		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			client := meta.(*clients.Client).ServiceBus.NamespacesClient
+
+			var cancel context.CancelFunc
+			ctx, cancel = timeouts.ForRead(ctx, d)
+			defer cancel()
+
+			id, err := namespaces.ParseNamespaceID(d.Id())
+			if err != nil {
+				return []*pluginsdk.ResourceData{d}, err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return []*pluginsdk.ResourceData{d}, fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.Encryption == nil {
+				return []*pluginsdk.ResourceData{d}, fmt.Errorf("retrieving %s: no customer managed key present", *id)
+			}
+
+			return []*pluginsdk.ResourceData{d}, nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"namespace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: namespaces.ValidateNamespaceID,
+			},
+
+			// a versionless Key ID allows the Namespace to automatically rotate to the latest version of the Key
+			"key_vault_key_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			},
+
+			"identity_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+			},
+
+			"infrastructure_encryption_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceServiceBusNamespaceCustomerManagedKeyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := namespaces.ParseNamespaceID(d.Get("namespace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.NamespaceName, serviceBusNamespaceResourceName)
+	defer locks.UnlockByName(id.NamespaceName, serviceBusNamespaceResourceName)
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `model` or `properties` was nil", *id)
+	}
+
+	if d.IsNewResource() {
+		if resp.Model.Properties.Encryption != nil {
+			return tf.ImportAsExistsError("azurerm_servicebus_namespace_customer_managed_key", id.ID())
+		}
+	}
+
+	namespace := resp.Model
+	if namespace.Sku == nil || !strings.EqualFold(string(namespace.Sku.Name), string(namespaces.SkuNamePremium)) {
+		return fmt.Errorf("a Customer Managed Key can only be configured for a %q %s", string(namespaces.SkuNamePremium), *id)
+	}
+
+	identityId := d.Get("identity_id").(string)
+	if !serviceBusNamespaceHasUserAssignedIdentity(namespace, identityId) {
+		return fmt.Errorf("the User Assigned Identity %q must be assigned to %s", identityId, *id)
+	}
+
+	keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(d.Get("key_vault_key_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVaultProps := namespaces.KeyVaultProperties{
+		KeyName:     utils.String(keyId.Name),
+		KeyVaultUri: utils.String(keyId.KeyVaultBaseUrl),
+		Identity: &namespaces.UserAssignedIdentityProperties{
+			UserAssignedIdentity: utils.String(identityId),
+		},
+	}
+	if keyId.Version != "" {
+		keyVaultProps.KeyVersion = utils.String(keyId.Version)
+	}
+
+	keySource := namespaces.KeySourceMicrosoftPointKeyVault
+	namespace.Properties.Encryption = &namespaces.Encryption{
+		KeySource:                       &keySource,
+		KeyVaultProperties:              &[]namespaces.KeyVaultProperties{keyVaultProps},
+		RequireInfrastructureEncryption: utils.Bool(d.Get("infrastructure_encryption_enabled").(bool)),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, *namespace); err != nil {
+		return fmt.Errorf("creating/updating Customer Managed Key for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceServiceBusNamespaceCustomerManagedKeyRead(d, meta)
+}
+
+func resourceServiceBusNamespaceCustomerManagedKeyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := namespaces.ParseNamespaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if resp.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+	if resp.Model.Properties == nil || resp.Model.Properties.Encryption == nil {
+		log.Printf("[DEBUG] no Customer Managed Key was found for %s - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("namespace_id", id.ID())
+
+	customerManagedKey, err := flattenServiceBusNamespaceEncryption(resp.Model.Properties.Encryption)
+	if err != nil {
+		return err
+	}
+	if len(customerManagedKey) > 0 {
+		v := customerManagedKey[0].(map[string]interface{})
+		d.Set("key_vault_key_id", v["key_vault_key_id"])
+		d.Set("identity_id", v["identity_id"])
+		d.Set("infrastructure_encryption_enabled", v["infrastructure_encryption_enabled"])
+	}
+
+	return nil
+}
+
+func resourceServiceBusNamespaceCustomerManagedKeyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	log.Printf(`[INFO] Customer Managed Keys cannot be removed from ServiceBus Namespaces once added. To remove the Customer Managed Key delete and recreate the parent ServiceBus Namespace`)
+	return nil
+}
+
+func serviceBusNamespaceHasUserAssignedIdentity(namespace *namespaces.SBNamespace, identityId string) bool {
+	if namespace.Identity == nil {
+		return false
+	}
+
+	for id := range namespace.Identity.IdentityIds {
+		if strings.EqualFold(id, identityId) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -91,9 +91,12 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 				ValidateFunc: validation.IntInSlice([]int{0, 1, 2, 4, 8, 16}),
 			},
 
+			// NOTE: this is Optional & Computed since it can also be managed via the
+			// `azurerm_servicebus_namespace_customer_managed_key` resource
 			"customer_managed_key": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
@@ -253,6 +256,10 @@ func resourceServiceBusNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 		parameters.Properties.MinimumTlsVersion = &minimumTls
 	}
 
+	if parameters.Properties.Encryption != nil && !strings.EqualFold(sku, string(namespaces.SkuNamePremium)) {
+		return fmt.Errorf("a `customer_managed_key` can only be specified when `sku` is %q", string(namespaces.SkuNamePremium))
+	}
+
 	if capacity := d.Get("capacity"); capacity != nil {
 		if !strings.EqualFold(sku, string(namespaces.SkuNamePremium)) && capacity.(int) > 0 {
 			return fmt.Errorf("Service Bus SKU %q only supports `capacity` of 0", sku)
@@ -382,18 +389,21 @@ func expandServiceBusNamespaceEncryption(input []interface{}) *namespaces.Encryp
 	}
 	v := input[0].(map[string]interface{})
 	keyId, _ := keyVaultParse.ParseOptionallyVersionedNestedItemID(v["key_vault_key_id"].(string))
+	keyVaultProps := namespaces.KeyVaultProperties{
+		KeyName:     utils.String(keyId.Name),
+		KeyVaultUri: utils.String(keyId.KeyVaultBaseUrl),
+		Identity: &namespaces.UserAssignedIdentityProperties{
+			UserAssignedIdentity: utils.String(v["identity_id"].(string)),
+		},
+	}
+	// omitting the Key Version allows the Namespace to automatically rotate to the latest version of the Key
+	if keyId.Version != "" {
+		keyVaultProps.KeyVersion = utils.String(keyId.Version)
+	}
+
 	keySource := namespaces.KeySourceMicrosoftPointKeyVault
 	return &namespaces.Encryption{
-		KeyVaultProperties: &[]namespaces.KeyVaultProperties{
-			{
-				KeyName:     utils.String(keyId.Name),
-				KeyVersion:  utils.String(keyId.Version),
-				KeyVaultUri: utils.String(keyId.KeyVaultBaseUrl),
-				Identity: &namespaces.UserAssignedIdentityProperties{
-					UserAssignedIdentity: utils.String(v["identity_id"].(string)),
-				},
-			},
-		},
+		KeyVaultProperties:              &[]namespaces.KeyVaultProperties{keyVaultProps},
 		KeySource:                       &keySource,
 		RequireInfrastructureEncryption: utils.Bool(v["infrastructure_encryption_enabled"].(bool)),
 	}
@@ -408,7 +418,7 @@ func flattenServiceBusNamespaceEncryption(encryption *namespaces.Encryption) ([]
 	var identityId string
 	if keyVaultProperties := encryption.KeyVaultProperties; keyVaultProperties != nil && len(*keyVaultProperties) != 0 {
 		props := (*keyVaultProperties)[0]
-		keyVaultKeyId, err := keyVaultParse.NewNestedItemID(*props.KeyVaultUri, "keys", *props.KeyName, *props.KeyVersion)
+		keyVaultKeyId, err := keyVaultParse.NewNestedItemID(utils.NormalizeNilableString(props.KeyVaultUri), "keys", utils.NormalizeNilableString(props.KeyName), utils.NormalizeNilableString(props.KeyVersion))
 		if err != nil {
 			return nil, fmt.Errorf("parsing `key_vault_key_id`: %+v", err)
 		}
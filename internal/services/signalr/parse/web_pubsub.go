@@ -111,3 +111,23 @@ func WebPubsubIDInsensitively(input string) (*WebPubsubId, error) {
 
 	return &resourceId, nil
 }
+
+// WebPubsubIDFromNames builds an WebPubsubId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{webPubSubName}", using the specified Subscription ID when this is omitted
+func WebPubsubIDFromNames(subscriptionId, input string) (*WebPubsubId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{webPubSubName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{webPubSubName}")
+		}
+	}
+
+	resourceId := NewWebPubsubID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -129,3 +129,23 @@ func WebPubsubHubIDInsensitively(input string) (*WebPubsubHubId, error) {
 
 	return &resourceId, nil
 }
+
+// WebPubsubHubIDFromNames builds an WebPubsubHubId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{webPubSubName}/{hubName}", using the specified Subscription ID when this is omitted
+func WebPubsubHubIDFromNames(subscriptionId, input string) (*WebPubsubHubId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{webPubSubName}/{hubName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{webPubSubName}/{hubName}")
+		}
+	}
+
+	resourceId := NewWebPubsubHubID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func WebPubsubSharedPrivateLinkResourceID(input string) (*WebPubsubSharedPrivate
 
 	return &resourceId, nil
 }
+
+// WebPubsubSharedPrivateLinkResourceIDFromNames builds an WebPubsubSharedPrivateLinkResourceId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{webPubSubName}/{sharedPrivateLinkResourceName}", using the specified Subscription ID when this is omitted
+func WebPubsubSharedPrivateLinkResourceIDFromNames(subscriptionId, input string) (*WebPubsubSharedPrivateLinkResourceId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{webPubSubName}/{sharedPrivateLinkResourceName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{webPubSubName}/{sharedPrivateLinkResourceName}")
+		}
+	}
+
+	resourceId := NewWebPubsubSharedPrivateLinkResourceID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func SpringCloudAPIPortalID(input string) (*SpringCloudAPIPortalId, error) {
 
 	return &resourceId, nil
 }
+
+// SpringCloudAPIPortalIDFromNames builds an SpringCloudAPIPortalId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{apiPortalName}", using the specified Subscription ID when this is omitted
+func SpringCloudAPIPortalIDFromNames(subscriptionId, input string) (*SpringCloudAPIPortalId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{apiPortalName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{apiPortalName}")
+		}
+	}
+
+	resourceId := NewSpringCloudAPIPortalID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func SpringCloudAPIPortalCustomDomainID(input string) (*SpringCloudAPIPortalCust
 
 	return &resourceId, nil
 }
+
+// SpringCloudAPIPortalCustomDomainIDFromNames builds an SpringCloudAPIPortalCustomDomainId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{apiPortalName}/{domainName}", using the specified Subscription ID when this is omitted
+func SpringCloudAPIPortalCustomDomainIDFromNames(subscriptionId, input string) (*SpringCloudAPIPortalCustomDomainId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{apiPortalName}/{domainName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{apiPortalName}/{domainName}")
+		}
+	}
+
+	resourceId := NewSpringCloudAPIPortalCustomDomainID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
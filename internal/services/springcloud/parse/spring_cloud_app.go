@@ -73,3 +73,23 @@ func SpringCloudAppID(input string) (*SpringCloudAppId, error) {
 
 	return &resourceId, nil
 }
+
+// SpringCloudAppIDFromNames builds an SpringCloudAppId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{appName}", using the specified Subscription ID when this is omitted
+func SpringCloudAppIDFromNames(subscriptionId, input string) (*SpringCloudAppId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{appName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{appName}")
+		}
+	}
+
+	resourceId := NewSpringCloudAppID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
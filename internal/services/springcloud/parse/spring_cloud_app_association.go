@@ -79,3 +79,23 @@ func SpringCloudAppAssociationID(input string) (*SpringCloudAppAssociationId, er
 
 	return &resourceId, nil
 }
+
+// SpringCloudAppAssociationIDFromNames builds an SpringCloudAppAssociationId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{appName}/{bindingName}", using the specified Subscription ID when this is omitted
+func SpringCloudAppAssociationIDFromNames(subscriptionId, input string) (*SpringCloudAppAssociationId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{appName}/{bindingName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{appName}/{bindingName}")
+		}
+	}
+
+	resourceId := NewSpringCloudAppAssociationID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
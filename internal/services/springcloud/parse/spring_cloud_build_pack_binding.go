@@ -85,3 +85,23 @@ func SpringCloudBuildPackBindingID(input string) (*SpringCloudBuildPackBindingId
 
 	return &resourceId, nil
 }
+
+// SpringCloudBuildPackBindingIDFromNames builds an SpringCloudBuildPackBindingId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{buildServiceName}/{builderName}/{buildpackBindingName}", using the specified Subscription ID when this is omitted
+func SpringCloudBuildPackBindingIDFromNames(subscriptionId, input string) (*SpringCloudBuildPackBindingId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 5 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 6 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{buildServiceName}/{builderName}/{buildpackBindingName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{buildServiceName}/{builderName}/{buildpackBindingName}")
+		}
+	}
+
+	resourceId := NewSpringCloudBuildPackBindingID(names[0], names[1], names[2], names[3], names[4], names[5])
+	return &resourceId, nil
+}
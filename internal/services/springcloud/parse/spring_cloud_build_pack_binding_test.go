@@ -158,3 +158,97 @@ func TestSpringCloudBuildPackBindingID(t *testing.T) {
 		}
 	}
 }
+
+func TestSpringCloudBuildPackBindingIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *SpringCloudBuildPackBindingId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resourceGroup1/service1/buildService1/builder1",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "12345678-1234-9876-4563-123456789012/resourceGroup1/service1/buildService1/builder1/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resourceGroup1/service1/buildService1/builder1/buildpackBinding1",
+			Expected: &SpringCloudBuildPackBindingId{
+				SubscriptionId:       "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:        "resourceGroup1",
+				SpringName:           "service1",
+				BuildServiceName:     "buildService1",
+				BuilderName:          "builder1",
+				BuildpackBindingName: "buildpackBinding1",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "12345678-1234-9876-4563-123456789012/resourceGroup1/service1/buildService1/builder1/buildpackBinding1",
+			Expected: &SpringCloudBuildPackBindingId{
+				SubscriptionId:       "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:        "resourceGroup1",
+				SpringName:           "service1",
+				BuildServiceName:     "buildService1",
+				BuilderName:          "builder1",
+				BuildpackBindingName: "buildpackBinding1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := SpringCloudBuildPackBindingIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.SpringName != v.Expected.SpringName {
+			t.Fatalf("Expected %q but got %q for SpringName", v.Expected.SpringName, actual.SpringName)
+		}
+		if actual.BuildServiceName != v.Expected.BuildServiceName {
+			t.Fatalf("Expected %q but got %q for BuildServiceName", v.Expected.BuildServiceName, actual.BuildServiceName)
+		}
+		if actual.BuilderName != v.Expected.BuilderName {
+			t.Fatalf("Expected %q but got %q for BuilderName", v.Expected.BuilderName, actual.BuilderName)
+		}
+		if actual.BuildpackBindingName != v.Expected.BuildpackBindingName {
+			t.Fatalf("Expected %q but got %q for BuildpackBindingName", v.Expected.BuildpackBindingName, actual.BuildpackBindingName)
+		}
+	}
+}
@@ -79,3 +79,23 @@ func SpringCloudBuildServiceBuilderID(input string) (*SpringCloudBuildServiceBui
 
 	return &resourceId, nil
 }
+
+// SpringCloudBuildServiceBuilderIDFromNames builds an SpringCloudBuildServiceBuilderId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{buildServiceName}/{builderName}", using the specified Subscription ID when this is omitted
+func SpringCloudBuildServiceBuilderIDFromNames(subscriptionId, input string) (*SpringCloudBuildServiceBuilderId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{buildServiceName}/{builderName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{buildServiceName}/{builderName}")
+		}
+	}
+
+	resourceId := NewSpringCloudBuildServiceBuilderID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func SpringCloudCertificateID(input string) (*SpringCloudCertificateId, error) {
 
 	return &resourceId, nil
 }
+
+// SpringCloudCertificateIDFromNames builds an SpringCloudCertificateId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{certificateName}", using the specified Subscription ID when this is omitted
+func SpringCloudCertificateIDFromNames(subscriptionId, input string) (*SpringCloudCertificateId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{certificateName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{certificateName}")
+		}
+	}
+
+	resourceId := NewSpringCloudCertificateID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func SpringCloudConfigurationServiceID(input string) (*SpringCloudConfigurationS
 
 	return &resourceId, nil
 }
+
+// SpringCloudConfigurationServiceIDFromNames builds an SpringCloudConfigurationServiceId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{configurationServiceName}", using the specified Subscription ID when this is omitted
+func SpringCloudConfigurationServiceIDFromNames(subscriptionId, input string) (*SpringCloudConfigurationServiceId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{configurationServiceName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{configurationServiceName}")
+		}
+	}
+
+	resourceId := NewSpringCloudConfigurationServiceID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
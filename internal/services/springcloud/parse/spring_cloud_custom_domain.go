@@ -79,3 +79,23 @@ func SpringCloudCustomDomainID(input string) (*SpringCloudCustomDomainId, error)
 
 	return &resourceId, nil
 }
+
+// SpringCloudCustomDomainIDFromNames builds an SpringCloudCustomDomainId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{appName}/{domainName}", using the specified Subscription ID when this is omitted
+func SpringCloudCustomDomainIDFromNames(subscriptionId, input string) (*SpringCloudCustomDomainId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{appName}/{domainName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{appName}/{domainName}")
+		}
+	}
+
+	resourceId := NewSpringCloudCustomDomainID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func SpringCloudDeploymentID(input string) (*SpringCloudDeploymentId, error) {
 
 	return &resourceId, nil
 }
+
+// SpringCloudDeploymentIDFromNames builds an SpringCloudDeploymentId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{appName}/{deploymentName}", using the specified Subscription ID when this is omitted
+func SpringCloudDeploymentIDFromNames(subscriptionId, input string) (*SpringCloudDeploymentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{appName}/{deploymentName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{appName}/{deploymentName}")
+		}
+	}
+
+	resourceId := NewSpringCloudDeploymentID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func SpringCloudGatewayID(input string) (*SpringCloudGatewayId, error) {
 
 	return &resourceId, nil
 }
+
+// SpringCloudGatewayIDFromNames builds an SpringCloudGatewayId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{gatewayName}", using the specified Subscription ID when this is omitted
+func SpringCloudGatewayIDFromNames(subscriptionId, input string) (*SpringCloudGatewayId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{gatewayName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{gatewayName}")
+		}
+	}
+
+	resourceId := NewSpringCloudGatewayID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func SpringCloudGatewayCustomDomainID(input string) (*SpringCloudGatewayCustomDo
 
 	return &resourceId, nil
 }
+
+// SpringCloudGatewayCustomDomainIDFromNames builds an SpringCloudGatewayCustomDomainId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{gatewayName}/{domainName}", using the specified Subscription ID when this is omitted
+func SpringCloudGatewayCustomDomainIDFromNames(subscriptionId, input string) (*SpringCloudGatewayCustomDomainId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{gatewayName}/{domainName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{gatewayName}/{domainName}")
+		}
+	}
+
+	resourceId := NewSpringCloudGatewayCustomDomainID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func SpringCloudGatewayRouteConfigID(input string) (*SpringCloudGatewayRouteConf
 
 	return &resourceId, nil
 }
+
+// SpringCloudGatewayRouteConfigIDFromNames builds an SpringCloudGatewayRouteConfigId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{gatewayName}/{routeConfigName}", using the specified Subscription ID when this is omitted
+func SpringCloudGatewayRouteConfigIDFromNames(subscriptionId, input string) (*SpringCloudGatewayRouteConfigId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{gatewayName}/{routeConfigName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{gatewayName}/{routeConfigName}")
+		}
+	}
+
+	resourceId := NewSpringCloudGatewayRouteConfigID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -67,3 +67,23 @@ func SpringCloudServiceID(input string) (*SpringCloudServiceId, error) {
 
 	return &resourceId, nil
 }
+
+// SpringCloudServiceIDFromNames builds an SpringCloudServiceId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}", using the specified Subscription ID when this is omitted
+func SpringCloudServiceIDFromNames(subscriptionId, input string) (*SpringCloudServiceId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}")
+		}
+	}
+
+	resourceId := NewSpringCloudServiceID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func SpringCloudServiceRegistryID(input string) (*SpringCloudServiceRegistryId,
 
 	return &resourceId, nil
 }
+
+// SpringCloudServiceRegistryIDFromNames builds an SpringCloudServiceRegistryId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{serviceRegistryName}", using the specified Subscription ID when this is omitted
+func SpringCloudServiceRegistryIDFromNames(subscriptionId, input string) (*SpringCloudServiceRegistryId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{serviceRegistryName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{serviceRegistryName}")
+		}
+	}
+
+	resourceId := NewSpringCloudServiceRegistryID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func SpringCloudStorageID(input string) (*SpringCloudStorageId, error) {
 
 	return &resourceId, nil
 }
+
+// SpringCloudStorageIDFromNames builds an SpringCloudStorageId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{springName}/{storageName}", using the specified Subscription ID when this is omitted
+func SpringCloudStorageIDFromNames(subscriptionId, input string) (*SpringCloudStorageId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{storageName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{springName}/{storageName}")
+		}
+	}
+
+	resourceId := NewSpringCloudStorageID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func AzureActiveDirectoryAdministratorID(input string) (*AzureActiveDirectoryAdm
 
 	return &resourceId, nil
 }
+
+// AzureActiveDirectoryAdministratorIDFromNames builds an AzureActiveDirectoryAdministratorId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverName}/{administratorName}", using the specified Subscription ID when this is omitted
+func AzureActiveDirectoryAdministratorIDFromNames(subscriptionId, input string) (*AzureActiveDirectoryAdministratorId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{administratorName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverName}/{administratorName}")
+		}
+	}
+
+	resourceId := NewAzureActiveDirectoryAdministratorID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func InstanceFailoverGroupID(input string) (*InstanceFailoverGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// InstanceFailoverGroupIDFromNames builds an InstanceFailoverGroupId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{locationName}/{name}", using the specified Subscription ID when this is omitted
+func InstanceFailoverGroupIDFromNames(subscriptionId, input string) (*InstanceFailoverGroupId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{locationName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{locationName}/{name}")
+		}
+	}
+
+	resourceId := NewInstanceFailoverGroupID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
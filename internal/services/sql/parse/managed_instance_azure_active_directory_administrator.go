@@ -73,3 +73,23 @@ func ManagedInstanceAzureActiveDirectoryAdministratorID(input string) (*ManagedI
 
 	return &resourceId, nil
 }
+
+// ManagedInstanceAzureActiveDirectoryAdministratorIDFromNames builds an ManagedInstanceAzureActiveDirectoryAdministratorId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{managedInstanceName}/{administratorName}", using the specified Subscription ID when this is omitted
+func ManagedInstanceAzureActiveDirectoryAdministratorIDFromNames(subscriptionId, input string) (*ManagedInstanceAzureActiveDirectoryAdministratorId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{managedInstanceName}/{administratorName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{managedInstanceName}/{administratorName}")
+		}
+	}
+
+	resourceId := NewManagedInstanceAzureActiveDirectoryAdministratorID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -36,6 +36,7 @@ type Client struct {
 	Environment                 azure.Environment
 	FileServicesClient          *storage.FileServicesClient
 	ObjectReplicationClient     *objectreplicationpolicies.ObjectReplicationPoliciesClient
+	SkusClient                  *storage.SkusClient
 	SyncServiceClient           *storagesync.ServicesClient
 	SyncGroupsClient            *storagesync.SyncGroupsClient
 	SubscriptionId              string
@@ -78,6 +79,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	syncServiceClient := storagesync.NewServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&syncServiceClient.Client, options.ResourceManagerAuthorizer)
 
+	skusClient := storage.NewSkusClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&skusClient.Client, options.ResourceManagerAuthorizer)
+
 	syncGroupsClient := storagesync.NewSyncGroupsClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&syncGroupsClient.Client, options.ResourceManagerAuthorizer)
 
@@ -95,6 +99,7 @@ func NewClient(options *common.ClientOptions) *Client {
 		Environment:                 options.Environment,
 		FileServicesClient:          &fileServicesClient,
 		ObjectReplicationClient:     &objectReplicationPolicyClient,
+		SkusClient:                  &skusClient,
 		SubscriptionId:              options.SubscriptionId,
 		SyncServiceClient:           &syncServiceClient,
 		SyncGroupsClient:            &syncGroupsClient,
@@ -73,3 +73,23 @@ func BlobInventoryPolicyID(input string) (*BlobInventoryPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// BlobInventoryPolicyIDFromNames builds an BlobInventoryPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{inventoryPolicyName}", using the specified Subscription ID when this is omitted
+func BlobInventoryPolicyIDFromNames(subscriptionId, input string) (*BlobInventoryPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{inventoryPolicyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{inventoryPolicyName}")
+		}
+	}
+
+	resourceId := NewBlobInventoryPolicyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func EncryptionScopeID(input string) (*EncryptionScopeId, error) {
 
 	return &resourceId, nil
 }
+
+// EncryptionScopeIDFromNames builds an EncryptionScopeId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{name}", using the specified Subscription ID when this is omitted
+func EncryptionScopeIDFromNames(subscriptionId, input string) (*EncryptionScopeId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{name}")
+		}
+	}
+
+	resourceId := NewEncryptionScopeID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
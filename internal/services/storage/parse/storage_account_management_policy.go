@@ -73,3 +73,23 @@ func StorageAccountManagementPolicyID(input string) (*StorageAccountManagementPo
 
 	return &resourceId, nil
 }
+
+// StorageAccountManagementPolicyIDFromNames builds an StorageAccountManagementPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{managementPolicyName}", using the specified Subscription ID when this is omitted
+func StorageAccountManagementPolicyIDFromNames(subscriptionId, input string) (*StorageAccountManagementPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{managementPolicyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{managementPolicyName}")
+		}
+	}
+
+	resourceId := NewStorageAccountManagementPolicyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
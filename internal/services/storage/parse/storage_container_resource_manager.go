@@ -79,3 +79,23 @@ func StorageContainerResourceManagerID(input string) (*StorageContainerResourceM
 
 	return &resourceId, nil
 }
+
+// StorageContainerResourceManagerIDFromNames builds an StorageContainerResourceManagerId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{blobServiceName}/{containerName}", using the specified Subscription ID when this is omitted
+func StorageContainerResourceManagerIDFromNames(subscriptionId, input string) (*StorageContainerResourceManagerId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{blobServiceName}/{containerName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{blobServiceName}/{containerName}")
+		}
+	}
+
+	resourceId := NewStorageContainerResourceManagerID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
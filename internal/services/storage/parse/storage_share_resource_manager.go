@@ -79,3 +79,23 @@ func StorageShareResourceManagerID(input string) (*StorageShareResourceManagerId
 
 	return &resourceId, nil
 }
+
+// StorageShareResourceManagerIDFromNames builds an StorageShareResourceManagerId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{fileServiceName}/{fileshareName}", using the specified Subscription ID when this is omitted
+func StorageShareResourceManagerIDFromNames(subscriptionId, input string) (*StorageShareResourceManagerId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{fileServiceName}/{fileshareName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageAccountName}/{fileServiceName}/{fileshareName}")
+		}
+	}
+
+	resourceId := NewStorageShareResourceManagerID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
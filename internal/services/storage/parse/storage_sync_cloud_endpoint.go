@@ -79,3 +79,23 @@ func StorageSyncCloudEndpointID(input string) (*StorageSyncCloudEndpointId, erro
 
 	return &resourceId, nil
 }
+
+// StorageSyncCloudEndpointIDFromNames builds an StorageSyncCloudEndpointId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{storageSyncServiceName}/{syncGroupName}/{cloudEndpointName}", using the specified Subscription ID when this is omitted
+func StorageSyncCloudEndpointIDFromNames(subscriptionId, input string) (*StorageSyncCloudEndpointId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageSyncServiceName}/{syncGroupName}/{cloudEndpointName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageSyncServiceName}/{syncGroupName}/{cloudEndpointName}")
+		}
+	}
+
+	resourceId := NewStorageSyncCloudEndpointID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -142,3 +142,92 @@ func TestStorageSyncCloudEndpointID(t *testing.T) {
 		}
 	}
 }
+
+func TestStorageSyncCloudEndpointIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *StorageSyncCloudEndpointId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resGroup1/storageSyncService1/syncGroup1",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "12345678-1234-9876-4563-123456789012/resGroup1/storageSyncService1/syncGroup1/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resGroup1/storageSyncService1/syncGroup1/cloudEndpoint1",
+			Expected: &StorageSyncCloudEndpointId{
+				SubscriptionId:         "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:          "resGroup1",
+				StorageSyncServiceName: "storageSyncService1",
+				SyncGroupName:          "syncGroup1",
+				CloudEndpointName:      "cloudEndpoint1",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "12345678-1234-9876-4563-123456789012/resGroup1/storageSyncService1/syncGroup1/cloudEndpoint1",
+			Expected: &StorageSyncCloudEndpointId{
+				SubscriptionId:         "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:          "resGroup1",
+				StorageSyncServiceName: "storageSyncService1",
+				SyncGroupName:          "syncGroup1",
+				CloudEndpointName:      "cloudEndpoint1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := StorageSyncCloudEndpointIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.StorageSyncServiceName != v.Expected.StorageSyncServiceName {
+			t.Fatalf("Expected %q but got %q for StorageSyncServiceName", v.Expected.StorageSyncServiceName, actual.StorageSyncServiceName)
+		}
+		if actual.SyncGroupName != v.Expected.SyncGroupName {
+			t.Fatalf("Expected %q but got %q for SyncGroupName", v.Expected.SyncGroupName, actual.SyncGroupName)
+		}
+		if actual.CloudEndpointName != v.Expected.CloudEndpointName {
+			t.Fatalf("Expected %q but got %q for CloudEndpointName", v.Expected.CloudEndpointName, actual.CloudEndpointName)
+		}
+	}
+}
@@ -73,3 +73,23 @@ func StorageSyncGroupID(input string) (*StorageSyncGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// StorageSyncGroupIDFromNames builds an StorageSyncGroupId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{storageSyncServiceName}/{syncGroupName}", using the specified Subscription ID when this is omitted
+func StorageSyncGroupIDFromNames(subscriptionId, input string) (*StorageSyncGroupId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageSyncServiceName}/{syncGroupName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{storageSyncServiceName}/{syncGroupName}")
+		}
+	}
+
+	resourceId := NewStorageSyncGroupID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
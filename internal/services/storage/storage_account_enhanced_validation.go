@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// storageAccountSkus caches the Locations each combination of Storage Account SKU and Kind is available in,
+// for each Subscription
+var storageAccountSkus = &sdk.CapabilityCache{}
+
+func (r Registration) EnhancedValidations() []sdk.EnhancedValidation {
+	return []sdk.EnhancedValidation{
+		{
+			ResourceType: "azurerm_storage_account",
+			Description:  "the combination of `account_kind`, `account_tier` and `account_replication_type` is available in the Location",
+			Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+				d := metadata.ResourceDiff
+				fields := []string{"location", "account_kind", "account_tier", "account_replication_type"}
+				if d.Id() != "" && !d.HasChanges(fields...) {
+					return nil
+				}
+				for _, field := range fields {
+					if !d.NewValueKnown(field) {
+						return nil
+					}
+				}
+
+				locationName := location.Normalize(d.Get("location").(string))
+				kind := d.Get("account_kind").(string)
+				skuName := fmt.Sprintf("%s_%s", d.Get("account_tier").(string), d.Get("account_replication_type").(string))
+
+				raw, err := storageAccountSkus.Get(metadata.Client.Account.SubscriptionId, func() (interface{}, error) {
+					return retrieveStorageAccountSkus(ctx, metadata.Client.Storage.SkusClient)
+				})
+				if err != nil {
+					metadata.Logger.Warnf("%+v - skipping Enhanced Validation of the Storage Account SKU", err)
+					return nil
+				}
+
+				return checkStorageAccountSkuAvailability(raw.(map[string][]string), skuName, kind, locationName)
+			},
+		},
+	}
+}
+
+func checkStorageAccountSkuAvailability(skus map[string][]string, skuName, kind, locationName string) error {
+	locations, ok := skus[strings.ToLower(fmt.Sprintf("%s/%s", skuName, kind))]
+	if !ok {
+		return fmt.Errorf("a Storage Account with the SKU %q is not supported for the `account_kind` %q", skuName, kind)
+	}
+
+	for _, v := range locations {
+		if v == locationName {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("a Storage Account with the SKU %q and `account_kind` %q is not available in the Location %q", skuName, kind, locationName)
+}
+
+// retrieveStorageAccountSkus returns the Locations each SKU/Kind combination is available in,
+// keyed by `{skuName}/{kind}` in lower-case
+func retrieveStorageAccountSkus(ctx context.Context, client *storage.SkusClient) (map[string][]string, error) {
+	resp, err := client.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing Storage Account SKUs: %+v", err)
+	}
+
+	if resp.Value == nil {
+		return map[string][]string{}, nil
+	}
+
+	return flattenStorageAccountSkus(*resp.Value), nil
+}
+
+func flattenStorageAccountSkus(input []storage.SkuInformation) map[string][]string {
+	output := make(map[string][]string)
+	for _, sku := range input {
+		restricted := make(map[string]struct{})
+		if sku.Restrictions != nil {
+			for _, restriction := range *sku.Restrictions {
+				if restriction.Type == nil || !strings.EqualFold(*restriction.Type, "location") || restriction.Values == nil {
+					continue
+				}
+
+				for _, v := range *restriction.Values {
+					restricted[location.Normalize(v)] = struct{}{}
+				}
+			}
+		}
+
+		key := strings.ToLower(fmt.Sprintf("%s/%s", string(sku.Name), string(sku.Kind)))
+		locations := output[key]
+		if sku.Locations != nil {
+			for _, v := range *sku.Locations {
+				if _, ok := restricted[location.Normalize(v)]; !ok {
+					locations = append(locations, location.Normalize(v))
+				}
+			}
+		}
+		output[key] = locations
+	}
+
+	return output
+}
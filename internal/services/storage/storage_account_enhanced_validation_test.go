@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenStorageAccountSkus(t *testing.T) {
+	input := []storage.SkuInformation{
+		{
+			Name:      storage.SkuNameStandardLRS,
+			Kind:      storage.KindStorageV2,
+			Locations: &[]string{"West Europe", "eastus"},
+		},
+		{
+			Name:      storage.SkuNamePremiumZRS,
+			Kind:      storage.KindFileStorage,
+			Locations: &[]string{"westeurope", "northeurope"},
+			Restrictions: &[]storage.Restriction{
+				{
+					Type:       utils.String("Location"),
+					Values:     &[]string{"North Europe"},
+					ReasonCode: storage.ReasonCodeNotAvailableForSubscription,
+				},
+			},
+		},
+		{
+			Name: storage.SkuNameStandardGZRS,
+			Kind: storage.KindStorageV2,
+		},
+	}
+
+	expected := map[string][]string{
+		"standard_lrs/storagev2":  {"westeurope", "eastus"},
+		"premium_zrs/filestorage": {"westeurope"},
+		"standard_gzrs/storagev2": nil,
+	}
+
+	if actual := flattenStorageAccountSkus(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestCheckStorageAccountSkuAvailability(t *testing.T) {
+	skus := map[string][]string{
+		"standard_lrs/storagev2":  {"westeurope", "eastus"},
+		"premium_zrs/filestorage": {"westeurope"},
+	}
+
+	testData := []struct {
+		skuName  string
+		kind     string
+		location string
+		valid    bool
+	}{
+		{
+			skuName:  "Standard_LRS",
+			kind:     "StorageV2",
+			location: "eastus",
+			valid:    true,
+		},
+		{
+			skuName:  "Premium_ZRS",
+			kind:     "FileStorage",
+			location: "westeurope",
+			valid:    true,
+		},
+		{
+			skuName:  "Premium_ZRS",
+			kind:     "FileStorage",
+			location: "northeurope",
+			valid:    false,
+		},
+		{
+			skuName:  "Premium_ZRS",
+			kind:     "StorageV2",
+			location: "westeurope",
+			valid:    false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q in %q", v.skuName, v.kind, v.location)
+
+		err := checkStorageAccountSkuAvailability(skus, v.skuName, v.kind, v.location)
+		if v.valid && err != nil {
+			t.Fatalf("expected %q / %q to be valid but got: %+v", v.skuName, v.kind, err)
+		}
+		if !v.valid && err == nil {
+			t.Fatalf("expected %q / %q to be invalid but it was valid", v.skuName, v.kind)
+		}
+	}
+}
@@ -73,3 +73,23 @@ func FunctionID(input string) (*FunctionId, error) {
 
 	return &resourceId, nil
 }
+
+// FunctionIDFromNames builds an FunctionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{streamingjobName}/{name}", using the specified Subscription ID when this is omitted
+func FunctionIDFromNames(subscriptionId, input string) (*FunctionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{streamingjobName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{streamingjobName}/{name}")
+		}
+	}
+
+	resourceId := NewFunctionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
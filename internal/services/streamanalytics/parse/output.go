@@ -73,3 +73,23 @@ func OutputID(input string) (*OutputId, error) {
 
 	return &resourceId, nil
 }
+
+// OutputIDFromNames builds an OutputId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{streamingjobName}/{name}", using the specified Subscription ID when this is omitted
+func OutputIDFromNames(subscriptionId, input string) (*OutputId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{streamingjobName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{streamingjobName}/{name}")
+		}
+	}
+
+	resourceId := NewOutputID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
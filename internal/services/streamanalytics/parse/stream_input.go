@@ -73,3 +73,23 @@ func StreamInputID(input string) (*StreamInputId, error) {
 
 	return &resourceId, nil
 }
+
+// StreamInputIDFromNames builds an StreamInputId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{streamingjobName}/{inputName}", using the specified Subscription ID when this is omitted
+func StreamInputIDFromNames(subscriptionId, input string) (*StreamInputId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{streamingjobName}/{inputName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{streamingjobName}/{inputName}")
+		}
+	}
+
+	resourceId := NewStreamInputID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func StreamingJobScheduleID(input string) (*StreamingJobScheduleId, error) {
 
 	return &resourceId, nil
 }
+
+// StreamingJobScheduleIDFromNames builds an StreamingJobScheduleId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{streamingjobName}/{scheduleName}", using the specified Subscription ID when this is omitted
+func StreamingJobScheduleIDFromNames(subscriptionId, input string) (*StreamingJobScheduleId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{streamingjobName}/{scheduleName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{streamingjobName}/{scheduleName}")
+		}
+	}
+
+	resourceId := NewStreamingJobScheduleID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func SparkPoolID(input string) (*SparkPoolId, error) {
 
 	return &resourceId, nil
 }
+
+// SparkPoolIDFromNames builds an SparkPoolId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{bigDataPoolName}", using the specified Subscription ID when this is omitted
+func SparkPoolIDFromNames(subscriptionId, input string) (*SparkPoolId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{bigDataPoolName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{bigDataPoolName}")
+		}
+	}
+
+	resourceId := NewSparkPoolID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
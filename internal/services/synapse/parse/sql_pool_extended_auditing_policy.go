@@ -79,3 +79,23 @@ func SqlPoolExtendedAuditingPolicyID(input string) (*SqlPoolExtendedAuditingPoli
 
 	return &resourceId, nil
 }
+
+// SqlPoolExtendedAuditingPolicyIDFromNames builds an SqlPoolExtendedAuditingPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{extendedAuditingSettingName}", using the specified Subscription ID when this is omitted
+func SqlPoolExtendedAuditingPolicyIDFromNames(subscriptionId, input string) (*SqlPoolExtendedAuditingPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{extendedAuditingSettingName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{extendedAuditingSettingName}")
+		}
+	}
+
+	resourceId := NewSqlPoolExtendedAuditingPolicyID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func SqlPoolSecurityAlertPolicyID(input string) (*SqlPoolSecurityAlertPolicyId,
 
 	return &resourceId, nil
 }
+
+// SqlPoolSecurityAlertPolicyIDFromNames builds an SqlPoolSecurityAlertPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{securityAlertPolicyName}", using the specified Subscription ID when this is omitted
+func SqlPoolSecurityAlertPolicyIDFromNames(subscriptionId, input string) (*SqlPoolSecurityAlertPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{securityAlertPolicyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{securityAlertPolicyName}")
+		}
+	}
+
+	resourceId := NewSqlPoolSecurityAlertPolicyID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
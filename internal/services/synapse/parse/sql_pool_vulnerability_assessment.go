@@ -79,3 +79,23 @@ func SqlPoolVulnerabilityAssessmentID(input string) (*SqlPoolVulnerabilityAssess
 
 	return &resourceId, nil
 }
+
+// SqlPoolVulnerabilityAssessmentIDFromNames builds an SqlPoolVulnerabilityAssessmentId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{vulnerabilityAssessmentName}", using the specified Subscription ID when this is omitted
+func SqlPoolVulnerabilityAssessmentIDFromNames(subscriptionId, input string) (*SqlPoolVulnerabilityAssessmentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{vulnerabilityAssessmentName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{vulnerabilityAssessmentName}")
+		}
+	}
+
+	resourceId := NewSqlPoolVulnerabilityAssessmentID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
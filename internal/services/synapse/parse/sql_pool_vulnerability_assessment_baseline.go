@@ -91,3 +91,23 @@ func SqlPoolVulnerabilityAssessmentBaselineID(input string) (*SqlPoolVulnerabili
 
 	return &resourceId, nil
 }
+
+// SqlPoolVulnerabilityAssessmentBaselineIDFromNames builds an SqlPoolVulnerabilityAssessmentBaselineId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{vulnerabilityAssessmentName}/{ruleName}/{baselineName}", using the specified Subscription ID when this is omitted
+func SqlPoolVulnerabilityAssessmentBaselineIDFromNames(subscriptionId, input string) (*SqlPoolVulnerabilityAssessmentBaselineId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 6 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 7 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{vulnerabilityAssessmentName}/{ruleName}/{baselineName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{vulnerabilityAssessmentName}/{ruleName}/{baselineName}")
+		}
+	}
+
+	resourceId := NewSqlPoolVulnerabilityAssessmentBaselineID(names[0], names[1], names[2], names[3], names[4], names[5], names[6])
+	return &resourceId, nil
+}
@@ -174,3 +174,102 @@ func TestSqlPoolVulnerabilityAssessmentBaselineID(t *testing.T) {
 		}
 	}
 }
+
+func TestSqlPoolVulnerabilityAssessmentBaselineIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *SqlPoolVulnerabilityAssessmentBaselineId
+	}{
+
+		{
+			// empty
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "",
+			Error:          true,
+		},
+
+		{
+			// missing the last segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resGroup1/workspace1/sqlPool1/default/rule1",
+			Error:          true,
+		},
+
+		{
+			// empty segment
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "12345678-1234-9876-4563-123456789012/resGroup1/workspace1/sqlPool1/default/rule1/",
+			Error:          true,
+		},
+
+		{
+			// valid without the Subscription ID
+			SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			Input:          "resGroup1/workspace1/sqlPool1/default/rule1/baseline1",
+			Expected: &SqlPoolVulnerabilityAssessmentBaselineId{
+				SubscriptionId:              "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:               "resGroup1",
+				WorkspaceName:               "workspace1",
+				SqlPoolName:                 "sqlPool1",
+				VulnerabilityAssessmentName: "default",
+				RuleName:                    "rule1",
+				BaselineName:                "baseline1",
+			},
+		},
+
+		{
+			// valid with the Subscription ID
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+			Input:          "12345678-1234-9876-4563-123456789012/resGroup1/workspace1/sqlPool1/default/rule1/baseline1",
+			Expected: &SqlPoolVulnerabilityAssessmentBaselineId{
+				SubscriptionId:              "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:               "resGroup1",
+				WorkspaceName:               "workspace1",
+				SqlPoolName:                 "sqlPool1",
+				VulnerabilityAssessmentName: "default",
+				RuleName:                    "rule1",
+				BaselineName:                "baseline1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := SqlPoolVulnerabilityAssessmentBaselineIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.WorkspaceName != v.Expected.WorkspaceName {
+			t.Fatalf("Expected %q but got %q for WorkspaceName", v.Expected.WorkspaceName, actual.WorkspaceName)
+		}
+		if actual.SqlPoolName != v.Expected.SqlPoolName {
+			t.Fatalf("Expected %q but got %q for SqlPoolName", v.Expected.SqlPoolName, actual.SqlPoolName)
+		}
+		if actual.VulnerabilityAssessmentName != v.Expected.VulnerabilityAssessmentName {
+			t.Fatalf("Expected %q but got %q for VulnerabilityAssessmentName", v.Expected.VulnerabilityAssessmentName, actual.VulnerabilityAssessmentName)
+		}
+		if actual.RuleName != v.Expected.RuleName {
+			t.Fatalf("Expected %q but got %q for RuleName", v.Expected.RuleName, actual.RuleName)
+		}
+		if actual.BaselineName != v.Expected.BaselineName {
+			t.Fatalf("Expected %q but got %q for BaselineName", v.Expected.BaselineName, actual.BaselineName)
+		}
+	}
+}
@@ -85,3 +85,23 @@ func SqlPoolWorkloadClassifierID(input string) (*SqlPoolWorkloadClassifierId, er
 
 	return &resourceId, nil
 }
+
+// SqlPoolWorkloadClassifierIDFromNames builds an SqlPoolWorkloadClassifierId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{workloadGroupName}/{workloadClassifierName}", using the specified Subscription ID when this is omitted
+func SqlPoolWorkloadClassifierIDFromNames(subscriptionId, input string) (*SqlPoolWorkloadClassifierId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 5 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 6 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{workloadGroupName}/{workloadClassifierName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{workloadGroupName}/{workloadClassifierName}")
+		}
+	}
+
+	resourceId := NewSqlPoolWorkloadClassifierID(names[0], names[1], names[2], names[3], names[4], names[5])
+	return &resourceId, nil
+}
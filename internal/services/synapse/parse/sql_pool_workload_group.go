@@ -79,3 +79,23 @@ func SqlPoolWorkloadGroupID(input string) (*SqlPoolWorkloadGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// SqlPoolWorkloadGroupIDFromNames builds an SqlPoolWorkloadGroupId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{workloadGroupName}", using the specified Subscription ID when this is omitted
+func SqlPoolWorkloadGroupIDFromNames(subscriptionId, input string) (*SqlPoolWorkloadGroupId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{workloadGroupName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlPoolName}/{workloadGroupName}")
+		}
+	}
+
+	resourceId := NewSqlPoolWorkloadGroupID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func WorkspaceAADAdminID(input string) (*WorkspaceAADAdminId, error) {
 
 	return &resourceId, nil
 }
+
+// WorkspaceAADAdminIDFromNames builds an WorkspaceAADAdminId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{administratorName}", using the specified Subscription ID when this is omitted
+func WorkspaceAADAdminIDFromNames(subscriptionId, input string) (*WorkspaceAADAdminId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{administratorName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{administratorName}")
+		}
+	}
+
+	resourceId := NewWorkspaceAADAdminID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func WorkspaceExtendedAuditingPolicyID(input string) (*WorkspaceExtendedAuditing
 
 	return &resourceId, nil
 }
+
+// WorkspaceExtendedAuditingPolicyIDFromNames builds an WorkspaceExtendedAuditingPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{extendedAuditingSettingName}", using the specified Subscription ID when this is omitted
+func WorkspaceExtendedAuditingPolicyIDFromNames(subscriptionId, input string) (*WorkspaceExtendedAuditingPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{extendedAuditingSettingName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{extendedAuditingSettingName}")
+		}
+	}
+
+	resourceId := NewWorkspaceExtendedAuditingPolicyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func WorkspaceKeysID(input string) (*WorkspaceKeysId, error) {
 
 	return &resourceId, nil
 }
+
+// WorkspaceKeysIDFromNames builds an WorkspaceKeysId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{keyName}", using the specified Subscription ID when this is omitted
+func WorkspaceKeysIDFromNames(subscriptionId, input string) (*WorkspaceKeysId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{keyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{keyName}")
+		}
+	}
+
+	resourceId := NewWorkspaceKeysID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func WorkspaceSecurityAlertPolicyID(input string) (*WorkspaceSecurityAlertPolicy
 
 	return &resourceId, nil
 }
+
+// WorkspaceSecurityAlertPolicyIDFromNames builds an WorkspaceSecurityAlertPolicyId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{securityAlertPolicyName}", using the specified Subscription ID when this is omitted
+func WorkspaceSecurityAlertPolicyIDFromNames(subscriptionId, input string) (*WorkspaceSecurityAlertPolicyId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{securityAlertPolicyName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{securityAlertPolicyName}")
+		}
+	}
+
+	resourceId := NewWorkspaceSecurityAlertPolicyID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
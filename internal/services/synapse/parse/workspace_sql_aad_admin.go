@@ -73,3 +73,23 @@ func WorkspaceSqlAADAdminID(input string) (*WorkspaceSqlAADAdminId, error) {
 
 	return &resourceId, nil
 }
+
+// WorkspaceSqlAADAdminIDFromNames builds an WorkspaceSqlAADAdminId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlAdministratorName}", using the specified Subscription ID when this is omitted
+func WorkspaceSqlAADAdminIDFromNames(subscriptionId, input string) (*WorkspaceSqlAADAdminId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlAdministratorName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{sqlAdministratorName}")
+		}
+	}
+
+	resourceId := NewWorkspaceSqlAADAdminID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
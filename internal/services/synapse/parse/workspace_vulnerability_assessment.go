@@ -73,3 +73,23 @@ func WorkspaceVulnerabilityAssessmentID(input string) (*WorkspaceVulnerabilityAs
 
 	return &resourceId, nil
 }
+
+// WorkspaceVulnerabilityAssessmentIDFromNames builds an WorkspaceVulnerabilityAssessmentId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{vulnerabilityAssessmentName}", using the specified Subscription ID when this is omitted
+func WorkspaceVulnerabilityAssessmentIDFromNames(subscriptionId, input string) (*WorkspaceVulnerabilityAssessmentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{vulnerabilityAssessmentName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{workspaceName}/{vulnerabilityAssessmentName}")
+		}
+	}
+
+	resourceId := NewWorkspaceVulnerabilityAssessmentID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
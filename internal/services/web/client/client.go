@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/sdk/2022-03-01/staticsites"
 )
 
 type Client struct {
@@ -13,6 +14,7 @@ type Client struct {
 	CertificatesClient           *web.CertificatesClient
 	CertificatesOrderClient      *web.AppServiceCertificateOrdersClient
 	StaticSitesClient            *web.StaticSitesClient
+	StaticWebAppsClient          *staticsites.StaticSitesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -37,6 +39,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	staticSitesClient := web.NewStaticSitesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&staticSitesClient.Client, o.ResourceManagerAuthorizer)
 
+	staticWebAppsClient := staticsites.NewStaticSitesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&staticWebAppsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AppServiceEnvironmentsClient: &appServiceEnvironmentsClient,
 		AppServicePlansClient:        &appServicePlansClient,
@@ -45,5 +50,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		CertificatesClient:           &certificatesClient,
 		CertificatesOrderClient:      &certificatesOrderClient,
 		StaticSitesClient:            &staticSitesClient,
+		StaticWebAppsClient:          &staticWebAppsClient,
 	}
 }
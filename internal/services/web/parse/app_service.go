@@ -67,3 +67,23 @@ func AppServiceID(input string) (*AppServiceId, error) {
 
 	return &resourceId, nil
 }
+
+// AppServiceIDFromNames builds an AppServiceId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{siteName}", using the specified Subscription ID when this is omitted
+func AppServiceIDFromNames(subscriptionId, input string) (*AppServiceId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}")
+		}
+	}
+
+	resourceId := NewAppServiceID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
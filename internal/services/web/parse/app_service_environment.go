@@ -67,3 +67,23 @@ func AppServiceEnvironmentID(input string) (*AppServiceEnvironmentId, error) {
 
 	return &resourceId, nil
 }
+
+// AppServiceEnvironmentIDFromNames builds an AppServiceEnvironmentId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{hostingEnvironmentName}", using the specified Subscription ID when this is omitted
+func AppServiceEnvironmentIDFromNames(subscriptionId, input string) (*AppServiceEnvironmentId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{hostingEnvironmentName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{hostingEnvironmentName}")
+		}
+	}
+
+	resourceId := NewAppServiceEnvironmentID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
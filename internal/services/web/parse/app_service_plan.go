@@ -67,3 +67,23 @@ func AppServicePlanID(input string) (*AppServicePlanId, error) {
 
 	return &resourceId, nil
 }
+
+// AppServicePlanIDFromNames builds an AppServicePlanId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{serverfarmName}", using the specified Subscription ID when this is omitted
+func AppServicePlanIDFromNames(subscriptionId, input string) (*AppServicePlanId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverfarmName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{serverfarmName}")
+		}
+	}
+
+	resourceId := NewAppServicePlanID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func AppServiceSlotID(input string) (*AppServiceSlotId, error) {
 
 	return &resourceId, nil
 }
+
+// AppServiceSlotIDFromNames builds an AppServiceSlotId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}", using the specified Subscription ID when this is omitted
+func AppServiceSlotIDFromNames(subscriptionId, input string) (*AppServiceSlotId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}")
+		}
+	}
+
+	resourceId := NewAppServiceSlotID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
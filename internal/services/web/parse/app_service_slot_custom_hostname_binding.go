@@ -79,3 +79,23 @@ func AppServiceSlotCustomHostnameBindingID(input string) (*AppServiceSlotCustomH
 
 	return &resourceId, nil
 }
+
+// AppServiceSlotCustomHostnameBindingIDFromNames builds an AppServiceSlotCustomHostnameBindingId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}/{hostNameBindingName}", using the specified Subscription ID when this is omitted
+func AppServiceSlotCustomHostnameBindingIDFromNames(subscriptionId, input string) (*AppServiceSlotCustomHostnameBindingId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}/{hostNameBindingName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}/{hostNameBindingName}")
+		}
+	}
+
+	resourceId := NewAppServiceSlotCustomHostnameBindingID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
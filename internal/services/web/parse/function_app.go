@@ -67,3 +67,23 @@ func FunctionAppID(input string) (*FunctionAppId, error) {
 
 	return &resourceId, nil
 }
+
+// FunctionAppIDFromNames builds an FunctionAppId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{siteName}", using the specified Subscription ID when this is omitted
+func FunctionAppIDFromNames(subscriptionId, input string) (*FunctionAppId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}")
+		}
+	}
+
+	resourceId := NewFunctionAppID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func FunctionAppSlotID(input string) (*FunctionAppSlotId, error) {
 
 	return &resourceId, nil
 }
+
+// FunctionAppSlotIDFromNames builds an FunctionAppSlotId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}", using the specified Subscription ID when this is omitted
+func FunctionAppSlotIDFromNames(subscriptionId, input string) (*FunctionAppSlotId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}")
+		}
+	}
+
+	resourceId := NewFunctionAppSlotID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func HostnameBindingID(input string) (*HostnameBindingId, error) {
 
 	return &resourceId, nil
 }
+
+// HostnameBindingIDFromNames builds an HostnameBindingId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{siteName}/{name}", using the specified Subscription ID when this is omitted
+func HostnameBindingIDFromNames(subscriptionId, input string) (*HostnameBindingId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{name}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{name}")
+		}
+	}
+
+	resourceId := NewHostnameBindingID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
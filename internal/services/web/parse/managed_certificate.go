@@ -67,3 +67,23 @@ func ManagedCertificateID(input string) (*ManagedCertificateId, error) {
 
 	return &resourceId, nil
 }
+
+// ManagedCertificateIDFromNames builds an ManagedCertificateId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{certificateName}", using the specified Subscription ID when this is omitted
+func ManagedCertificateIDFromNames(subscriptionId, input string) (*ManagedCertificateId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 2 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 3 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{certificateName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{certificateName}")
+		}
+	}
+
+	resourceId := NewManagedCertificateID(names[0], names[1], names[2])
+	return &resourceId, nil
+}
@@ -79,3 +79,23 @@ func SlotVirtualNetworkSwiftConnectionID(input string) (*SlotVirtualNetworkSwift
 
 	return &resourceId, nil
 }
+
+// SlotVirtualNetworkSwiftConnectionIDFromNames builds an SlotVirtualNetworkSwiftConnectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}/{configName}", using the specified Subscription ID when this is omitted
+func SlotVirtualNetworkSwiftConnectionIDFromNames(subscriptionId, input string) (*SlotVirtualNetworkSwiftConnectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 4 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 5 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}/{configName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{slotName}/{configName}")
+		}
+	}
+
+	resourceId := NewSlotVirtualNetworkSwiftConnectionID(names[0], names[1], names[2], names[3], names[4])
+	return &resourceId, nil
+}
@@ -73,3 +73,23 @@ func StaticSiteCustomDomainID(input string) (*StaticSiteCustomDomainId, error) {
 
 	return &resourceId, nil
 }
+
+// StaticSiteCustomDomainIDFromNames builds an StaticSiteCustomDomainId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{staticSiteName}/{customDomainName}", using the specified Subscription ID when this is omitted
+func StaticSiteCustomDomainIDFromNames(subscriptionId, input string) (*StaticSiteCustomDomainId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{staticSiteName}/{customDomainName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{staticSiteName}/{customDomainName}")
+		}
+	}
+
+	resourceId := NewStaticSiteCustomDomainID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
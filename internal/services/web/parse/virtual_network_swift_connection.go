@@ -73,3 +73,23 @@ func VirtualNetworkSwiftConnectionID(input string) (*VirtualNetworkSwiftConnecti
 
 	return &resourceId, nil
 }
+
+// VirtualNetworkSwiftConnectionIDFromNames builds an VirtualNetworkSwiftConnectionId from the names of each segment in the format
+// "[{subscriptionId}/]{resourceGroup}/{siteName}/{configName}", using the specified Subscription ID when this is omitted
+func VirtualNetworkSwiftConnectionIDFromNames(subscriptionId, input string) (*VirtualNetworkSwiftConnectionId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == 3 {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != 4 {
+		return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{configName}")
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %q to be in the format %q", input, "[{subscriptionId}/]{resourceGroup}/{siteName}/{configName}")
+		}
+	}
+
+	resourceId := NewVirtualNetworkSwiftConnectionID(names[0], names[1], names[2], names[3])
+	return &resourceId, nil
+}
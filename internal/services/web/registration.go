@@ -61,11 +61,13 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		AppServiceEnvironmentV3DataSource{},
+		StaticWebAppEnvironmentsDataSource{},
 	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AppServiceEnvironmentV3Resource{},
+		StaticWebAppResource{},
 	}
 }
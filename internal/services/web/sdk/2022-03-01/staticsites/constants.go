@@ -0,0 +1,71 @@
+package staticsites
+
+import "strings"
+
+type BuildStatus string
+
+const (
+	BuildStatusDeleting             BuildStatus = "Deleting"
+	BuildStatusDetached             BuildStatus = "Detached"
+	BuildStatusFailed               BuildStatus = "Failed"
+	BuildStatusReady                BuildStatus = "Ready"
+	BuildStatusUploading            BuildStatus = "Uploading"
+	BuildStatusWaitingForDeployment BuildStatus = "WaitingForDeployment"
+)
+
+func PossibleValuesForBuildStatus() []string {
+	return []string{
+		string(BuildStatusDeleting),
+		string(BuildStatusDetached),
+		string(BuildStatusFailed),
+		string(BuildStatusReady),
+		string(BuildStatusUploading),
+		string(BuildStatusWaitingForDeployment),
+	}
+}
+
+func parseBuildStatus(input string) (*BuildStatus, error) {
+	vals := map[string]BuildStatus{
+		"deleting":             BuildStatusDeleting,
+		"detached":             BuildStatusDetached,
+		"failed":               BuildStatusFailed,
+		"ready":                BuildStatusReady,
+		"uploading":            BuildStatusUploading,
+		"waitingfordeployment": BuildStatusWaitingForDeployment,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := BuildStatus(input)
+	return &out, nil
+}
+
+type StagingEnvironmentPolicy string
+
+const (
+	StagingEnvironmentPolicyDisabled StagingEnvironmentPolicy = "Disabled"
+	StagingEnvironmentPolicyEnabled  StagingEnvironmentPolicy = "Enabled"
+)
+
+func PossibleValuesForStagingEnvironmentPolicy() []string {
+	return []string{
+		string(StagingEnvironmentPolicyDisabled),
+		string(StagingEnvironmentPolicyEnabled),
+	}
+}
+
+func parseStagingEnvironmentPolicy(input string) (*StagingEnvironmentPolicy, error) {
+	vals := map[string]StagingEnvironmentPolicy{
+		"disabled": StagingEnvironmentPolicyDisabled,
+		"enabled":  StagingEnvironmentPolicyEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StagingEnvironmentPolicy(input)
+	return &out, nil
+}
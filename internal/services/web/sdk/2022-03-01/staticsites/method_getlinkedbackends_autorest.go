@@ -0,0 +1,183 @@
+package staticsites
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetLinkedBackendsResponse struct {
+	HttpResponse *http.Response
+	Model        *[]StaticSiteLinkedBackendARMResource
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (GetLinkedBackendsResponse, error)
+}
+
+type GetLinkedBackendsCompleteResult struct {
+	Items []StaticSiteLinkedBackendARMResource
+}
+
+func (r GetLinkedBackendsResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r GetLinkedBackendsResponse) LoadMore(ctx context.Context) (resp GetLinkedBackendsResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+// GetLinkedBackends ...
+func (c StaticSitesClient) GetLinkedBackends(ctx context.Context, id StaticSiteId) (resp GetLinkedBackendsResponse, err error) {
+	req, err := c.preparerForGetLinkedBackends(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetLinkedBackends", nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetLinkedBackends", resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderForGetLinkedBackends(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetLinkedBackends", resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// GetLinkedBackendsComplete retrieves all of the results into a single object
+func (c StaticSitesClient) GetLinkedBackendsComplete(ctx context.Context, id StaticSiteId) (GetLinkedBackendsCompleteResult, error) {
+	return c.GetLinkedBackendsCompleteMatchingPredicate(ctx, id, StaticSiteLinkedBackendARMResourcePredicate{})
+}
+
+// GetLinkedBackendsCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c StaticSitesClient) GetLinkedBackendsCompleteMatchingPredicate(ctx context.Context, id StaticSiteId, predicate StaticSiteLinkedBackendARMResourcePredicate) (resp GetLinkedBackendsCompleteResult, err error) {
+	items := make([]StaticSiteLinkedBackendARMResource, 0)
+
+	page, err := c.GetLinkedBackends(ctx, id)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := GetLinkedBackendsCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+// preparerForGetLinkedBackends prepares the GetLinkedBackends request.
+func (c StaticSitesClient) preparerForGetLinkedBackends(ctx context.Context, id StaticSiteId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/linkedBackends", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForGetLinkedBackendsWithNextLink prepares the GetLinkedBackends request with the given nextLink token.
+func (c StaticSitesClient) preparerForGetLinkedBackendsWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %q: %+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetLinkedBackends handles the response to the GetLinkedBackends request. The method always
+// closes the http.Response Body.
+func (c StaticSitesClient) responderForGetLinkedBackends(resp *http.Response) (result GetLinkedBackendsResponse, err error) {
+	type page struct {
+		Values   []StaticSiteLinkedBackendARMResource `json:"value"`
+		NextLink *string                              `json:"nextLink"`
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result GetLinkedBackendsResponse, err error) {
+			req, err := c.preparerForGetLinkedBackendsWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetLinkedBackends", nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetLinkedBackends", result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderForGetLinkedBackends(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetLinkedBackends", result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,62 @@
+package staticsites
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type UnlinkBackendResponse struct {
+	HttpResponse *http.Response
+}
+
+// UnlinkBackend ...
+func (c StaticSitesClient) UnlinkBackend(ctx context.Context, id LinkedBackendId) (result UnlinkBackendResponse, err error) {
+	req, err := c.preparerForUnlinkBackend(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "UnlinkBackend", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "UnlinkBackend", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForUnlinkBackend(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "UnlinkBackend", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForUnlinkBackend prepares the UnlinkBackend request.
+func (c StaticSitesClient) preparerForUnlinkBackend(ctx context.Context, id LinkedBackendId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForUnlinkBackend handles the response to the UnlinkBackend request. The method always
+// closes the http.Response Body.
+func (c StaticSitesClient) responderForUnlinkBackend(resp *http.Response) (result UnlinkBackendResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
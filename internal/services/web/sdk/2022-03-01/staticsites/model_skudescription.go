@@ -0,0 +1,6 @@
+package staticsites
+
+type SkuDescription struct {
+	Name *string `json:"name,omitempty"`
+	Tier *string `json:"tier,omitempty"`
+}
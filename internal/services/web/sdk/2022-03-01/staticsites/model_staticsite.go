@@ -0,0 +1,16 @@
+package staticsites
+
+type StaticSite struct {
+	AllowConfigFileUpdates      *bool                      `json:"allowConfigFileUpdates,omitempty"`
+	Branch                      *string                    `json:"branch,omitempty"`
+	ContentDistributionEndpoint *string                    `json:"contentDistributionEndpoint,omitempty"`
+	CustomDomains               *[]string                  `json:"customDomains,omitempty"`
+	DefaultHostname             *string                    `json:"defaultHostname,omitempty"`
+	KeyVaultReferenceIdentity   *string                    `json:"keyVaultReferenceIdentity,omitempty"`
+	LinkedBackends              *[]StaticSiteLinkedBackend `json:"linkedBackends,omitempty"`
+	Provider                    *string                    `json:"provider,omitempty"`
+	PublicNetworkAccess         *string                    `json:"publicNetworkAccess,omitempty"`
+	RepositoryToken             *string                    `json:"repositoryToken,omitempty"`
+	RepositoryUrl               *string                    `json:"repositoryUrl,omitempty"`
+	StagingEnvironmentPolicy    *StagingEnvironmentPolicy  `json:"stagingEnvironmentPolicy,omitempty"`
+}
@@ -0,0 +1,9 @@
+package staticsites
+
+type StaticSiteBasicAuthPropertiesARMResource struct {
+	Id         *string                                             `json:"id,omitempty"`
+	Kind       *string                                             `json:"kind,omitempty"`
+	Name       *string                                             `json:"name,omitempty"`
+	Properties *StaticSiteBasicAuthPropertiesARMResourceProperties `json:"properties,omitempty"`
+	Type       *string                                             `json:"type,omitempty"`
+}
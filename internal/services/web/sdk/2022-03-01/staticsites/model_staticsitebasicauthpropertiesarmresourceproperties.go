@@ -0,0 +1,9 @@
+package staticsites
+
+type StaticSiteBasicAuthPropertiesARMResourceProperties struct {
+	ApplicableEnvironmentsMode string    `json:"applicableEnvironmentsMode"`
+	Environments               *[]string `json:"environments,omitempty"`
+	Password                   *string   `json:"password,omitempty"`
+	SecretState                *string   `json:"secretState,omitempty"`
+	SecretUrl                  *string   `json:"secretUrl,omitempty"`
+}
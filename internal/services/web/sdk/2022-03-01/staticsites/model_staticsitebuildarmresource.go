@@ -0,0 +1,9 @@
+package staticsites
+
+type StaticSiteBuildARMResource struct {
+	Id         *string                               `json:"id,omitempty"`
+	Kind       *string                               `json:"kind,omitempty"`
+	Name       *string                               `json:"name,omitempty"`
+	Properties *StaticSiteBuildARMResourceProperties `json:"properties,omitempty"`
+	Type       *string                               `json:"type,omitempty"`
+}
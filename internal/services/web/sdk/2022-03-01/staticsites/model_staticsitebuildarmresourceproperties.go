@@ -0,0 +1,12 @@
+package staticsites
+
+type StaticSiteBuildARMResourceProperties struct {
+	BuildId          *string                    `json:"buildId,omitempty"`
+	CreatedTimeUtc   *string                    `json:"createdTimeUtc,omitempty"`
+	Hostname         *string                    `json:"hostname,omitempty"`
+	LastUpdatedOn    *string                    `json:"lastUpdatedOn,omitempty"`
+	LinkedBackends   *[]StaticSiteLinkedBackend `json:"linkedBackends,omitempty"`
+	PullRequestTitle *string                    `json:"pullRequestTitle,omitempty"`
+	SourceBranch     *string                    `json:"sourceBranch,omitempty"`
+	Status           *BuildStatus               `json:"status,omitempty"`
+}
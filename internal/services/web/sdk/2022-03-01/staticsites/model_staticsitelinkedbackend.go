@@ -0,0 +1,8 @@
+package staticsites
+
+type StaticSiteLinkedBackend struct {
+	BackendResourceId *string `json:"backendResourceId,omitempty"`
+	CreatedOn         *string `json:"createdOn,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	Region            *string `json:"region,omitempty"`
+}
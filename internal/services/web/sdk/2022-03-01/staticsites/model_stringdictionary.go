@@ -0,0 +1,9 @@
+package staticsites
+
+type StringDictionary struct {
+	Id         *string            `json:"id,omitempty"`
+	Kind       *string            `json:"kind,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *map[string]string `json:"properties,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,148 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/sdk/2022-03-01/staticsites"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StaticWebAppEnvironmentsDataSource struct{}
+
+var _ sdk.DataSource = StaticWebAppEnvironmentsDataSource{}
+
+type StaticWebAppEnvironmentsModel struct {
+	StaticWebAppId string                         `tfschema:"static_web_app_id"`
+	Environments   []StaticWebAppEnvironmentModel `tfschema:"environments"`
+}
+
+type StaticWebAppEnvironmentModel struct {
+	Name             string `tfschema:"name"`
+	HostName         string `tfschema:"host_name"`
+	SourceBranch     string `tfschema:"source_branch"`
+	PullRequestTitle string `tfschema:"pull_request_title"`
+	Status           string `tfschema:"status"`
+	CreatedTime      string `tfschema:"created_time"`
+	LastUpdatedTime  string `tfschema:"last_updated_time"`
+}
+
+func (d StaticWebAppEnvironmentsDataSource) ResourceType() string {
+	return "azurerm_static_web_app_environments"
+}
+
+func (d StaticWebAppEnvironmentsDataSource) ModelObject() interface{} {
+	return &StaticWebAppEnvironmentsModel{}
+}
+
+func (d StaticWebAppEnvironmentsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"static_web_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: staticsites.ValidateStaticSiteID,
+		},
+	}
+}
+
+func (d StaticWebAppEnvironmentsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"environments": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"host_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"source_branch": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"pull_request_title": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"created_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"last_updated_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d StaticWebAppEnvironmentsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.StaticWebAppsClient
+
+			var model StaticWebAppEnvironmentsModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := staticsites.ParseStaticSiteID(model.StaticWebAppId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetStaticSiteBuildsComplete(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing the Environments for %s: %+v", *id, err)
+			}
+
+			environments := make([]StaticWebAppEnvironmentModel, 0)
+			for _, item := range resp.Items {
+				environment := StaticWebAppEnvironmentModel{
+					Name: utils.NormalizeNilableString(item.Name),
+				}
+
+				if props := item.Properties; props != nil {
+					environment.HostName = utils.NormalizeNilableString(props.Hostname)
+					environment.SourceBranch = utils.NormalizeNilableString(props.SourceBranch)
+					environment.PullRequestTitle = utils.NormalizeNilableString(props.PullRequestTitle)
+					environment.CreatedTime = utils.NormalizeNilableString(props.CreatedTimeUtc)
+					environment.LastUpdatedTime = utils.NormalizeNilableString(props.LastUpdatedOn)
+					if props.Status != nil {
+						environment.Status = string(*props.Status)
+					}
+				}
+
+				environments = append(environments, environment)
+			}
+
+			state := StaticWebAppEnvironmentsModel{
+				StaticWebAppId: id.ID(),
+				Environments:   environments,
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package web_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StaticWebAppEnvironmentsDataSource struct{}
+
+func TestAccStaticWebAppEnvironmentsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_static_web_app_environments", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StaticWebAppEnvironmentsDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				// the production environment exists once the Static Web App has been created
+				check.That(data.ResourceName).Key("environments.#").HasValue("1"),
+				check.That(data.ResourceName).Key("environments.0.name").HasValue("default"),
+			),
+		},
+	})
+}
+
+func (StaticWebAppEnvironmentsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_static_web_app_environments" "test" {
+  static_web_app_id = azurerm_static_web_app.test.id
+}
+`, StaticWebAppResource{}.basic(data))
+}
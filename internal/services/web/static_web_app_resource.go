@@ -0,0 +1,545 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/sdk/2022-03-01/staticsites"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	staticWebAppSkuFree     = "Free"
+	staticWebAppSkuStandard = "Standard"
+
+	staticWebAppBasicAuthAllEnvironments       = "AllEnvironments"
+	staticWebAppBasicAuthStagingEnvironments   = "StagingEnvironments"
+	staticWebAppBasicAuthSpecifiedEnvironments = "SpecifiedEnvironments"
+
+	staticWebAppPublicNetworkAccessEnabled  = "Enabled"
+	staticWebAppPublicNetworkAccessDisabled = "Disabled"
+
+	// a Static Web App can only be linked to a single backend, so this is always used as the name of the link
+	staticWebAppLinkedBackendName = "backend"
+)
+
+type StaticWebAppResource struct{}
+
+var _ sdk.ResourceWithUpdate = StaticWebAppResource{}
+
+type StaticWebAppModel struct {
+	Name                string                                     `tfschema:"name"`
+	ResourceGroupName   string                                     `tfschema:"resource_group_name"`
+	Location            string                                     `tfschema:"location"`
+	SkuTier             string                                     `tfschema:"sku_tier"`
+	SkuSize             string                                     `tfschema:"sku_size"`
+	AppSettings         map[string]string                          `tfschema:"app_settings"`
+	BasicAuth           []StaticWebAppBasicAuthModel               `tfschema:"basic_auth"`
+	ConfigFileChanges   bool                                       `tfschema:"configuration_file_changes_enabled"`
+	Identity            []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	LinkedBackend       []StaticWebAppLinkedBackendModel           `tfschema:"linked_backend"`
+	PreviewEnvironments bool                                       `tfschema:"preview_environments_enabled"`
+	PublicNetworkAccess bool                                       `tfschema:"public_network_access_enabled"`
+	Tags                map[string]string                          `tfschema:"tags"`
+	ApiKey              string                                     `tfschema:"api_key"`
+	DefaultHostName     string                                     `tfschema:"default_host_name"`
+}
+
+type StaticWebAppBasicAuthModel struct {
+	Password     string `tfschema:"password"`
+	Environments string `tfschema:"environments"`
+}
+
+type StaticWebAppLinkedBackendModel struct {
+	BackendResourceId string `tfschema:"backend_resource_id"`
+	Region            string `tfschema:"region"`
+}
+
+func (r StaticWebAppResource) ResourceType() string {
+	return "azurerm_static_web_app"
+}
+
+func (r StaticWebAppResource) ModelObject() interface{} {
+	return &StaticWebAppModel{}
+}
+
+func (r StaticWebAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return staticsites.ValidateStaticSiteID
+}
+
+func (r StaticWebAppResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StaticSiteName,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku_tier": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  staticWebAppSkuFree,
+			ValidateFunc: validation.StringInSlice([]string{
+				staticWebAppSkuFree,
+				staticWebAppSkuStandard,
+			}, false),
+		},
+
+		"sku_size": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  staticWebAppSkuFree,
+			ValidateFunc: validation.StringInSlice([]string{
+				staticWebAppSkuFree,
+				staticWebAppSkuStandard,
+			}, false),
+		},
+
+		"app_settings": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"basic_auth": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"password": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"environments": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							staticWebAppBasicAuthAllEnvironments,
+							staticWebAppBasicAuthStagingEnvironments,
+						}, false),
+					},
+				},
+			},
+		},
+
+		"configuration_file_changes_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"linked_backend": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					// the ID of a Function App, Container App or API Management Service
+					"backend_resource_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+
+					"region": commonschema.LocationWithoutForceNew(),
+				},
+			},
+		},
+
+		"preview_environments_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r StaticWebAppResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"api_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"default_host_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StaticWebAppResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.StaticWebAppsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StaticWebAppModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := staticsites.NewStaticSiteID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.GetStaticSite(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if len(model.BasicAuth) > 0 && model.SkuTier != staticWebAppSkuStandard {
+				return fmt.Errorf("`basic_auth` can only be specified when `sku_tier` is set to %q", staticWebAppSkuStandard)
+			}
+
+			parameters, err := expandStaticWebApp(model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateStaticSiteThenPoll(ctx, id, *parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if len(model.AppSettings) > 0 {
+				if _, err := client.CreateOrUpdateStaticSiteAppSettings(ctx, id, expandStaticWebAppSettings(model.AppSettings)); err != nil {
+					return fmt.Errorf("setting the App Settings for %s: %+v", id, err)
+				}
+			}
+
+			if len(model.BasicAuth) > 0 {
+				if _, err := client.CreateOrUpdateBasicAuth(ctx, id, expandStaticWebAppBasicAuth(model.BasicAuth)); err != nil {
+					return fmt.Errorf("setting the Basic Auth for %s: %+v", id, err)
+				}
+			}
+
+			if len(model.LinkedBackend) > 0 {
+				backendId := staticsites.NewLinkedBackendID(id.SubscriptionId, id.ResourceGroupName, id.StaticSiteName, staticWebAppLinkedBackendName)
+				if err := client.LinkBackendThenPoll(ctx, backendId, expandStaticWebAppLinkedBackend(model.LinkedBackend)); err != nil {
+					return fmt.Errorf("linking the backend for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StaticWebAppResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.StaticWebAppsClient
+
+			id, err := staticsites.ParseStaticSiteID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetStaticSite(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StaticWebAppModel{
+				Name:              id.StaticSiteName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if sku := model.Sku; sku != nil {
+					state.SkuSize = utils.NormalizeNilableString(sku.Name)
+					state.SkuTier = utils.NormalizeNilableString(sku.Tier)
+				}
+
+				flattenedIdentity, err := flattenStaticWebAppIdentity(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = *flattenedIdentity
+
+				if props := model.Properties; props != nil {
+					state.ConfigFileChanges = utils.NormaliseNilableBool(props.AllowConfigFileUpdates)
+					state.DefaultHostName = utils.NormalizeNilableString(props.DefaultHostname)
+					state.LinkedBackend = flattenStaticWebAppLinkedBackends(props.LinkedBackends)
+					state.PreviewEnvironments = props.StagingEnvironmentPolicy == nil || *props.StagingEnvironmentPolicy == staticsites.StagingEnvironmentPolicyEnabled
+					state.PublicNetworkAccess = props.PublicNetworkAccess == nil || *props.PublicNetworkAccess != staticWebAppPublicNetworkAccessDisabled
+				}
+			}
+
+			appSettings, err := client.ListStaticSiteAppSettings(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing the App Settings for %s: %+v", *id, err)
+			}
+			if model := appSettings.Model; model != nil && model.Properties != nil {
+				state.AppSettings = *model.Properties
+			}
+
+			secrets, err := client.ListStaticSiteSecrets(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing the Secrets for %s: %+v", *id, err)
+			}
+			if model := secrets.Model; model != nil && model.Properties != nil {
+				state.ApiKey = (*model.Properties)["apiKey"]
+			}
+
+			// Basic Auth is only available for the Standard SKU
+			if state.SkuTier == staticWebAppSkuStandard {
+				basicAuth, err := client.GetBasicAuth(ctx, *id)
+				if err != nil && !response.WasNotFound(basicAuth.HttpResponse) {
+					return fmt.Errorf("retrieving the Basic Auth for %s: %+v", *id, err)
+				}
+				// the password isn't returned by the API, so we keep the value from the config
+				state.BasicAuth = flattenStaticWebAppBasicAuth(basicAuth.Model, metadata.ResourceData.Get("basic_auth.0.password").(string))
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StaticWebAppResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.StaticWebAppsClient
+
+			id, err := staticsites.ParseStaticSiteID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StaticWebAppModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if len(model.BasicAuth) > 0 && model.SkuTier != staticWebAppSkuStandard {
+				return fmt.Errorf("`basic_auth` can only be specified when `sku_tier` is set to %q", staticWebAppSkuStandard)
+			}
+
+			if metadata.ResourceData.HasChanges("sku_tier", "sku_size", "configuration_file_changes_enabled", "identity", "preview_environments_enabled", "public_network_access_enabled", "tags") {
+				parameters, err := expandStaticWebApp(model)
+				if err != nil {
+					return err
+				}
+
+				if err := client.CreateOrUpdateStaticSiteThenPoll(ctx, *id, *parameters); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("app_settings") {
+				if _, err := client.CreateOrUpdateStaticSiteAppSettings(ctx, *id, expandStaticWebAppSettings(model.AppSettings)); err != nil {
+					return fmt.Errorf("updating the App Settings for %s: %+v", *id, err)
+				}
+			}
+
+			// Basic Auth is only available for the Standard SKU, so there's nothing to disable when moving to the Free SKU
+			if metadata.ResourceData.HasChange("basic_auth") && (len(model.BasicAuth) > 0 || model.SkuTier == staticWebAppSkuStandard) {
+				if _, err := client.CreateOrUpdateBasicAuth(ctx, *id, expandStaticWebAppBasicAuth(model.BasicAuth)); err != nil {
+					return fmt.Errorf("updating the Basic Auth for %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("linked_backend") {
+				backendId := staticsites.NewLinkedBackendID(id.SubscriptionId, id.ResourceGroupName, id.StaticSiteName, staticWebAppLinkedBackendName)
+
+				// the existing backend has to be unlinked before another can be linked
+				if old, _ := metadata.ResourceData.GetChange("linked_backend"); len(old.([]interface{})) > 0 {
+					if resp, err := client.UnlinkBackend(ctx, backendId); err != nil {
+						if !response.WasNotFound(resp.HttpResponse) {
+							return fmt.Errorf("unlinking the backend for %s: %+v", *id, err)
+						}
+					}
+				}
+
+				if len(model.LinkedBackend) > 0 {
+					if err := client.LinkBackendThenPoll(ctx, backendId, expandStaticWebAppLinkedBackend(model.LinkedBackend)); err != nil {
+						return fmt.Errorf("linking the backend for %s: %+v", *id, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StaticWebAppResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.StaticWebAppsClient
+
+			id, err := staticsites.ParseStaticSiteID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// any linked backend is unlinked as a part of deleting the Static Web App
+			if err := client.DeleteStaticSiteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStaticWebApp(input StaticWebAppModel) (*staticsites.StaticSiteARMResource, error) {
+	expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(input.Identity)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `identity`: %+v", err)
+	}
+	// the API uses the legacy (comma separated) representation of the identity type
+	legacyIdentity := identity.LegacySystemAndUserAssignedMap(*expandedIdentity)
+
+	stagingEnvironmentPolicy := staticsites.StagingEnvironmentPolicyEnabled
+	if !input.PreviewEnvironments {
+		stagingEnvironmentPolicy = staticsites.StagingEnvironmentPolicyDisabled
+	}
+
+	publicNetworkAccess := staticWebAppPublicNetworkAccessEnabled
+	if !input.PublicNetworkAccess {
+		publicNetworkAccess = staticWebAppPublicNetworkAccessDisabled
+	}
+
+	return &staticsites.StaticSiteARMResource{
+		Identity: &legacyIdentity,
+		Location: location.Normalize(input.Location),
+		Properties: &staticsites.StaticSite{
+			AllowConfigFileUpdates:   utils.Bool(input.ConfigFileChanges),
+			PublicNetworkAccess:      utils.String(publicNetworkAccess),
+			StagingEnvironmentPolicy: &stagingEnvironmentPolicy,
+		},
+		Sku: &staticsites.SkuDescription{
+			Name: utils.String(input.SkuSize),
+			Tier: utils.String(input.SkuTier),
+		},
+		Tags: &input.Tags,
+	}, nil
+}
+
+func expandStaticWebAppSettings(input map[string]string) staticsites.StringDictionary {
+	settings := make(map[string]string)
+	for k, v := range input {
+		settings[k] = v
+	}
+
+	return staticsites.StringDictionary{
+		Properties: &settings,
+	}
+}
+
+func expandStaticWebAppBasicAuth(input []StaticWebAppBasicAuthModel) staticsites.StaticSiteBasicAuthPropertiesARMResource {
+	// Basic Auth can't be removed, instead it's disabled by limiting it to no environments
+	props := staticsites.StaticSiteBasicAuthPropertiesARMResourceProperties{
+		ApplicableEnvironmentsMode: staticWebAppBasicAuthSpecifiedEnvironments,
+	}
+	if len(input) > 0 {
+		props.ApplicableEnvironmentsMode = input[0].Environments
+		props.Password = utils.String(input[0].Password)
+	}
+
+	return staticsites.StaticSiteBasicAuthPropertiesARMResource{
+		Properties: &props,
+	}
+}
+
+func flattenStaticWebAppBasicAuth(input *staticsites.StaticSiteBasicAuthPropertiesARMResource, password string) []StaticWebAppBasicAuthModel {
+	if input == nil || input.Properties == nil {
+		return []StaticWebAppBasicAuthModel{}
+	}
+
+	mode := input.Properties.ApplicableEnvironmentsMode
+	if mode != staticWebAppBasicAuthAllEnvironments && mode != staticWebAppBasicAuthStagingEnvironments {
+		return []StaticWebAppBasicAuthModel{}
+	}
+
+	return []StaticWebAppBasicAuthModel{
+		{
+			Environments: mode,
+			Password:     password,
+		},
+	}
+}
+
+func expandStaticWebAppLinkedBackend(input []StaticWebAppLinkedBackendModel) staticsites.StaticSiteLinkedBackendARMResource {
+	return staticsites.StaticSiteLinkedBackendARMResource{
+		Properties: &staticsites.StaticSiteLinkedBackendARMResourceProperties{
+			BackendResourceId: utils.String(input[0].BackendResourceId),
+			Region:            utils.String(location.Normalize(input[0].Region)),
+		},
+	}
+}
+
+func flattenStaticWebAppLinkedBackends(input *[]staticsites.StaticSiteLinkedBackend) []StaticWebAppLinkedBackendModel {
+	output := make([]StaticWebAppLinkedBackendModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, StaticWebAppLinkedBackendModel{
+			BackendResourceId: utils.NormalizeNilableString(v.BackendResourceId),
+			Region:            location.NormalizeNilable(v.Region),
+		})
+	}
+
+	return output
+}
+
+func flattenStaticWebAppIdentity(input *identity.LegacySystemAndUserAssignedMap) (*[]identity.ModelSystemAssignedUserAssigned, error) {
+	if input == nil {
+		return identity.FlattenSystemAndUserAssignedMapToModel(nil)
+	}
+
+	transform := identity.SystemAndUserAssignedMap(*input)
+	return identity.FlattenSystemAndUserAssignedMapToModel(&transform)
+}
@@ -4,14 +4,24 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type IDValidationFunc func(id string) error
 
+// IDFromNamesFunc builds the Resource ID from the names of each segment of the Resource ID
+// (e.g. `{resourceGroup}/{name}`), using the specified Subscription ID when this is omitted
+type IDFromNamesFunc func(subscriptionId, input string) (string, error)
+
 type ImporterFunc = func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error)
 
+// subscriptionIdProvider is implemented by the Client for the Provider, exposing the configured Subscription ID
+type subscriptionIdProvider interface {
+	ConfiguredSubscriptionId() string
+}
+
 // ImporterValidatingResourceId validates the ID provided at import time is valid
 // using the validateFunc.
 func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.ResourceImporter {
@@ -36,3 +46,53 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 		},
 	}
 }
+
+// ImporterValidatingResourceIdOrNames validates the ID provided at import time is valid
+// using the validateFunc - where the ID provided is instead the names of each segment of
+// the Resource ID (e.g. `{resourceGroup}/{name}`) this is first built into the Resource ID
+// using the fromNamesFunc.
+func ImporterValidatingResourceIdOrNames(validateFunc IDValidationFunc, fromNamesFunc IDFromNamesFunc) *schema.ResourceImporter {
+	thenFunc := func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+		return []*ResourceData{d}, nil
+	}
+	return ImporterValidatingResourceIdOrNamesThen(validateFunc, fromNamesFunc, thenFunc)
+}
+
+// ImporterValidatingResourceIdOrNamesThen validates the ID provided at import time is valid
+// using the validateFunc (building the Resource ID using the fromNamesFunc where the names of
+// each segment are provided instead) then runs the 'thenFunc', allowing the import to be customised.
+func ImporterValidatingResourceIdOrNamesThen(validateFunc IDValidationFunc, fromNamesFunc IDFromNamesFunc, thenFunc ImporterFunc) *schema.ResourceImporter {
+	importer := ImporterValidatingResourceIdThen(validateFunc, thenFunc)
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+			if err := resourceIdFromNames(d, meta, fromNamesFunc); err != nil {
+				return []*ResourceData{d}, err
+			}
+
+			return importer.StateContext(ctx, d, meta)
+		},
+	}
+}
+
+// resourceIdFromNames replaces the ID of the Resource being imported with the Resource ID built from the
+// names of each segment, when the ID isn't a Resource ID (which always begins with a `/`)
+func resourceIdFromNames(d *ResourceData, meta interface{}, fromNamesFunc IDFromNamesFunc) error {
+	input := d.Id()
+	if strings.HasPrefix(input, "/") {
+		return nil
+	}
+
+	subscriptionId := ""
+	if v, ok := meta.(subscriptionIdProvider); ok {
+		subscriptionId = v.ConfiguredSubscriptionId()
+	}
+
+	id, err := fromNamesFunc(subscriptionId, input)
+	if err != nil {
+		return fmt.Errorf("building the Resource ID from %q: %+v - either the Resource ID or the names of each segment of the Resource ID can be specified", input, err)
+	}
+
+	log.Printf("[DEBUG] Importing Resource - built the Resource ID %q from %q", id, input)
+	d.SetId(id)
+	return nil
+}
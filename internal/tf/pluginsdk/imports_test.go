@@ -0,0 +1,89 @@
+package pluginsdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testClient struct{}
+
+func (testClient) ConfiguredSubscriptionId() string {
+	return "00000000-0000-0000-0000-000000000000"
+}
+
+func TestImporterValidatingResourceIdOrNames(t *testing.T) {
+	validateFunc := func(id string) error {
+		if !strings.HasPrefix(id, "/subscriptions/") {
+			return fmt.Errorf("expected a Resource ID but got %q", id)
+		}
+		return nil
+	}
+	fromNamesFunc := func(subscriptionId, input string) (string, error) {
+		names := strings.Split(input, "/")
+		if len(names) == 2 {
+			names = append([]string{subscriptionId}, names...)
+		}
+		if len(names) != 3 {
+			return "", fmt.Errorf("expected `[{subscriptionId}/]{resourceGroup}/{name}`")
+		}
+		return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Example/things/%s", names[0], names[1], names[2]), nil
+	}
+
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected string
+	}{
+		{
+			// full Resource ID
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Example/things/thing1",
+			Expected: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Example/things/thing1",
+		},
+		{
+			// names using the configured Subscription
+			Input:    "group1/thing1",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Example/things/thing1",
+		},
+		{
+			// names including the Subscription
+			Input:    "11111111-1111-1111-1111-111111111111/group1/thing1",
+			Expected: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Example/things/thing1",
+		},
+		{
+			// too few names
+			Input: "thing1",
+			Error: true,
+		},
+		{
+			// invalid Resource ID
+			Input: "/resourceGroups/group1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		resourceData := &schema.ResourceData{}
+		resourceData.SetId(v.Input)
+		_, err := ImporterValidatingResourceIdOrNames(validateFunc, fromNamesFunc).StateContext(context.TODO(), resourceData, testClient{})
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if resourceData.Id() != v.Expected {
+			t.Fatalf("Expected the ID to be %q but got %q", v.Expected, resourceData.Id())
+		}
+	}
+}
@@ -0,0 +1,601 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk" // nolint: typecheck
+)
+
+const modulePath = "github.com/hashicorp/terraform-provider-azurerm"
+
+func main() {
+	rootPath := flag.String("path", "", "The relative path to the root directory")
+	outputPath := flag.String("output", "", "The path to the file the JSON should be written to, outputs to stdout when omitted")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	rootDirectory, err := filepath.Abs(*rootPath)
+	if err != nil {
+		log.Fatalf("determining the root directory: %+v", err)
+	}
+
+	metadata, err := buildProviderMetadata(rootDirectory)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	out, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		log.Fatalf("marshalling the metadata: %+v", err)
+	}
+
+	if *outputPath == "" {
+		fmt.Println(string(out))
+		return
+	}
+
+	if err := os.WriteFile(*outputPath, append(out, '\n'), 0644); err != nil {
+		log.Fatalf("writing the metadata to %q: %+v", *outputPath, err)
+	}
+}
+
+type providerMetadata struct {
+	DataSources map[string]resourceMetadata `json:"data_sources"`
+	Resources   map[string]resourceMetadata `json:"resources"`
+}
+
+type resourceMetadata struct {
+	Service              string                    `json:"service"`
+	ServicePackage       string                    `json:"service_package"`
+	Typed                bool                      `json:"typed"`
+	ArmProviderNamespace string                    `json:"arm_provider_namespace,omitempty"`
+	ArmResourceType      string                    `json:"arm_resource_type,omitempty"`
+	ApiVersions          []string                  `json:"api_versions,omitempty"`
+	IdPattern            string                    `json:"id_pattern,omitempty"`
+	Timeouts             *timeoutsMetadata         `json:"timeouts,omitempty"`
+	Deprecation          *deprecationMetadata      `json:"deprecation,omitempty"`
+	Schema               map[string]schemaMetadata `json:"schema"`
+}
+
+type timeoutsMetadata struct {
+	Create string `json:"create,omitempty"`
+	Read   string `json:"read,omitempty"`
+	Update string `json:"update,omitempty"`
+	Delete string `json:"delete,omitempty"`
+}
+
+type deprecationMetadata struct {
+	Message    string `json:"message"`
+	ReplacedBy string `json:"replaced_by,omitempty"`
+}
+
+type schemaMetadata struct {
+	Type          string                    `json:"type"`
+	Required      bool                      `json:"required,omitempty"`
+	Optional      bool                      `json:"optional,omitempty"`
+	Computed      bool                      `json:"computed,omitempty"`
+	ForceNew      bool                      `json:"force_new,omitempty"`
+	Sensitive     bool                      `json:"sensitive,omitempty"`
+	Deprecated    string                    `json:"deprecated,omitempty"`
+	Default       interface{}               `json:"default,omitempty"`
+	MinItems      int                       `json:"min_items,omitempty"`
+	MaxItems      int                       `json:"max_items,omitempty"`
+	ConflictsWith []string                  `json:"conflicts_with,omitempty"`
+	ExactlyOneOf  []string                  `json:"exactly_one_of,omitempty"`
+	AtLeastOneOf  []string                  `json:"at_least_one_of,omitempty"`
+	RequiredWith  []string                  `json:"required_with,omitempty"`
+	Elem          *schemaMetadata           `json:"elem,omitempty"`
+	Block         map[string]schemaMetadata `json:"block,omitempty"`
+}
+
+func buildProviderMetadata(rootDirectory string) (*providerMetadata, error) {
+	output := providerMetadata{
+		DataSources: map[string]resourceMetadata{},
+		Resources:   map[string]resourceMetadata{},
+	}
+	ids := newIdPatternCache(rootDirectory)
+
+	for _, service := range provider.SupportedTypedServices() {
+		servicePackage := packageNameForService(service)
+
+		for _, ds := range service.DataSources() {
+			wrapper := sdk.NewDataSourceWrapper(ds)
+			resource, err := wrapper.DataSource()
+			if err != nil {
+				return nil, fmt.Errorf("building Data Source %q: %+v", ds.ResourceType(), err)
+			}
+
+			metadata := buildResourceMetadata(service.Name(), servicePackage, true, resource)
+			ids.populate(&metadata, sourceFileForFunc(ds.Read().Func), "")
+			output.DataSources[ds.ResourceType()] = metadata
+		}
+
+		for _, r := range service.Resources() {
+			wrapper := sdk.NewResourceWrapper(r)
+			resource, err := wrapper.Resource()
+			if err != nil {
+				return nil, fmt.Errorf("building Resource %q: %+v", r.ResourceType(), err)
+			}
+
+			metadata := buildResourceMetadata(service.Name(), servicePackage, true, resource)
+			if v, ok := r.(sdk.ResourceWithDeprecationReplacedBy); ok && metadata.Deprecation != nil {
+				metadata.Deprecation.ReplacedBy = v.DeprecatedInFavourOfResource()
+			}
+			ids.populate(&metadata, sourceFileForFunc(r.Read().Func), funcName(r.IDValidationFunc()))
+			output.Resources[r.ResourceType()] = metadata
+		}
+	}
+
+	for _, service := range provider.SupportedUntypedServices() {
+		servicePackage := packageNameForService(service)
+
+		for name, resource := range service.SupportedDataSources() {
+			metadata := buildResourceMetadata(service.Name(), servicePackage, false, resource)
+			ids.populate(&metadata, sourceFileForUntypedResource(resource), "")
+			output.DataSources[name] = metadata
+		}
+
+		for name, resource := range service.SupportedResources() {
+			metadata := buildResourceMetadata(service.Name(), servicePackage, false, resource)
+			ids.populate(&metadata, sourceFileForUntypedResource(resource), "")
+			output.Resources[name] = metadata
+		}
+	}
+
+	return &output, nil
+}
+
+func packageNameForService(service interface{}) string {
+	segments := strings.Split(reflect.TypeOf(service).PkgPath(), "/")
+	return segments[len(segments)-1]
+}
+
+func buildResourceMetadata(serviceName, servicePackage string, typed bool, resource *schema.Resource) resourceMetadata {
+	output := resourceMetadata{
+		Service:        serviceName,
+		ServicePackage: servicePackage,
+		Typed:          typed,
+		Schema:         buildSchemaMetadata(resource.Schema),
+	}
+
+	if resource.Timeouts != nil {
+		output.Timeouts = &timeoutsMetadata{
+			Create: formatTimeout(resource.Timeouts.Create),
+			Read:   formatTimeout(resource.Timeouts.Read),
+			Update: formatTimeout(resource.Timeouts.Update),
+			Delete: formatTimeout(resource.Timeouts.Delete),
+		}
+	}
+
+	if resource.DeprecationMessage != "" {
+		output.Deprecation = &deprecationMetadata{
+			Message: resource.DeprecationMessage,
+		}
+	}
+
+	return output
+}
+
+func formatTimeout(input *time.Duration) string {
+	if input == nil {
+		return ""
+	}
+
+	return input.String()
+}
+
+func buildSchemaMetadata(input map[string]*schema.Schema) map[string]schemaMetadata {
+	output := make(map[string]schemaMetadata)
+	for name, field := range input {
+		output[name] = buildFieldMetadata(field)
+	}
+	return output
+}
+
+func buildFieldMetadata(field *schema.Schema) schemaMetadata {
+	output := schemaMetadata{
+		Type:          strings.TrimPrefix(field.Type.String(), "Type"),
+		Required:      field.Required,
+		Optional:      field.Optional,
+		Computed:      field.Computed,
+		ForceNew:      field.ForceNew,
+		Sensitive:     field.Sensitive,
+		Deprecated:    field.Deprecated,
+		Default:       field.Default,
+		MinItems:      field.MinItems,
+		MaxItems:      field.MaxItems,
+		ConflictsWith: field.ConflictsWith,
+		ExactlyOneOf:  field.ExactlyOneOf,
+		AtLeastOneOf:  field.AtLeastOneOf,
+		RequiredWith:  field.RequiredWith,
+	}
+
+	switch elem := field.Elem.(type) {
+	case *schema.Schema:
+		v := buildFieldMetadata(elem)
+		output.Elem = &v
+	case *schema.Resource:
+		output.Block = buildSchemaMetadata(elem.Schema)
+	}
+
+	return output
+}
+
+// sourceFileForUntypedResource returns the path to the file containing the Untyped Resource, using the file
+// containing its Read function - since the Importer is typically an anonymous function
+func sourceFileForUntypedResource(resource *schema.Resource) string {
+	// nolint staticcheck
+	for _, fn := range []interface{}{resource.Read, resource.ReadContext, resource.ReadWithoutTimeout} {
+		if reflect.ValueOf(fn).IsNil() {
+			continue
+		}
+
+		return sourceFileForFunc(fn)
+	}
+
+	return ""
+}
+
+func sourceFileForFunc(fn interface{}) string {
+	if fn == nil {
+		return ""
+	}
+
+	pc := reflect.ValueOf(fn).Pointer()
+	f := runtime.FuncForPC(pc)
+	if f == nil {
+		return ""
+	}
+
+	fileName, _ := f.FileLine(pc)
+	return fileName
+}
+
+func funcName(fn interface{}) string {
+	if fn == nil {
+		return ""
+	}
+
+	f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer())
+	if f == nil {
+		return ""
+	}
+
+	return f.Name()
+}
+
+var (
+	apiVersionRegex = regexp.MustCompile(`/(\d{4}-\d{2}-\d{2}(-preview)?)/`)
+	idFunctionRegex = regexp.MustCompile(`^(Parse|New|Validate)?([A-Z][A-Za-z0-9]*?)ID(Insensitively)?$`)
+)
+
+// idPatternCache resolves the Resource ID pattern for a Data Source/Resource from its source code, caching the
+// Resource ID types defined within each package directory
+type idPatternCache struct {
+	rootDirectory string
+	packages      map[string]map[string]string
+}
+
+func newIdPatternCache(rootDirectory string) idPatternCache {
+	return idPatternCache{
+		rootDirectory: rootDirectory,
+		packages:      map[string]map[string]string{},
+	}
+}
+
+// populate determines the API Versions and Resource ID used by a Data Source/Resource from the file containing it.
+// When specified, the fully qualified name of the ID Validation Function (for Typed Resources) takes precedence
+// over the functions called within the file.
+func (c idPatternCache) populate(metadata *resourceMetadata, fileName string, idValidationFuncName string) {
+	if fileName == "" {
+		return
+	}
+
+	fileName = c.localPath(fileName)
+	file, err := parser.ParseFile(token.NewFileSet(), fileName, nil, 0)
+	if err != nil {
+		log.Printf("[WARN] parsing %q: %+v", fileName, err)
+		return
+	}
+
+	imports := make(map[string]string)
+	apiVersions := make(map[string]struct{})
+	for _, spec := range file.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		alias := importPath[strings.LastIndex(importPath, "/")+1:]
+		if spec.Name != nil {
+			alias = spec.Name.Name
+		}
+		imports[alias] = importPath
+
+		if match := apiVersionRegex.FindStringSubmatch(importPath + "/"); match != nil {
+			apiVersions[match[1]] = struct{}{}
+		}
+	}
+
+	for v := range apiVersions {
+		metadata.ApiVersions = append(metadata.ApiVersions, v)
+	}
+	sort.Strings(metadata.ApiVersions)
+
+	candidates := make([][2]string, 0)
+	if idValidationFuncName != "" {
+		// e.g. `github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate.VaultID`
+		if i := strings.LastIndex(idValidationFuncName, "."); i > 0 {
+			candidates = append(candidates, [2]string{idValidationFuncName[:i], idValidationFuncName[i+1:]})
+		}
+	}
+	for _, call := range idFunctionCallsInFile(file) {
+		if importPath, ok := imports[call[0]]; ok {
+			candidates = append(candidates, [2]string{importPath, call[1]})
+		}
+	}
+
+	for _, candidate := range candidates {
+		match := idFunctionRegex.FindStringSubmatch(candidate[1])
+		if match == nil {
+			continue
+		}
+
+		pattern, ok := c.patternsForPackage(candidate[0])[match[2]]
+		if !ok {
+			continue
+		}
+
+		metadata.IdPattern = pattern
+		metadata.ArmProviderNamespace, metadata.ArmResourceType = armResourceTypeFromPattern(pattern)
+		return
+	}
+}
+
+// localPath returns the path to the specified file within the root directory, since the path
+// embedded in the binary is the path to the file at compile time
+func (c idPatternCache) localPath(fileName string) string {
+	if _, err := os.Stat(fileName); err == nil {
+		return fileName
+	}
+
+	if i := strings.Index(fileName, "/internal/"); i >= 0 {
+		return filepath.Join(c.rootDirectory, fileName[i:])
+	}
+
+	return fileName
+}
+
+func (c idPatternCache) patternsForPackage(importPath string) map[string]string {
+	// the Resource ID types for the `validate` packages are defined in the neighbouring `parse` package
+	if strings.HasSuffix(importPath, "/validate") {
+		importPath = strings.TrimSuffix(importPath, "/validate") + "/parse"
+	}
+
+	if v, ok := c.packages[importPath]; ok {
+		return v
+	}
+
+	directory := filepath.Join(c.rootDirectory, "vendor", importPath)
+	if strings.HasPrefix(importPath, modulePath+"/") {
+		directory = filepath.Join(c.rootDirectory, strings.TrimPrefix(importPath, modulePath+"/"))
+	}
+
+	patterns := make(map[string]string)
+	files, _ := filepath.Glob(filepath.Join(directory, "*.go"))
+	for _, fileName := range files {
+		if strings.HasSuffix(fileName, "_test.go") {
+			continue
+		}
+
+		contents, err := os.ReadFile(fileName)
+		if err != nil {
+			continue
+		}
+
+		v, err := idPatternsInSource(contents)
+		if err != nil {
+			log.Printf("[WARN] parsing %q: %+v", fileName, err)
+			continue
+		}
+
+		for k, pattern := range v {
+			patterns[k] = pattern
+		}
+	}
+
+	c.packages[importPath] = patterns
+	return patterns
+}
+
+// idFunctionCallsInFile returns the package alias and function name for each call to a function within another
+// package which could parse/validate a Resource ID, in the order they're defined - giving precedence to those
+// used within the Importer
+func idFunctionCallsInFile(file *ast.File) [][2]string {
+	importer := make([][2]string, 0)
+	other := make([][2]string, 0)
+
+	var visit func(node ast.Node, inImporter bool)
+	visit = func(node ast.Node, inImporter bool) {
+		ast.Inspect(node, func(n ast.Node) bool {
+			if kv, ok := n.(*ast.KeyValueExpr); ok && !inImporter {
+				if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Importer" {
+					visit(kv.Value, true)
+					return false
+				}
+			}
+
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			selector, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+
+			pkg, ok := selector.X.(*ast.Ident)
+			if !ok || !idFunctionRegex.MatchString(selector.Sel.Name) {
+				return true
+			}
+
+			if inImporter {
+				importer = append(importer, [2]string{pkg.Name, selector.Sel.Name})
+			} else {
+				other = append(other, [2]string{pkg.Name, selector.Sel.Name})
+			}
+			return true
+		})
+	}
+	visit(file, false)
+
+	return append(importer, other...)
+}
+
+// idPatternsInSource returns the Resource ID pattern for each Resource ID type defined in the specified source,
+// keyed by the name of the type without the `Id` suffix. Patterns are derived from the `ID()` method, for example:
+//
+//	func (id VaultId) ID() string {
+//		fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s"
+//		return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+//	}
+//
+// becomes `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.KeyVault/vaults/{name}`
+func idPatternsInSource(src []byte) (map[string]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]string)
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Name.Name != "ID" || fn.Recv == nil || len(fn.Recv.List) != 1 || fn.Body == nil || len(fn.Body.List) != 2 {
+			continue
+		}
+
+		receiverType := fn.Recv.List[0].Type
+		if star, ok := receiverType.(*ast.StarExpr); ok {
+			receiverType = star.X
+		}
+		receiver, ok := receiverType.(*ast.Ident)
+		if !ok || !strings.HasSuffix(receiver.Name, "Id") {
+			continue
+		}
+
+		format, ok := formatStringFromAssignment(fn.Body.List[0])
+		if !ok {
+			continue
+		}
+
+		fields, ok := fieldsFromReturn(fn.Body.List[1])
+		if !ok || strings.Count(format, "%s") != len(fields) {
+			continue
+		}
+
+		pattern := format
+		for _, field := range fields {
+			pattern = strings.Replace(pattern, "%s", fmt.Sprintf("{%s}", strings.ToLower(field[:1])+field[1:]), 1)
+		}
+
+		output[strings.TrimSuffix(receiver.Name, "Id")] = pattern
+	}
+
+	return output, nil
+}
+
+func formatStringFromAssignment(stmt ast.Stmt) (string, bool) {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || len(assign.Rhs) != 1 {
+		return "", false
+	}
+
+	lit, ok := assign.Rhs[0].(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil || !strings.HasPrefix(value, "/") {
+		return "", false
+	}
+
+	return value, true
+}
+
+func fieldsFromReturn(stmt ast.Stmt) ([]string, bool) {
+	ret, ok := stmt.(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return nil, false
+	}
+
+	call, ok := ret.Results[0].(*ast.CallExpr)
+	if !ok || len(call.Args) < 1 {
+		return nil, false
+	}
+
+	fields := make([]string, 0)
+	for _, arg := range call.Args[1:] {
+		selector, ok := arg.(*ast.SelectorExpr)
+		if !ok {
+			return nil, false
+		}
+
+		fields = append(fields, selector.Sel.Name)
+	}
+
+	return fields, true
+}
+
+// armResourceTypeFromPattern returns the ARM Provider Namespace (e.g. `Microsoft.KeyVault`) and Resource Type
+// (e.g. `Microsoft.KeyVault/vaults`) from the specified Resource ID pattern
+func armResourceTypeFromPattern(pattern string) (string, string) {
+	segments := strings.Split(strings.Trim(pattern, "/"), "/")
+
+	index := -1
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") && i+1 < len(segments) && !strings.HasPrefix(segments[i+1], "{") {
+			index = i
+		}
+	}
+
+	if index == -1 {
+		// Resource Groups and Subscriptions are defined within the `Microsoft.Resources` namespace
+		types := make([]string, 0)
+		for i := 0; i < len(segments); i += 2 {
+			types = append(types, segments[i])
+		}
+		if len(types) == 0 {
+			return "", ""
+		}
+		return "Microsoft.Resources", fmt.Sprintf("Microsoft.Resources/%s", types[len(types)-1])
+	}
+
+	namespace := segments[index+1]
+	types := []string{namespace}
+	for i := index + 2; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+
+	return namespace, strings.Join(types, "/")
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestIdPatternsInSource(t *testing.T) {
+	src := []byte(`package parse
+
+import "fmt"
+
+type VaultId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func (id VaultId) String() string {
+	return fmt.Sprintf("Vault %q", id.Name)
+}
+
+func (id VaultId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+type TableId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	WorkspaceName     string
+	TableName         string
+}
+
+func (id *TableId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/tables/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.TableName)
+}
+
+type DataPlaneId struct {
+	AccountName string
+}
+
+func (id DataPlaneId) ID() string {
+	return fmt.Sprintf("https://%s.example.com", id.AccountName)
+}
+`)
+
+	actual, err := idPatternsInSource(src)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	expected := map[string]string{
+		"Vault": "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.KeyVault/vaults/{name}",
+		"Table": "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.OperationalInsights/workspaces/{workspaceName}/tables/{tableName}",
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d patterns but got %d: %+v", len(expected), len(actual), actual)
+	}
+	for k, v := range expected {
+		if actual[k] != v {
+			t.Fatalf("expected the pattern for %q to be %q but got %q", k, v, actual[k])
+		}
+	}
+}
+
+func TestArmResourceTypeFromPattern(t *testing.T) {
+	testData := []struct {
+		pattern           string
+		expectedNamespace string
+		expectedType      string
+	}{
+		{
+			pattern:           "/subscriptions/{subscriptionId}",
+			expectedNamespace: "Microsoft.Resources",
+			expectedType:      "Microsoft.Resources/subscriptions",
+		},
+		{
+			pattern:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}",
+			expectedNamespace: "Microsoft.Resources",
+			expectedType:      "Microsoft.Resources/resourceGroups",
+		},
+		{
+			pattern:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.KeyVault/vaults/{name}",
+			expectedNamespace: "Microsoft.KeyVault",
+			expectedType:      "Microsoft.KeyVault/vaults",
+		},
+		{
+			pattern:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/subnets/{name}",
+			expectedNamespace: "Microsoft.Network",
+			expectedType:      "Microsoft.Network/virtualNetworks/subnets",
+		},
+		{
+			// extension resources use the namespace of the extension rather than the parent
+			pattern:           "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{storageAccountName}/providers/Microsoft.Security/advancedThreatProtectionSettings/{name}",
+			expectedNamespace: "Microsoft.Security",
+			expectedType:      "Microsoft.Security/advancedThreatProtectionSettings",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.pattern)
+
+		namespace, resourceType := armResourceTypeFromPattern(v.pattern)
+		if namespace != v.expectedNamespace {
+			t.Fatalf("expected the namespace to be %q but got %q", v.expectedNamespace, namespace)
+		}
+		if resourceType != v.expectedType {
+			t.Fatalf("expected the resource type to be %q but got %q", v.expectedType, resourceType)
+		}
+	}
+}
@@ -347,7 +347,8 @@ import (
 %s
 %s
 %s
-`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive())
+%s
+`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive(), id.codeForParserFromNames())
 }
 
 func (id ResourceIdGenerator) codeForType() string {
@@ -562,6 +563,78 @@ func %[1]sIDInsensitively(input string) (*%[1]sId, error) {
 `, id.TypeName, directAssignmentsStr, parserStatementsStr)
 }
 
+// namesFormat returns the format used for the names of each segment of this Resource ID (e.g. `{resourceGroup}/{name}`),
+// where the Subscription ID is optional when this Resource ID is scoped to a Subscription
+func (id ResourceIdGenerator) namesFormat() string {
+	names := make([]string, 0)
+	for _, segment := range id.Segments {
+		names = append(names, fmt.Sprintf("{%s}", segment.ArgumentName))
+	}
+
+	if id.hasOptionalSubscriptionId() {
+		return fmt.Sprintf("[%s/]%s", names[0], strings.Join(names[1:], "/"))
+	}
+
+	return strings.Join(names, "/")
+}
+
+// hasOptionalSubscriptionId returns whether the Subscription ID can be omitted when building this Resource ID from
+// the names of each segment, which is the case when this Resource ID is scoped to a Subscription
+func (id ResourceIdGenerator) hasOptionalSubscriptionId() bool {
+	return id.HasSubscriptionId && len(id.Segments) > 1 && id.Segments[0].FieldName == "SubscriptionId"
+}
+
+func (id ResourceIdGenerator) codeForParserFromNames() string {
+	arguments := make([]string, 0)
+	for i := range id.Segments {
+		arguments = append(arguments, fmt.Sprintf("names[%d]", i))
+	}
+	argumentsStr := strings.Join(arguments, ", ")
+
+	if !id.hasOptionalSubscriptionId() {
+		return fmt.Sprintf(`
+// %[1]sIDFromNames builds an %[1]sId from the names of each segment in the format
+// %[2]q
+func %[1]sIDFromNames(_, input string) (*%[1]sId, error) {
+	names := strings.Split(input, "/")
+	if len(names) != %[3]d {
+		return nil, fmt.Errorf("expected %%q to be in the format %%q", input, %[2]q)
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %%q to be in the format %%q", input, %[2]q)
+		}
+	}
+
+	resourceId := New%[1]sID(%[4]s)
+	return &resourceId, nil
+}
+`, id.TypeName, id.namesFormat(), len(id.Segments), argumentsStr)
+	}
+
+	return fmt.Sprintf(`
+// %[1]sIDFromNames builds an %[1]sId from the names of each segment in the format
+// %[2]q, using the specified Subscription ID when this is omitted
+func %[1]sIDFromNames(subscriptionId, input string) (*%[1]sId, error) {
+	names := strings.Split(input, "/")
+	if len(names) == %[3]d {
+		names = append([]string{subscriptionId}, names...)
+	}
+	if len(names) != %[4]d {
+		return nil, fmt.Errorf("expected %%q to be in the format %%q", input, %[2]q)
+	}
+	for _, v := range names {
+		if v == "" {
+			return nil, fmt.Errorf("expected %%q to be in the format %%q", input, %[2]q)
+		}
+	}
+
+	resourceId := New%[1]sID(%[5]s)
+	return &resourceId, nil
+}
+`, id.TypeName, id.namesFormat(), len(id.Segments)-1, len(id.Segments), argumentsStr)
+}
+
 func (id ResourceIdGenerator) TestCode() string {
 	importLine := ""
 	if id.TestPackageSuffix != "" {
@@ -583,7 +656,8 @@ import (
 %s
 %s
 %s
-`, id.TestPackageSuffix, importLine, id.testCodeForFormatter(), id.testCodeForParser(), id.testCodeForParserInsensitive())
+%s
+`, id.TestPackageSuffix, importLine, id.testCodeForFormatter(), id.testCodeForParser(), id.testCodeForParserInsensitive(), id.testCodeForParserFromNames())
 }
 
 func (id ResourceIdGenerator) testCodeForFormatter() string {
@@ -900,6 +974,96 @@ func Test%[1]sIDInsensitively(t *testing.T) {
 `, id.TypeName, testCasesStr, assignmentCheckStr)
 }
 
+func (id ResourceIdGenerator) testCodeForParserFromNames() string {
+	packagePrefix := ""
+	if id.TestPackageSuffix != "" {
+		packagePrefix = "parse."
+	}
+
+	names := make([]string, 0)
+	expectAssignments := make([]string, 0)
+	assignmentChecks := make([]string, 0)
+	for _, segment := range id.Segments {
+		names = append(names, segment.SegmentValue)
+		expectAssignments = append(expectAssignments, fmt.Sprintf("\t\t\t\t%s:\t%q,", segment.FieldName, segment.SegmentValue))
+
+		assignmentsFmt := "\t\tif actual.%[1]s != v.Expected.%[1]s {\n\t\t\tt.Fatalf(\"Expected %%q but got %%q for %[1]s\", v.Expected.%[1]s, actual.%[1]s)\n\t\t}"
+		assignmentChecks = append(assignmentChecks, fmt.Sprintf(assignmentsFmt, segment.FieldName))
+	}
+	expected := fmt.Sprintf("&%s%sId{\n%s\n\t\t\t}", packagePrefix, id.TypeName, strings.Join(expectAssignments, "\n"))
+
+	// the Subscription ID used when this is omitted from the names
+	subscriptionId := "00000000-0000-0000-0000-000000000000"
+	if id.hasOptionalSubscriptionId() {
+		subscriptionId = id.Segments[0].SegmentValue
+	}
+
+	testCases := make([]string, 0)
+	testCaseFmt := `
+		{
+			// %s
+			SubscriptionId: %q,
+			Input: %q,
+			Error: true,
+		},`
+	testCases = append(testCases, fmt.Sprintf(testCaseFmt, "empty", subscriptionId, ""))
+	requiredNames := names
+	if id.hasOptionalSubscriptionId() {
+		requiredNames = names[1:]
+	}
+	testCases = append(testCases, fmt.Sprintf(testCaseFmt, "missing the last segment", subscriptionId, strings.Join(requiredNames[:len(requiredNames)-1], "/")))
+	if len(names) > 1 {
+		withEmptySegment := append([]string{}, names...)
+		withEmptySegment[len(names)-1] = ""
+		testCases = append(testCases, fmt.Sprintf(testCaseFmt, "empty segment", subscriptionId, strings.Join(withEmptySegment, "/")))
+	}
+
+	validTestCaseFmt := `
+		{
+			// %s
+			SubscriptionId: %q,
+			Input: %q,
+			Expected: %s,
+		},`
+	if id.hasOptionalSubscriptionId() {
+		testCases = append(testCases, fmt.Sprintf(validTestCaseFmt, "valid without the Subscription ID", subscriptionId, strings.Join(names[1:], "/"), expected))
+		testCases = append(testCases, fmt.Sprintf(validTestCaseFmt, "valid with the Subscription ID", "00000000-0000-0000-0000-000000000000", strings.Join(names, "/"), expected))
+	} else {
+		testCases = append(testCases, fmt.Sprintf(validTestCaseFmt, "valid", subscriptionId, strings.Join(names, "/"), expected))
+	}
+
+	return fmt.Sprintf(`
+func Test%[1]sIDFromNames(t *testing.T) {
+	testData := []struct {
+		SubscriptionId string
+		Input          string
+		Error          bool
+		Expected       *%[2]s%[1]sId
+	}{
+%[3]s
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %%q", v.Input)
+
+		actual, err := %[2]s%[1]sIDFromNames(v.SubscriptionId, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %%s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+%[4]s
+	}
+}
+`, id.TypeName, packagePrefix, strings.Join(testCases, "\n"), strings.Join(assignmentChecks, "\n"))
+}
+
 func (id ResourceIdGenerator) ValidatorCode() string {
 	return fmt.Sprintf(`package validate
 
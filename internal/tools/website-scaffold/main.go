@@ -7,6 +7,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -23,6 +24,7 @@ import (
 func main() {
 	f := flag.NewFlagSet("example", flag.ExitOnError)
 
+	mode := f.String("mode", "scaffold", "Either `scaffold` to write a new page, `generate` to output the Arguments/Attributes Reference from the schema or `lint` to check the existing pages against the schema")
 	resourceName := f.String("name", "", "The name of the Data Source/Resource which should be generated")
 	brandName := f.String("brand-name", "", "The friendly/brand name of this Data Source/Resource (e.g. Resource Group)")
 	resourceId := f.String("resource-id", "", "An Azure Resource ID showing an example of how to Import this Resource")
@@ -42,6 +44,37 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *mode != "scaffold" && *mode != "generate" && *mode != "lint" {
+		quitWithError("The mode specified via `-mode` must be either `scaffold`, `generate` or `lint`")
+		return
+	}
+
+	if *mode == "lint" {
+		if websitePath == nil || *websitePath == "" {
+			quitWithError("The Relative Website Path must be specified via `-website-path`")
+			return
+		}
+
+		if *resourceName != "" && *resourceType != "data" && *resourceType != "resource" {
+			quitWithError("The type of the Data Source/Resource specified via `-type` must be either `data` or `resource` when linting a single page")
+			return
+		}
+
+		issues, err := lint(*resourceName, *resourceType == "resource", *websitePath)
+		if err != nil {
+			quitWithError(err.Error())
+			return
+		}
+
+		for _, issue := range issues {
+			log.Print(issue)
+		}
+		if len(issues) > 0 {
+			quitWithError(fmt.Sprintf("%d issue(s) found where the documentation disagrees with the schema", len(issues)))
+		}
+		return
+	}
+
 	if resourceName == nil || *resourceName == "" {
 		quitWithError("The name of the Data Source/Resource must be specified via `-name`")
 		return
@@ -62,12 +95,23 @@ func main() {
 		return
 	}
 
+	isResource := *resourceType == "resource"
+	if *mode == "generate" {
+		content, err := getReferenceContent(*resourceName, *brandName, isResource)
+		if err != nil {
+			quitWithError(err.Error())
+			return
+		}
+
+		fmt.Println(*content)
+		return
+	}
+
 	if websitePath == nil || *websitePath == "" {
 		quitWithError("The Relative Website Path must be specified via `-website-path`")
 		return
 	}
 
-	isResource := *resourceType == "resource"
 	if isResource && (resourceId == nil || *resourceId == "") {
 		quitWithError("An example of an Azure Resource ID must be specified via `-resource-id` when scaffolding for a Resource")
 		return
@@ -97,14 +141,47 @@ func run(resourceName, brandName string, resourceId *string, isResource bool, we
 }
 
 func getContent(resourceName, brandName string, resourceId *string, isResource bool, expsrc *examplegen.ExampleSource) (*string, error) {
+	resource, websiteCategories, err := findResource(resourceName, isResource)
+	if err != nil {
+		return nil, err
+	}
+
 	generator := documentationGenerator{
-		resourceName:  resourceName,
-		brandName:     brandName,
-		resourceId:    resourceId,
-		isDataSource:  !isResource,
-		exampleSource: expsrc,
+		resource:          resource,
+		resourceName:      resourceName,
+		brandName:         brandName,
+		resourceId:        resourceId,
+		isDataSource:      !isResource,
+		websiteCategories: websiteCategories,
+		exampleSource:     expsrc,
 	}
 
+	docs := generator.generate()
+	return &docs, nil
+}
+
+// getReferenceContent returns the Arguments and Attributes Reference for an existing Data Source/Resource, which
+// can be used to bring an existing page in line with the schema
+func getReferenceContent(resourceName, brandName string, isResource bool) (*string, error) {
+	resource, _, err := findResource(resourceName, isResource)
+	if err != nil {
+		return nil, err
+	}
+
+	generator := documentationGenerator{
+		resource:     resource,
+		resourceName: resourceName,
+		brandName:    brandName,
+		isDataSource: !isResource,
+	}
+
+	docs := fmt.Sprintf("%s\n\n%s", generator.argumentsBlock(), generator.attributesBlock())
+	return &docs, nil
+}
+
+// findResource returns the schema and website categories for the specified Data Source/Resource, which can be
+// either Typed or Untyped
+func findResource(resourceName string, isResource bool) (*schema.Resource, []string, error) {
 	if !isResource {
 		for _, service := range provider.SupportedTypedServices() {
 			for _, ds := range service.DataSources() {
@@ -112,61 +189,46 @@ func getContent(resourceName, brandName string, resourceId *string, isResource b
 					wrapper := sdk.NewDataSourceWrapper(ds)
 					dsWrapper, err := wrapper.DataSource()
 					if err != nil {
-						return nil, fmt.Errorf("wrapping Data Source %q: %+v", ds.ResourceType(), err)
+						return nil, nil, fmt.Errorf("wrapping Data Source %q: %+v", ds.ResourceType(), err)
 					}
 
-					generator.resource = dsWrapper
-					generator.websiteCategories = service.WebsiteCategories()
-					break
+					return dsWrapper, service.WebsiteCategories(), nil
 				}
 			}
 		}
 		for _, service := range provider.SupportedUntypedServices() {
 			for key, ds := range service.SupportedDataSources() {
 				if key == resourceName {
-					generator.resource = ds
-					generator.websiteCategories = service.WebsiteCategories()
-					break
+					return ds, service.WebsiteCategories(), nil
 				}
 			}
 		}
 
-		if generator.resource == nil {
-			return nil, fmt.Errorf("Data Source %q was not registered!", resourceName)
-		}
-	} else {
-		for _, service := range provider.SupportedTypedServices() {
-			for _, rs := range service.Resources() {
-				if rs.ResourceType() == resourceName {
-					wrapper := sdk.NewResourceWrapper(rs)
-					rsWrapper, err := wrapper.Resource()
-					if err != nil {
-						return nil, fmt.Errorf("wrapping Resource %q: %+v", rs.ResourceType(), err)
-					}
+		return nil, nil, fmt.Errorf("Data Source %q was not registered!", resourceName)
+	}
 
-					generator.resource = rsWrapper
-					generator.websiteCategories = service.WebsiteCategories()
-					break
+	for _, service := range provider.SupportedTypedServices() {
+		for _, rs := range service.Resources() {
+			if rs.ResourceType() == resourceName {
+				wrapper := sdk.NewResourceWrapper(rs)
+				rsWrapper, err := wrapper.Resource()
+				if err != nil {
+					return nil, nil, fmt.Errorf("wrapping Resource %q: %+v", rs.ResourceType(), err)
 				}
+
+				return rsWrapper, service.WebsiteCategories(), nil
 			}
 		}
-		for _, service := range provider.SupportedUntypedServices() {
-			for key, rs := range service.SupportedResources() {
-				if key == resourceName {
-					generator.resource = rs
-					generator.websiteCategories = service.WebsiteCategories()
-					break
-				}
+	}
+	for _, service := range provider.SupportedUntypedServices() {
+		for key, rs := range service.SupportedResources() {
+			if key == resourceName {
+				return rs, service.WebsiteCategories(), nil
 			}
 		}
-
-		if generator.resource == nil {
-			return nil, fmt.Errorf("Resource %q was not registered!", resourceName)
-		}
 	}
 
-	docs := generator.generate()
-	return &docs, nil
+	return nil, nil, fmt.Errorf("Resource %q was not registered!", resourceName)
 }
 
 func saveContent(resourceName string, websitePath string, content string, isResource bool) error {
@@ -280,6 +342,7 @@ func (gen documentationGenerator) argumentsBlock() string {
 			}
 
 			value := gen.buildDescriptionForArgument(fieldName, field, blockName)
+			value += gen.buildDetailsForArgument(field)
 			if len(field.ConflictsWith) > 0 {
 				conflictingValues := make([]string, 0)
 				for _, v := range field.ConflictsWith {
@@ -623,6 +686,30 @@ func (gen documentationGenerator) buildDescriptionForArgument(name string, field
 	return "TODO."
 }
 
+// buildDetailsForArgument documents the possible values and default value for the argument, from the schema
+func (gen documentationGenerator) buildDetailsForArgument(field *schema.Schema) string {
+	details := ""
+
+	if values := possibleValuesForField(field); len(values) > 0 {
+		quoted := make([]string, 0)
+		for _, v := range values {
+			quoted = append(quoted, fmt.Sprintf("`%s`", v))
+		}
+
+		if len(quoted) == 1 {
+			details += fmt.Sprintf(" The only possible value is %s.", quoted[0])
+		} else {
+			details += fmt.Sprintf(" Possible values are %s and %s.", strings.Join(quoted[:len(quoted)-1], ", "), quoted[len(quoted)-1])
+		}
+	}
+
+	if field.Default != nil {
+		details += fmt.Sprintf(" Defaults to `%v`.", field.Default)
+	}
+
+	return details
+}
+
 func (gen documentationGenerator) buildDescriptionForAttribute(name string, field *schema.Schema, blockName string) string {
 	if name == "name" {
 		if blockName == "" {
@@ -947,3 +1034,282 @@ func (gen documentationGenerator) uniqueBlockNamesForAttribute(fields map[string
 
 	return blockNames, blocks
 }
+
+// possibleValuesForField returns the values accepted by a field (or the elements of a list/set) validated using
+// `validation.StringInSlice` - since the values are captured within the validation function, they're retrieved
+// by validating a value which can never be valid and parsing the resulting error
+func possibleValuesForField(field *schema.Schema) (values []string) {
+	validateFunc := field.ValidateFunc
+	if v, ok := field.Elem.(*schema.Schema); ok && validateFunc == nil {
+		validateFunc = v.ValidateFunc
+	}
+	if validateFunc == nil {
+		return nil
+	}
+
+	defer func() {
+		// validation functions for other types may not handle a string
+		if r := recover(); r != nil {
+			values = nil
+		}
+	}()
+
+	prefix := "expected field to be one of ["
+	_, errs := validateFunc("\x00", "field")
+	for _, err := range errs {
+		message := err.Error()
+		end := strings.LastIndex(message, "], got ")
+		if !strings.HasPrefix(message, prefix) || end == -1 {
+			continue
+		}
+
+		return strings.Fields(message[len(prefix):end])
+	}
+
+	return nil
+}
+
+// lint checks the existing documentation for the specified Data Source/Resource (or all of them, when no name is
+// specified) against the schema - returning a list of the issues found
+func lint(resourceName string, isResource bool, websitePath string) ([]string, error) {
+	type lintTarget struct {
+		name         string
+		resource     *schema.Resource
+		isDataSource bool
+	}
+	targets := make([]lintTarget, 0)
+
+	if resourceName != "" {
+		resource, _, err := findResource(resourceName, isResource)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, lintTarget{name: resourceName, resource: resource, isDataSource: !isResource})
+	} else {
+		for _, service := range provider.SupportedTypedServices() {
+			for _, ds := range service.DataSources() {
+				wrapper := sdk.NewDataSourceWrapper(ds)
+				wrapped, err := wrapper.DataSource()
+				if err != nil {
+					return nil, fmt.Errorf("wrapping Data Source %q: %+v", ds.ResourceType(), err)
+				}
+				targets = append(targets, lintTarget{name: ds.ResourceType(), resource: wrapped, isDataSource: true})
+			}
+			for _, rs := range service.Resources() {
+				wrapper := sdk.NewResourceWrapper(rs)
+				wrapped, err := wrapper.Resource()
+				if err != nil {
+					return nil, fmt.Errorf("wrapping Resource %q: %+v", rs.ResourceType(), err)
+				}
+				targets = append(targets, lintTarget{name: rs.ResourceType(), resource: wrapped})
+			}
+		}
+		for _, service := range provider.SupportedUntypedServices() {
+			for name, ds := range service.SupportedDataSources() {
+				targets = append(targets, lintTarget{name: name, resource: ds, isDataSource: true})
+			}
+			for name, rs := range service.SupportedResources() {
+				targets = append(targets, lintTarget{name: name, resource: rs})
+			}
+		}
+	}
+
+	issues := make([]string, 0)
+	for _, target := range targets {
+		resourceKind := "r"
+		if target.isDataSource {
+			resourceKind = "d"
+		}
+		fileName := fmt.Sprintf("%s/docs/%s/%s.html.markdown", strings.TrimSuffix(websitePath, "/"), resourceKind, strings.TrimPrefix(target.name, "azurerm_"))
+
+		content, err := os.ReadFile(fileName)
+		if err != nil {
+			if os.IsNotExist(err) {
+				issues = append(issues, fmt.Sprintf("%s: the page for %q doesn't exist", fileName, target.name))
+				continue
+			}
+
+			return nil, fmt.Errorf("reading %q: %+v", fileName, err)
+		}
+
+		for _, issue := range lintDocumentation(target.resource, string(content)) {
+			issues = append(issues, fmt.Sprintf("%s: %s", fileName, issue))
+		}
+	}
+
+	sort.Strings(issues)
+	return issues, nil
+}
+
+type documentedField struct {
+	// status is the status documented for an argument, either `Required` or `Optional`
+	status string
+
+	// line is the documentation for this field
+	line string
+}
+
+var (
+	documentedBlockRegex = regexp.MustCompile("`([a-z0-9_]+)`( blocks?)? (supports|exports)")
+	documentedFieldRegex = regexp.MustCompile("^[*-] `([a-z0-9_]+)` - (\\(([^)]*)\\))?")
+)
+
+// lintDocumentation returns the differences between the Arguments/Attributes Reference in the documentation and the schema
+func lintDocumentation(resource *schema.Resource, content string) []string {
+	issues := make([]string, 0)
+
+	blocks := make(map[string]map[string]*schema.Schema)
+	collectSchemaBlocks(resource.Schema, "", blocks)
+
+	arguments := parseDocumentedFields(content, "## Argument")
+	for blockName, fields := range arguments {
+		schemaFields, ok := blocks[blockName]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("the `%s` block is documented as an argument but doesn't exist in the schema", blockName))
+			continue
+		}
+
+		for name, documented := range fields {
+			path := documentedFieldPath(blockName, name)
+			field, ok := schemaFields[name]
+			if !ok {
+				issues = append(issues, fmt.Sprintf("the argument `%s` is documented but doesn't exist in the schema", path))
+				continue
+			}
+
+			if !field.Required && !field.Optional {
+				issues = append(issues, fmt.Sprintf("the argument `%s` is Computed-only in the schema so should be documented as an attribute", path))
+				continue
+			}
+
+			if documented.status == "Required" && !field.Required {
+				issues = append(issues, fmt.Sprintf("the argument `%s` is documented as Required but is Optional in the schema", path))
+			}
+			if documented.status == "Optional" && field.Required {
+				issues = append(issues, fmt.Sprintf("the argument `%s` is documented as Optional but is Required in the schema", path))
+			}
+
+			if field.ForceNew && !strings.Contains(strings.ToLower(documented.line), "forces a new") {
+				issues = append(issues, fmt.Sprintf("the argument `%s` is ForceNew in the schema but the documentation doesn't mention that changing it forces a new resource to be created", path))
+			}
+		}
+	}
+
+	for blockName, schemaFields := range blocks {
+		for name, field := range schemaFields {
+			if (!field.Required && !field.Optional) || field.Deprecated != "" {
+				continue
+			}
+
+			if _, ok := arguments[blockName][name]; !ok {
+				issues = append(issues, fmt.Sprintf("the argument `%s` exists in the schema but isn't documented", documentedFieldPath(blockName, name)))
+			}
+		}
+	}
+
+	attributes := parseDocumentedFields(content, "## Attribute")
+	for blockName, fields := range attributes {
+		schemaFields, ok := blocks[blockName]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("the `%s` block is documented as an attribute but doesn't exist in the schema", blockName))
+			continue
+		}
+
+		for name := range fields {
+			if blockName == "" && name == "id" {
+				continue
+			}
+
+			if _, ok := schemaFields[name]; !ok {
+				issues = append(issues, fmt.Sprintf("the attribute `%s` is documented but doesn't exist in the schema", documentedFieldPath(blockName, name)))
+			}
+		}
+	}
+
+	for name, field := range resource.Schema {
+		if field.Required || field.Optional || field.Deprecated != "" {
+			continue
+		}
+
+		if _, ok := attributes[""][name]; !ok {
+			issues = append(issues, fmt.Sprintf("the attribute `%s` exists in the schema but isn't documented", name))
+		}
+	}
+
+	sort.Strings(issues)
+	return issues
+}
+
+// collectSchemaBlocks collects the fields within the schema keyed by the name of the block they're defined within
+// (using "" for the top-level) - since nested blocks are documented by name alone, blocks sharing a name are merged
+func collectSchemaBlocks(input map[string]*schema.Schema, blockName string, output map[string]map[string]*schema.Schema) {
+	if _, ok := output[blockName]; !ok {
+		output[blockName] = make(map[string]*schema.Schema)
+	}
+
+	for name, field := range input {
+		output[blockName][name] = field
+
+		if v, ok := field.Elem.(*schema.Resource); ok && v != nil {
+			collectSchemaBlocks(v.Schema, name, output)
+		}
+	}
+}
+
+// parseDocumentedFields parses the fields documented within the section of the page starting with the specified
+// heading, keyed by the name of the block they're documented within (using "" for the top-level)
+func parseDocumentedFields(content, heading string) map[string]map[string]documentedField {
+	output := make(map[string]map[string]documentedField)
+
+	inSection := false
+	blockName := ""
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+
+		if strings.HasPrefix(line, "## ") {
+			inSection = strings.HasPrefix(line, heading)
+			blockName = ""
+			continue
+		}
+		if !inSection {
+			continue
+		}
+
+		if !strings.HasPrefix(line, "*") {
+			if match := documentedBlockRegex.FindStringSubmatch(line); match != nil {
+				blockName = match[1]
+			}
+			continue
+		}
+
+		match := documentedFieldRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		status := ""
+		if strings.Contains(match[3], "Required") {
+			status = "Required"
+		} else if strings.Contains(match[3], "Optional") {
+			status = "Optional"
+		}
+
+		if _, ok := output[blockName]; !ok {
+			output[blockName] = make(map[string]documentedField)
+		}
+		output[blockName][match[1]] = documentedField{
+			status: status,
+			line:   line,
+		}
+	}
+
+	return output
+}
+
+func documentedFieldPath(blockName, name string) string {
+	if blockName == "" {
+		return name
+	}
+
+	return fmt.Sprintf("%s.%s", blockName, name)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/sergi/go-diff/diffmatchpatch"
 )
 
@@ -159,6 +160,170 @@ A 'block3' block supports the following:
 	runTest(t, expectedOut, actualOut)
 }
 
+func TestBuildDetailsForArgument(t *testing.T) {
+	testData := []struct {
+		name     string
+		field    *schema.Schema
+		expected string
+	}{
+		{
+			name: "no validation or default",
+			field: &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			expected: "",
+		},
+		{
+			name: "single possible value",
+			field: &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Basic"}, false),
+			},
+			expected: " The only possible value is `Basic`.",
+		},
+		{
+			name: "possible values and default",
+			field: &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Standard",
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard", "Premium"}, false),
+			},
+			expected: " Possible values are `Basic`, `Standard` and `Premium`. Defaults to `Standard`.",
+		},
+		{
+			name: "possible values within a list",
+			field: &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"Read", "Write"}, false),
+				},
+			},
+			expected: " Possible values are `Read` and `Write`.",
+		},
+		{
+			name: "non-enum validation",
+			field: &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 90),
+			},
+			expected: " Defaults to `30`.",
+		},
+	}
+
+	gen := setupDocGen(false, &schema.Resource{})
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := gen.buildDetailsForArgument(v.field)
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestLintDocumentation(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sku_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"undocumented": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"network": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"principal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+
+	content := strings.ReplaceAll(`## Arguments Reference
+
+The following arguments are supported:
+
+* 'name' - (Required) The name which should be used for this Foobar. Changing this forces a new Foobar to be created.
+
+* 'location' - (Required) The Azure Region where the Foobar should exist.
+
+* 'sku_name' - (Required) The SKU Name.
+
+* 'network' - (Optional) A 'network' block as defined below.
+
+* 'removed' - (Optional) A field which no longer exists.
+
+---
+
+A 'network' block supports the following:
+
+* 'subnet_id' - (Optional) The ID of the Subnet.
+
+## Attributes Reference
+
+In addition to the Arguments listed above - the following Attributes are exported:
+
+* 'id' - The ID of the Foobar.
+
+* 'endpoint' - The Endpoint of the Foobar.
+
+* 'hostname' - The Hostname of the Foobar.
+`, "'", "`")
+
+	expected := []string{
+		"the argument `location` is ForceNew in the schema but the documentation doesn't mention that changing it forces a new resource to be created",
+		"the argument `network.subnet_id` is documented as Optional but is Required in the schema",
+		"the argument `removed` is documented but doesn't exist in the schema",
+		"the argument `sku_name` is documented as Required but is Optional in the schema",
+		"the argument `undocumented` exists in the schema but isn't documented",
+		"the attribute `hostname` is documented but doesn't exist in the schema",
+		"the attribute `principal_id` exists in the schema but isn't documented",
+	}
+
+	actual := lintDocumentation(resource, content)
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d issues but got %d: %s", len(expected), len(actual), strings.Join(actual, "\n"))
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("expected issue %d to be %q but got %q", i, expected[i], actual[i])
+		}
+	}
+}
+
 func runTest(t *testing.T, expected, actual string) {
 	dmp := diffmatchpatch.New()
 	diffs := dmp.DiffMain(actual, expected, true)
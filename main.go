@@ -21,14 +21,14 @@ func main() {
 	if debugMode {
 		err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/azurerm",
 			&plugin.ServeOpts{
-				ProviderFunc: provider.AzureProvider,
+				GRPCProviderFunc: provider.AzureProviderServer,
 			})
 		if err != nil {
 			log.Println(err.Error())
 		}
 	} else {
 		plugin.Serve(&plugin.ServeOpts{
-			ProviderFunc: provider.AzureProvider,
+			GRPCProviderFunc: provider.AzureProviderServer,
 		})
 	}
 }